@@ -50,6 +50,7 @@ require (
 	github.com/pocketbase/pocketbase v0.28.4
 	github.com/povsister/scp v0.0.0-20250504051308-e467f71ea63c
 	github.com/qiniu/go-sdk/v7 v7.25.4
+	github.com/spf13/cobra v1.9.1
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn v1.0.1193
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb v1.0.1188
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common v1.0.1193
@@ -68,6 +69,7 @@ require (
 	gitlab.ecloud.com/ecloud/ecloudsdkcore v1.0.0
 	golang.org/x/crypto v0.39.0
 	golang.org/x/exp v0.0.0-20250620022241-b7579e27df2b
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/api v0.33.2
 	k8s.io/apimachinery v0.33.2
 	k8s.io/client-go v0.33.2
@@ -203,7 +205,6 @@ require (
 	github.com/opentracing/opentracing-go v1.2.1-0.20220228012449-10b1cf09e00b // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/spf13/cast v1.9.2 // indirect
-	github.com/spf13/cobra v1.9.1 // indirect
 	github.com/spf13/pflag v1.0.6 // indirect
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod v1.0.1128 // indirect
 	github.com/tjfoc/gmsm v1.4.1 // indirect
@@ -219,7 +220,6 @@ require (
 	golang.org/x/tools v0.34.0 // indirect
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	modernc.org/libc v1.65.10 // indirect
 	modernc.org/mathutil v1.7.1 // indirect
 	modernc.org/memory v1.11.0 // indirect
@@ -0,0 +1,75 @@
+package access
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+	"github.com/certimate-go/certimate/internal/secret"
+)
+
+type accessRepository interface {
+	GetById(ctx context.Context, id string) (*domain.Access, error)
+	Save(ctx context.Context, access *domain.Access) (*domain.Access, error)
+}
+
+type AccessService struct {
+	accessRepo accessRepository
+}
+
+func NewAccessService(accessRepo accessRepository) *AccessService {
+	return &AccessService{
+		accessRepo: accessRepo,
+	}
+}
+
+func (s *AccessService) UpdateAccess(ctx context.Context, req *dtos.AccessUpdateReq) error {
+	// 机密引用会在使用时读取服务器上的环境变量、文件等，仅允许超级管理员设置
+	if req.ByAPIToken && containsSecretReference(req.Config) {
+		return domain.NewError(403, "secret references are not allowed when updating access by api token")
+	}
+
+	access, err := s.accessRepo.GetById(ctx, req.AccessId)
+	if err != nil {
+		return err
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		access.Name = name
+	}
+
+	// 仅覆盖传入的配置项，便于自动化场景下单独轮换某个密钥
+	if access.Config == nil {
+		access.Config = make(map[string]any)
+	}
+	maps.Copy(access.Config, req.Config)
+
+	if _, err := s.accessRepo.Save(ctx, access); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func containsSecretReference(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return secret.IsReference(v)
+	case map[string]any:
+		for _, item := range v {
+			if containsSecretReference(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if containsSecretReference(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
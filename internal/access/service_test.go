@@ -0,0 +1,65 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+)
+
+type mockAccessRepository struct {
+	accesses map[string]*domain.Access
+}
+
+func (r *mockAccessRepository) GetById(ctx context.Context, id string) (*domain.Access, error) {
+	if access, ok := r.accesses[id]; ok {
+		return access, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockAccessRepository) Save(ctx context.Context, access *domain.Access) (*domain.Access, error) {
+	r.accesses[access.Id] = access
+	return access, nil
+}
+
+func TestAccessService_UpdateAccess(t *testing.T) {
+	accessRepo := &mockAccessRepository{
+		accesses: map[string]*domain.Access{
+			"ac1": {Meta: domain.Meta{Id: "ac1"}, Name: "dns", Config: map[string]any{"accessKeyId": "ak", "accessKeySecret": "sk"}},
+		},
+	}
+	service := NewAccessService(accessRepo)
+
+	if err := service.UpdateAccess(context.Background(), &dtos.AccessUpdateReq{AccessId: "ac1", Config: map[string]any{"accessKeySecret": "new-sk"}, ByAPIToken: true}); err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if config := accessRepo.accesses["ac1"].Config; config["accessKeyId"] != "ak" || config["accessKeySecret"] != "new-sk" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+	}{
+		{"env", map[string]any{"accessKeySecret": "env://AWS_SECRET_ACCESS_KEY"}},
+		{"file", map[string]any{"accessKeySecret": "file:///proc/self/environ"}},
+		{"nested vault", map[string]any{"nested": []any{map[string]any{"token": "vault://secret/data/dns#token"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.UpdateAccess(context.Background(), &dtos.AccessUpdateReq{AccessId: "ac1", Config: tt.config, ByAPIToken: true})
+			if xerr, ok := err.(*domain.Error); !ok || xerr.Code != 403 {
+				t.Errorf("expected error code 403, got %v", err)
+			}
+			if accessRepo.accesses["ac1"].Config["accessKeySecret"] != "new-sk" {
+				t.Errorf("config should not be modified")
+			}
+
+			if err := service.UpdateAccess(context.Background(), &dtos.AccessUpdateReq{AccessId: "ac1", Config: map[string]any{"other": tt.config}}); err != nil {
+				t.Errorf("expected superuser to be allowed, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/dbx"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+)
+
+const (
+	tokenPrefix       = "cmt_"
+	tokenDisplayChars = 12
+
+	// 最近使用信息的刷新间隔，避免每次请求都写库
+	lastUsedRefreshInterval = time.Minute
+)
+
+var (
+	ErrInvalidToken = domain.NewError(401, "invalid api token")
+	ErrExpiredToken = domain.NewError(401, "api token has expired")
+)
+
+type apiTokenRepository interface {
+	List(ctx context.Context) ([]*domain.APIToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domain.APIToken, error)
+	Save(ctx context.Context, token *domain.APIToken) (*domain.APIToken, error)
+	DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error)
+}
+
+type certificateRepository interface {
+	GetById(ctx context.Context, id string) (*domain.Certificate, error)
+}
+
+type APITokenService struct {
+	tokenRepo       apiTokenRepository
+	certificateRepo certificateRepository
+}
+
+func NewAPITokenService(tokenRepo apiTokenRepository, certificateRepo certificateRepository) *APITokenService {
+	return &APITokenService{
+		tokenRepo:       tokenRepo,
+		certificateRepo: certificateRepo,
+	}
+}
+
+func (s *APITokenService) CreateToken(ctx context.Context, req *dtos.APITokenCreateReq) (*dtos.APITokenCreateResp, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if len(req.Scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(domain.APITokenScopeTypes, scope) {
+			return nil, fmt.Errorf("unsupported scope '%s'", scope)
+		}
+	}
+
+	// 授权中包含第三方凭据，写入授权的令牌须显式限定可修改的授权
+	if slices.Contains(req.Scopes, domain.APITokenScopeTypeAccessWrite) && len(req.AccessIds) == 0 {
+		return nil, fmt.Errorf("access ids are required for scope '%s'", domain.APITokenScopeTypeAccessWrite)
+	}
+
+	for _, entry := range req.IPAllowlist {
+		if _, ok := parseIPAllowlistEntry(entry); !ok {
+			return nil, fmt.Errorf("invalid ip allowlist entry '%s'", entry)
+		}
+	}
+
+	if !req.ExpireAt.IsZero() && req.ExpireAt.Before(time.Now()) {
+		return nil, errors.New("expire time must be in the future")
+	}
+
+	plaintext, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate api token: %w", err)
+	}
+
+	token := &domain.APIToken{
+		Name:           name,
+		TokenHash:      hashToken(plaintext),
+		TokenPrefix:    plaintext[:tokenDisplayChars],
+		Scopes:         slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		WorkflowIds:    req.WorkflowIds,
+		CertificateIds: req.CertificateIds,
+		AccessIds:      req.AccessIds,
+		IPAllowlist:    req.IPAllowlist,
+		ExpireAt:       req.ExpireAt,
+	}
+	token, err = s.tokenRepo.Save(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.APITokenCreateResp{
+		Token: plaintext,
+		Item:  token,
+	}, nil
+}
+
+func (s *APITokenService) ListTokens(ctx context.Context) (*dtos.APITokenListResp, error) {
+	tokens, err := s.tokenRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.APITokenListResp{Items: tokens}, nil
+}
+
+func (s *APITokenService) RevokeToken(ctx context.Context, req *dtos.APITokenRevokeReq) error {
+	ret, err := s.tokenRepo.DeleteWhere(ctx, dbx.HashExp{"id": req.TokenId})
+	if err != nil {
+		return err
+	} else if ret == 0 {
+		return domain.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// 校验 API 令牌是否可用于本次请求。
+// 令牌无效或已过期时返回 401 错误；来源 IP、权限范围或访问资源不符合限制时返回 403 错误。
+func (s *APITokenService) Authenticate(ctx context.Context, req *dtos.APITokenAuthenticateReq) (*domain.APIToken, error) {
+	if !strings.HasPrefix(req.Token, tokenPrefix) {
+		return nil, ErrInvalidToken
+	}
+
+	token, err := s.tokenRepo.GetByTokenHash(ctx, hashToken(req.Token))
+	if err != nil {
+		if domain.IsRecordNotFoundError(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if !token.ExpireAt.IsZero() && !now.Before(token.ExpireAt) {
+		return nil, ErrExpiredToken
+	}
+
+	if !matchIPAllowlist(token.IPAllowlist, req.ClientIp) {
+		return nil, domain.NewError(403, fmt.Sprintf("api token is not allowed from ip '%s'", req.ClientIp))
+	}
+
+	if !slices.Contains(token.Scopes, req.Scope) {
+		return nil, domain.NewError(403, fmt.Sprintf("api token does not have scope '%s'", req.Scope))
+	}
+
+	if req.WorkflowId != "" && len(token.WorkflowIds) > 0 && !slices.Contains(token.WorkflowIds, req.WorkflowId) {
+		return nil, domain.NewError(403, fmt.Sprintf("api token is not allowed to access workflow #%s", req.WorkflowId))
+	}
+
+	if req.CertificateId != "" {
+		allowed, err := s.isCertificateAllowed(ctx, token, req.CertificateId)
+		if err != nil {
+			return nil, err
+		} else if !allowed {
+			return nil, domain.NewError(403, fmt.Sprintf("api token is not allowed to access certificate #%s", req.CertificateId))
+		}
+	}
+
+	if req.AccessId != "" && !slices.Contains(token.AccessIds, req.AccessId) {
+		return nil, domain.NewError(403, fmt.Sprintf("api token is not allowed to modify access #%s", req.AccessId))
+	}
+
+	if now.Sub(token.LastUsedAt) >= lastUsedRefreshInterval || token.LastUsedIp != req.ClientIp {
+		token.LastUsedAt = now
+		token.LastUsedIp = req.ClientIp
+		if _, err := s.tokenRepo.Save(ctx, token); err != nil {
+			app.GetLogger().Warn("failed to record api token usage", "tokenId", token.Id, "err", err)
+		}
+	}
+
+	return token, nil
+}
+
+// 判断令牌是否可访问指定证书。
+// 证书须在令牌限定的证书列表中，或由令牌限定的工作流所签发；若令牌未做任何限制，则可访问全部证书。
+func (s *APITokenService) isCertificateAllowed(ctx context.Context, token *domain.APIToken, certificateId string) (bool, error) {
+	if len(token.CertificateIds) == 0 && len(token.WorkflowIds) == 0 {
+		return true, nil
+	}
+
+	if slices.Contains(token.CertificateIds, certificateId) {
+		return true, nil
+	}
+
+	if len(token.WorkflowIds) > 0 {
+		certificate, err := s.certificateRepo.GetById(ctx, certificateId)
+		if err != nil {
+			if domain.IsRecordNotFoundError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return certificate.WorkflowId != "" && slices.Contains(token.WorkflowIds, certificate.WorkflowId), nil
+	}
+
+	return false, nil
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return tokenPrefix + hex.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func parseIPAllowlistEntry(entry string) (*net.IPNet, bool) {
+	entry = strings.TrimSpace(entry)
+	if strings.Contains(entry, "/") {
+		_, ipNet, err := net.ParseCIDR(entry)
+		return ipNet, err == nil
+	}
+
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, false
+	}
+
+	bits := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, true
+}
+
+func matchIPAllowlist(allowlist []string, clientIp string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(clientIp)
+	if ip == nil {
+		return false
+	}
+
+	for _, entry := range allowlist {
+		if ipNet, ok := parseIPAllowlistEntry(entry); ok && ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package apitoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pocketbase/dbx"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+)
+
+type mockAPITokenRepository struct {
+	tokens map[string]*domain.APIToken
+}
+
+func (r *mockAPITokenRepository) List(ctx context.Context) ([]*domain.APIToken, error) {
+	return nil, nil
+}
+
+func (r *mockAPITokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.APIToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockAPITokenRepository) Save(ctx context.Context, token *domain.APIToken) (*domain.APIToken, error) {
+	if token.Id == "" {
+		token.Id = "t1"
+	}
+	r.tokens[token.Id] = token
+	return token, nil
+}
+
+func (r *mockAPITokenRepository) DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error) {
+	return 0, nil
+}
+
+type mockCertificateRepository struct {
+	certificates map[string]*domain.Certificate
+}
+
+func (r *mockCertificateRepository) GetById(ctx context.Context, id string) (*domain.Certificate, error) {
+	if certificate, ok := r.certificates[id]; ok {
+		return certificate, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func TestAPITokenService_Authenticate(t *testing.T) {
+	tokenRepo := &mockAPITokenRepository{tokens: make(map[string]*domain.APIToken)}
+	certificateRepo := &mockCertificateRepository{
+		certificates: map[string]*domain.Certificate{
+			"c1": {Meta: domain.Meta{Id: "c1"}, WorkflowId: "wf1"},
+			"c2": {Meta: domain.Meta{Id: "c2"}, WorkflowId: "wf2"},
+		},
+	}
+	service := NewAPITokenService(tokenRepo, certificateRepo)
+
+	created, err := service.CreateToken(context.Background(), &dtos.APITokenCreateReq{
+		Name:        "ci",
+		Scopes:      []domain.APITokenScopeType{domain.APITokenScopeTypeWorkflowRun, domain.APITokenScopeTypeCertificateExport, domain.APITokenScopeTypeAccessWrite},
+		WorkflowIds: []string{"wf1"},
+		AccessIds:   []string{"ac1"},
+		IPAllowlist: []string{"10.0.0.0/8", "192.168.1.1"},
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if created.Item.TokenHash == created.Token || created.Item.TokenPrefix != created.Token[:tokenDisplayChars] {
+		t.Errorf("unexpected token: %+v", created.Item)
+	}
+
+	tests := []struct {
+		name     string
+		req      *dtos.APITokenAuthenticateReq
+		wantCode int
+	}{
+		{"valid", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRun, WorkflowId: "wf1", ClientIp: "10.1.2.3"}, 0},
+		{"unknown token", &dtos.APITokenAuthenticateReq{Token: "cmt_unknown", Scope: domain.APITokenScopeTypeWorkflowRun, ClientIp: "10.1.2.3"}, 401},
+		{"ip not allowed", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRun, WorkflowId: "wf1", ClientIp: "192.168.1.2"}, 403},
+		{"single ip allowed", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRun, WorkflowId: "wf1", ClientIp: "192.168.1.1"}, 0},
+		{"scope missing", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRead, ClientIp: "10.1.2.3"}, 403},
+		{"workflow not allowed", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRun, WorkflowId: "wf2", ClientIp: "10.1.2.3"}, 403},
+		{"certificate of allowed workflow", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeCertificateExport, CertificateId: "c1", ClientIp: "10.1.2.3"}, 0},
+		{"certificate of other workflow", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeCertificateExport, CertificateId: "c2", ClientIp: "10.1.2.3"}, 403},
+		{"access allowed", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeAccessWrite, AccessId: "ac1", ClientIp: "10.1.2.3"}, 0},
+		{"access not allowed", &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeAccessWrite, AccessId: "ac2", ClientIp: "10.1.2.3"}, 403},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Authenticate(context.Background(), tt.req)
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Errorf("unexpected err: %+v", err)
+				}
+				return
+			}
+
+			if xerr, ok := err.(*domain.Error); !ok || xerr.Code != tt.wantCode {
+				t.Errorf("expected error code %d, got %v", tt.wantCode, err)
+			}
+		})
+	}
+
+	token := tokenRepo.tokens[created.Item.Id]
+	if token.LastUsedAt.IsZero() || token.LastUsedIp == "" {
+		t.Errorf("expected last use to be recorded: %+v", token)
+	}
+
+	token.ExpireAt = time.Now().Add(-time.Second)
+	if _, err := service.Authenticate(context.Background(), &dtos.APITokenAuthenticateReq{Token: created.Token, Scope: domain.APITokenScopeTypeWorkflowRun, ClientIp: "10.1.2.3"}); err != ErrExpiredToken {
+		t.Errorf("expected expired error, got %v", err)
+	}
+}
+
+func TestAPITokenService_CreateToken_Invalid(t *testing.T) {
+	service := NewAPITokenService(&mockAPITokenRepository{tokens: make(map[string]*domain.APIToken)}, nil)
+
+	tests := []struct {
+		name string
+		req  *dtos.APITokenCreateReq
+	}{
+		{"empty name", &dtos.APITokenCreateReq{Scopes: []domain.APITokenScopeType{domain.APITokenScopeTypeWorkflowRead}}},
+		{"no scopes", &dtos.APITokenCreateReq{Name: "ci"}},
+		{"unsupported scope", &dtos.APITokenCreateReq{Name: "ci", Scopes: []domain.APITokenScopeType{"workflow:delete"}}},
+		{"access write without access ids", &dtos.APITokenCreateReq{Name: "ci", Scopes: []domain.APITokenScopeType{domain.APITokenScopeTypeAccessWrite}}},
+		{"invalid ip", &dtos.APITokenCreateReq{Name: "ci", Scopes: []domain.APITokenScopeType{domain.APITokenScopeTypeWorkflowRead}, IPAllowlist: []string{"10.0.0.0/33"}}},
+		{"expired", &dtos.APITokenCreateReq{Name: "ci", Scopes: []domain.APITokenScopeType{domain.APITokenScopeTypeWorkflowRead}, ExpireAt: time.Now().Add(-time.Hour)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.CreateToken(context.Background(), tt.req); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/internal/secret"
 	xmaps "github.com/certimate-go/certimate/pkg/utils/maps"
 	xslices "github.com/certimate-go/certimate/pkg/utils/slices"
 )
@@ -76,16 +77,20 @@ func NewWithWorkflowNode(config ApplicantWithWorkflowNodeConfig) (Applicant, err
 	if nodeCfg.ProviderAccessId != "" {
 		if access, err := accessRepo.GetById(context.Background(), nodeCfg.ProviderAccessId); err != nil {
 			return nil, fmt.Errorf("failed to get access #%s record: %w", nodeCfg.ProviderAccessId, err)
+		} else if accessConfig, err := secret.ResolveConfig(context.Background(), access.Config); err != nil {
+			return nil, fmt.Errorf("failed to resolve access #%s config: %w", nodeCfg.ProviderAccessId, err)
 		} else {
-			options.ProviderAccessConfig = access.Config
+			options.ProviderAccessConfig = accessConfig
 		}
 	}
 	if nodeCfg.CAProviderAccessId != "" {
 		if access, err := accessRepo.GetById(context.Background(), nodeCfg.CAProviderAccessId); err != nil {
 			return nil, fmt.Errorf("failed to get access #%s record: %w", nodeCfg.CAProviderAccessId, err)
+		} else if accessConfig, err := secret.ResolveConfig(context.Background(), access.Config); err != nil {
+			return nil, fmt.Errorf("failed to resolve access #%s config: %w", nodeCfg.CAProviderAccessId, err)
 		} else {
 			options.CAProviderAccessId = access.Id
-			options.CAProviderAccessConfig = access.Config
+			options.CAProviderAccessConfig = accessConfig
 		}
 	}
 
@@ -109,6 +114,13 @@ func NewWithWorkflowNode(config ApplicantWithWorkflowNodeConfig) (Applicant, err
 		options.CAProviderAccessConfig = sslProviderConfig.Config[options.CAProvider]
 	}
 
+	// 非 ACME 的证书颁发机构无需 DNS-01 质询
+	if options.CAProvider == domain.CAProviderTypeVaultPKI {
+		return &applicantImpl{
+			options: options,
+		}, nil
+	}
+
 	certRepo := repository.NewCertificateRepository()
 	lastCertificate, _ := certRepo.GetByWorkflowNodeId(context.Background(), config.Node.Id)
 	if lastCertificate != nil && !lastCertificate.ACMERenewed {
@@ -151,6 +163,11 @@ func (d *applicantImpl) Apply(ctx context.Context) (*ApplyResult, error) {
 		return nil, err
 	}
 
+	switch d.options.CAProvider {
+	case domain.CAProviderTypeVaultPKI:
+		return applyUseVaultPKI(ctx, d.options)
+	}
+
 	return applyUseLego(d.applicant, d.options)
 }
 
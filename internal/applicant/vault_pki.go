@@ -0,0 +1,147 @@
+package applicant
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	vaultsdk "github.com/certimate-go/certimate/pkg/sdk3rd/vault"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+	xmaps "github.com/certimate-go/certimate/pkg/utils/maps"
+)
+
+// 通过 Vault PKI 机密引擎签发证书。
+// 私钥在本地生成，仅将 CSR 提交至 Vault 的 `sign` 端点，私钥不会经过网络传输。
+func applyUseVaultPKI(ctx context.Context, options *applicantProviderOptions) (*ApplyResult, error) {
+	if len(options.Domains) == 0 {
+		return nil, errors.New("no domains to apply")
+	}
+
+	access := domain.AccessConfigForVault{}
+	if err := xmaps.Populate(options.CAProviderAccessConfig, &access); err != nil {
+		return nil, fmt.Errorf("failed to populate ca provider access config: %w", err)
+	}
+
+	mountPath := xmaps.GetOrDefaultString(options.CAProviderServiceConfig, "pkiMountPath", "pki")
+	roleName := xmaps.GetString(options.CAProviderServiceConfig, "roleName")
+	if roleName == "" {
+		return nil, errors.New("config `roleName` of vault pki is required")
+	}
+
+	client, err := createVaultClient(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+
+	// 本地生成私钥及 CSR
+	privkey, err := generateVaultPKIPrivateKey(domain.CertificateKeyAlgorithmType(options.KeyAlgorithm))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	csrDER, err := certcrypto.CreateCSR(privkey, certcrypto.CSROptions{
+		Domain: options.Domains[0],
+		SAN:    options.Domains,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create csr: %w", err)
+	}
+
+	csrPEM := strings.TrimSpace(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})))
+
+	// 区分 DNS 名称和 IP 地址
+	altNames := make([]string, 0, len(options.Domains))
+	ipSans := make([]string, 0)
+	for _, d := range options.Domains {
+		if net.ParseIP(d) != nil {
+			ipSans = append(ipSans, d)
+		} else {
+			altNames = append(altNames, d)
+		}
+	}
+
+	signReq := &vaultsdk.PKISignRequest{
+		Csr:        csrPEM,
+		CommonName: options.Domains[0],
+		AltNames:   strings.Join(altNames, ","),
+		IPSans:     strings.Join(ipSans, ","),
+		TTL:        xmaps.GetString(options.CAProviderServiceConfig, "ttl"),
+		Format:     "pem",
+	}
+	signResp, err := client.PKISignWithContext(ctx, mountPath, roleName, signReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate via vault pki: %w", err)
+	}
+
+	// 组装完整证书链（不包含自签名的根证书）
+	chain := make([]string, 0)
+	chain = append(chain, strings.TrimSpace(signResp.Data.Certificate))
+	if len(signResp.Data.CAChain) > 0 {
+		for _, caPEM := range signResp.Data.CAChain {
+			if isSelfSignedCertificatePEM(caPEM) {
+				continue
+			}
+			chain = append(chain, strings.TrimSpace(caPEM))
+		}
+	} else if signResp.Data.IssuingCA != "" && !isSelfSignedCertificatePEM(signResp.Data.IssuingCA) {
+		chain = append(chain, strings.TrimSpace(signResp.Data.IssuingCA))
+	}
+
+	return &ApplyResult{
+		CSR:                  csrPEM,
+		FullChainCertificate: strings.Join(chain, "\n"),
+		IssuerCertificate:    strings.TrimSpace(signResp.Data.IssuingCA),
+		PrivateKey:           strings.TrimSpace(string(certcrypto.PEMEncode(privkey))),
+	}, nil
+}
+
+func createVaultClient(ctx context.Context, access domain.AccessConfigForVault) (*vaultsdk.Client, error) {
+	client, err := vaultsdk.NewClientWithOptions(&vaultsdk.ClientOptions{
+		ServerUrl:                access.ServerUrl,
+		Namespace:                access.Namespace,
+		AllowInsecureConnections: access.AllowInsecureConnections,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Login(ctx, &vaultsdk.AuthOptions{
+		Method:           access.AuthMethod,
+		Token:            access.Token,
+		AppRoleMountPath: access.AppRoleMountPath,
+		AppRoleRoleId:    access.AppRoleRoleId,
+		AppRoleSecretId:  access.AppRoleSecretId,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to login vault: %w", err)
+	}
+
+	return client, nil
+}
+
+func generateVaultPKIPrivateKey(algo domain.CertificateKeyAlgorithmType) (crypto.PrivateKey, error) {
+	// lego 未实现 P-521 曲线的私钥生成，此处单独处理
+	if algo == domain.CertificateKeyAlgorithmTypeEC512 {
+		return ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	}
+
+	return certcrypto.GeneratePrivateKey(parseLegoKeyAlgorithm(algo))
+}
+
+func isSelfSignedCertificatePEM(certPEM string) bool {
+	cert, err := xcert.ParseCertificateFromPEM(certPEM)
+	if err != nil {
+		return false
+	}
+
+	return cert.CheckSignatureFrom(cert) == nil
+}
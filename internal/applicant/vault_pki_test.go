@@ -0,0 +1,130 @@
+package applicant
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+func Test_applyUseVaultPKI(t *testing.T) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, _ := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	caCert, _ := x509.ParseCertificate(caDER)
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/v1/pki_int/sign/web" || r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		req := make(map[string]any)
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["alt_names"] != "example.com,www.example.com" || req["ip_sans"] != "10.0.0.1" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":["unexpected sans"]}`))
+			return
+		}
+
+		block, _ := pem.Decode([]byte(req["csr"].(string)))
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":["invalid csr"]}`))
+			return
+		}
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			DNSNames:     csr.DNSNames,
+			IPAddresses:  csr.IPAddresses,
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		certDER, _ := x509.CreateCertificate(rand.Reader, template, caCert, csr.PublicKey, caKey)
+		certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+
+		resp, _ := json.Marshal(map[string]any{
+			"data": map[string]any{
+				"certificate":   certPEM,
+				"issuing_ca":    caPEM,
+				"ca_chain":      []string{caPEM},
+				"serial_number": "02",
+			},
+		})
+		w.Write(resp)
+	}))
+	defer server.Close()
+
+	res, err := applyUseVaultPKI(context.Background(), &applicantProviderOptions{
+		Domains:    []string{"example.com", "www.example.com", "10.0.0.1"},
+		CAProvider: domain.CAProviderTypeVaultPKI,
+		CAProviderAccessConfig: map[string]any{
+			"serverUrl": server.URL,
+			"token":     "test-token",
+		},
+		CAProviderServiceConfig: map[string]any{
+			"pkiMountPath": "pki_int",
+			"roleName":     "web",
+		},
+		KeyAlgorithm: string(domain.CertificateKeyAlgorithmTypeEC256),
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	if strings.Count(res.FullChainCertificate, "BEGIN CERTIFICATE") != 1 {
+		t.Errorf("expected the self-signed root to be excluded from the chain, got: %s", res.FullChainCertificate)
+	}
+
+	cert, err := xcert.ParseCertificateFromPEM(res.FullChainCertificate)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if cert.Subject.CommonName != "example.com" || len(cert.DNSNames) != 2 || len(cert.IPAddresses) != 1 {
+		t.Errorf("unexpected certificate subject: %v, %v, %v", cert.Subject, cert.DNSNames, cert.IPAddresses)
+	}
+
+	privkey, err := xcert.ParsePrivateKeyFromPEM(res.PrivateKey)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if !privkey.(*ecdsa.PrivateKey).PublicKey.Equal(cert.PublicKey) {
+		t.Errorf("private key does not match the certificate")
+	}
+
+	if _, err := applyUseVaultPKI(context.Background(), &applicantProviderOptions{
+		Domains:                 []string{"example.com"},
+		CAProviderAccessConfig:  map[string]any{"serverUrl": server.URL, "token": "wrong-token"},
+		CAProviderServiceConfig: map[string]any{"pkiMountPath": "pki_int", "roleName": "web"},
+	}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,215 @@
+package certificate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/pocketbase/tools/cron"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/notify"
+	"github.com/certimate-go/certimate/pkg/core"
+	xslices "github.com/certimate-go/certimate/pkg/utils/slices"
+)
+
+const defaultExpiryDigestSchedule = "0 9 * * *"
+
+var defaultExpiryDigestThresholds = []int32{30, 14, 7, 1}
+
+type expiryDigestItem struct {
+	certificate *domain.Certificate
+	daysLeft    int32
+	threshold   int32
+}
+
+// 若当前时间符合配置的发送时间，则发送证书过期摘要通知。
+func (s *CertificateService) runExpiryDigest(ctx context.Context, now time.Time) error {
+	settings := s.getExpiryDigestSettings(ctx)
+	if settings.Disabled {
+		return nil
+	}
+
+	location := time.Local
+	if settings.Timezone != "" {
+		loc, err := time.LoadLocation(settings.Timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", settings.Timezone, err)
+		}
+		location = loc
+	}
+
+	schedule, err := cron.NewSchedule(settings.Schedule)
+	if err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", settings.Schedule, err)
+	} else if !schedule.IsDue(cron.NewMoment(now.In(location))) {
+		return nil
+	}
+
+	return s.sendExpiryDigest(ctx, settings, now.In(location))
+}
+
+func (s *CertificateService) sendExpiryDigest(ctx context.Context, settings *domain.CertificateExpiryDigestSettingsContent, now time.Time) error {
+	thresholds := normalizeExpiryThresholds(settings.Thresholds)
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	certificates, err := s.certificateRepo.ListExpireSoon(ctx, thresholds[len(thresholds)-1]+1)
+	if err != nil {
+		return fmt.Errorf("failed to get certificates which expire soon: %w", err)
+	}
+
+	// 按所属工作流分组，每张证书在跨越每个阈值时仅提醒一次
+	groups := make(map[string][]*expiryDigestItem)
+	groupKeys := make([]string, 0)
+	for _, certificate := range certificates {
+		daysLeft := int32(certificate.ExpireAt.Sub(now).Hours() / 24)
+		threshold, ok := evalExpiryThreshold(thresholds, daysLeft, certificate.ExpiryNotifiedThreshold)
+		if !ok {
+			continue
+		}
+
+		if _, ok := groups[certificate.WorkflowId]; !ok {
+			groupKeys = append(groupKeys, certificate.WorkflowId)
+		}
+		groups[certificate.WorkflowId] = append(groups[certificate.WorkflowId], &expiryDigestItem{
+			certificate: certificate,
+			daysLeft:    daysLeft,
+			threshold:   threshold,
+		})
+	}
+
+	var errs []error
+	slices.Sort(groupKeys)
+	for _, workflowId := range groupKeys {
+		items := groups[workflowId]
+
+		var workflow *domain.Workflow
+		if workflowId != "" {
+			workflow, err = s.workflowRepo.GetById(ctx, workflowId)
+			if err != nil && !domain.IsRecordNotFoundError(err) {
+				errs = append(errs, fmt.Errorf("failed to get workflow #%s: %w", workflowId, err))
+				continue
+			}
+		}
+
+		event := buildExpiryDigestEvent(workflow, items, thresholds[0], now.Location())
+		delivered, err := notify.Dispatch(ctx, event)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to send expiry digest of workflow #%s: %w", workflowId, err))
+		}
+		if !delivered {
+			// 未送达时不记录已提醒阈值，以便在配置通知规则后重新提醒
+			if err == nil {
+				app.GetLogger().Warn("no notification rule matched the certificate expiry digest", "workflowId", workflowId)
+			}
+			continue
+		}
+
+		for _, item := range items {
+			item.certificate.ExpiryNotifiedThreshold = item.threshold
+			if _, err := s.certificateRepo.Save(ctx, item.certificate); err != nil {
+				errs = append(errs, fmt.Errorf("failed to save certificate #%s: %w", item.certificate.Id, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *CertificateService) getExpiryDigestSettings(ctx context.Context) *domain.CertificateExpiryDigestSettingsContent {
+	settingsContent := &domain.CertificateExpiryDigestSettingsContent{}
+
+	settings, err := s.settingsRepo.GetByName(ctx, "certificateExpiryDigest")
+	if err == nil {
+		json.Unmarshal([]byte(settings.Content), settingsContent)
+	}
+
+	if len(settingsContent.Thresholds) == 0 {
+		settingsContent.Thresholds = defaultExpiryDigestThresholds
+	}
+	if settingsContent.Schedule == "" {
+		settingsContent.Schedule = defaultExpiryDigestSchedule
+	}
+
+	return settingsContent
+}
+
+func buildExpiryDigestEvent(workflow *domain.Workflow, items []*expiryDigestItem, minThreshold int32, location *time.Location) *notify.Event {
+	event := &notify.Event{
+		Type:    domain.NotificationEventTypeCertificateExpiring,
+		Domains: make([]string, 0),
+		Message: &core.NotifyMessage{
+			Severity: core.NotifySeverityWarning,
+			Fields:   make([]core.NotifyMessageField, 0),
+		},
+	}
+
+	if workflow != nil {
+		event.WorkflowId = workflow.Id
+		event.Tags = workflow.Tags
+		event.Message.Subject = fmt.Sprintf("工作流「%s」有 %d 张证书即将过期", workflow.Name, len(items))
+		event.Message.Fields = append(event.Message.Fields, core.NotifyMessageField{Name: "Workflow", Value: workflow.Name, Inline: true})
+	} else {
+		event.Message.Subject = fmt.Sprintf("有 %d 张证书即将过期", len(items))
+	}
+	event.Message.Fields = append(event.Message.Fields, core.NotifyMessageField{Name: "Count", Value: fmt.Sprintf("%d", len(items)), Inline: true})
+
+	slices.SortFunc(items, func(a, b *expiryDigestItem) int {
+		return a.certificate.ExpireAt.Compare(b.certificate.ExpireAt)
+	})
+
+	lines := make([]string, 0, len(items))
+	mdLines := make([]string, 0, len(items))
+	for _, item := range items {
+		domains := xslices.Filter(strings.Split(item.certificate.SubjectAltNames, ";"), func(s string) bool { return s != "" })
+		event.Domains = append(event.Domains, domains...)
+
+		issuer := item.certificate.IssuerOrg
+		if issuer == "" {
+			issuer = "-"
+		}
+
+		expireAt := item.certificate.ExpireAt.In(location).Format("2006-01-02 15:04 -07:00")
+		lines = append(lines, fmt.Sprintf("- %s\n  到期时间：%s，剩余 %d 天，颁发者：%s", strings.Join(domains, ", "), expireAt, item.daysLeft, issuer))
+		mdLines = append(mdLines, fmt.Sprintf("- **%s**  \n  到期时间：%s，剩余 %d 天，颁发者：%s", strings.Join(domains, ", "), expireAt, item.daysLeft, issuer))
+
+		if item.threshold <= minThreshold {
+			event.Message.Severity = core.NotifySeverityError
+		}
+	}
+	event.Message.Body = strings.Join(lines, "\n")
+	event.Message.Markdown = strings.Join(mdLines, "\n")
+
+	return event
+}
+
+// 计算证书剩余天数所跨越的阈值。
+// 返回值为不小于剩余天数的最小阈值；若该阈值此前已提醒过，则返回 false。
+func evalExpiryThreshold(thresholds []int32, daysLeft int32, notifiedThreshold int32) (int32, bool) {
+	for _, threshold := range thresholds {
+		if daysLeft > threshold {
+			continue
+		}
+
+		if notifiedThreshold > 0 && threshold >= notifiedThreshold {
+			return 0, false
+		}
+
+		return threshold, true
+	}
+
+	return 0, false
+}
+
+func normalizeExpiryThresholds(thresholds []int32) []int32 {
+	normalized := xslices.Filter(thresholds, func(t int32) bool { return t > 0 })
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
@@ -0,0 +1,79 @@
+package certificate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+func Test_evalExpiryThreshold(t *testing.T) {
+	thresholds := []int32{1, 7, 14, 30}
+
+	tests := []struct {
+		name              string
+		daysLeft          int32
+		notifiedThreshold int32
+		wantThreshold     int32
+		wantOk            bool
+	}{
+		{"out of thresholds", 45, 0, 0, false},
+		{"cross first threshold", 29, 0, 30, true},
+		{"already notified", 20, 30, 0, false},
+		{"cross next threshold", 13, 30, 14, true},
+		{"skip to nearest threshold", 5, 0, 7, true},
+		{"cross last threshold", 0, 7, 1, true},
+		{"last threshold already notified", 0, 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			threshold, ok := evalExpiryThreshold(thresholds, tt.daysLeft, tt.notifiedThreshold)
+			if ok != tt.wantOk || threshold != tt.wantThreshold {
+				t.Errorf("evalExpiryThreshold() = (%v, %v), want (%v, %v)", threshold, ok, tt.wantThreshold, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_normalizeExpiryThresholds(t *testing.T) {
+	actual := normalizeExpiryThresholds([]int32{30, 7, 0, 14, 7, -1, 1})
+	if len(actual) != 4 || actual[0] != 1 || actual[1] != 7 || actual[2] != 14 || actual[3] != 30 {
+		t.Errorf("unexpected thresholds: %v", actual)
+	}
+}
+
+func Test_buildExpiryDigestEvent(t *testing.T) {
+	workflow := &domain.Workflow{Meta: domain.Meta{Id: "wf1"}, Name: "example", Tags: []string{"prod"}}
+	items := []*expiryDigestItem{
+		{
+			certificate: &domain.Certificate{SubjectAltNames: "b.example.com", IssuerOrg: "Let's Encrypt", ExpireAt: time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)},
+			daysLeft:    13,
+			threshold:   14,
+		},
+		{
+			certificate: &domain.Certificate{SubjectAltNames: "a.example.com;example.com", ExpireAt: time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC)},
+			daysLeft:    3,
+			threshold:   7,
+		},
+	}
+
+	event := buildExpiryDigestEvent(workflow, items, 1, time.UTC)
+	if event.Type != domain.NotificationEventTypeCertificateExpiring || event.WorkflowId != "wf1" || len(event.Tags) != 1 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if len(event.Domains) != 3 {
+		t.Errorf("unexpected domains: %v", event.Domains)
+	}
+	if event.Message.Severity != core.NotifySeverityWarning {
+		t.Errorf("unexpected severity: %v", event.Message.Severity)
+	}
+	if !strings.HasPrefix(event.Message.Body, "- a.example.com, example.com\n  到期时间：2025-07-10 00:00 +00:00，剩余 3 天，颁发者：-") {
+		t.Errorf("unexpected body: %q", event.Message.Body)
+	}
+	if !strings.Contains(event.Message.Body, "颁发者：Let's Encrypt") {
+		t.Errorf("unexpected body: %q", event.Message.Body)
+	}
+}
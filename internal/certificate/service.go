@@ -6,7 +6,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -16,54 +15,43 @@ import (
 	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
-	"github.com/certimate-go/certimate/internal/notify"
-	"github.com/certimate-go/certimate/internal/repository"
 	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
 )
 
-const (
-	defaultExpireSubject = "有 ${COUNT} 张证书即将过期"
-	defaultExpireMessage = "有 ${COUNT} 张证书即将过期，域名分别为 ${DOMAINS}，请保持关注！"
-)
-
 type certificateRepository interface {
-	ListExpireSoon(ctx context.Context) ([]*domain.Certificate, error)
+	ListExpireSoon(ctx context.Context, withinDays int32) ([]*domain.Certificate, error)
 	GetById(ctx context.Context, id string) (*domain.Certificate, error)
+	Save(ctx context.Context, certificate *domain.Certificate) (*domain.Certificate, error)
 	DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error)
 }
 
+type workflowRepository interface {
+	GetById(ctx context.Context, id string) (*domain.Workflow, error)
+}
+
 type settingsRepository interface {
 	GetByName(ctx context.Context, name string) (*domain.Settings, error)
 }
 
 type CertificateService struct {
 	certificateRepo certificateRepository
+	workflowRepo    workflowRepository
 	settingsRepo    settingsRepository
 }
 
-func NewCertificateService(certificateRepo certificateRepository, settingsRepo settingsRepository) *CertificateService {
+func NewCertificateService(certificateRepo certificateRepository, workflowRepo workflowRepository, settingsRepo settingsRepository) *CertificateService {
 	return &CertificateService{
 		certificateRepo: certificateRepo,
+		workflowRepo:    workflowRepo,
 		settingsRepo:    settingsRepo,
 	}
 }
 
 func (s *CertificateService) InitSchedule(ctx context.Context) error {
-	// 每日发送过期证书提醒
-	app.GetScheduler().MustAdd("certificateExpireSoonNotify", "0 0 * * *", func() {
-		certificates, err := s.certificateRepo.ListExpireSoon(context.Background())
-		if err != nil {
-			app.GetLogger().Error("failed to get certificates which expire soon", "err", err)
-			return
-		}
-
-		notification := buildExpireSoonNotification(certificates)
-		if notification == nil {
-			return
-		}
-
-		if err := notify.SendToAllChannels(notification.Subject, notification.Message); err != nil {
-			app.GetLogger().Error("failed to send notification", "err", err)
+	// 按配置的发送时间发送证书过期摘要通知
+	app.GetScheduler().MustAdd("certificateExpiryDigest", "* * * * *", func() {
+		if err := s.runExpiryDigest(context.Background(), time.Now()); err != nil {
+			app.GetLogger().Error("failed to send certificate expiry digest", "err", err)
 		}
 	})
 
@@ -95,6 +83,31 @@ func (s *CertificateService) InitSchedule(ctx context.Context) error {
 	return nil
 }
 
+func (s *CertificateService) GetCertificate(ctx context.Context, req *dtos.CertificateGetReq) (*dtos.CertificateGetResp, error) {
+	certificate, err := s.certificateRepo.GetById(ctx, req.CertificateId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 不返回私钥，导出私钥需通过归档接口
+	return &dtos.CertificateGetResp{
+		Id:                certificate.Id,
+		Source:            certificate.Source,
+		SubjectAltNames:   certificate.SubjectAltNames,
+		SerialNumber:      certificate.SerialNumber,
+		IssuerOrg:         certificate.IssuerOrg,
+		KeyAlgorithm:      certificate.KeyAlgorithm,
+		Certificate:       certificate.Certificate,
+		IssuerCertificate: certificate.IssuerCertificate,
+		EffectAt:          certificate.EffectAt,
+		ExpireAt:          certificate.ExpireAt,
+		WorkflowId:        certificate.WorkflowId,
+		WorkflowRunId:     certificate.WorkflowRunId,
+		CreatedAt:         certificate.CreatedAt,
+		UpdatedAt:         certificate.UpdatedAt,
+	}, nil
+}
+
 func (s *CertificateService) ArchiveFile(ctx context.Context, req *dtos.CertificateArchiveFileReq) (*dtos.CertificateArchiveFileResp, error) {
 	certificate, err := s.certificateRepo.GetById(ctx, req.CertificateId)
 	if err != nil {
@@ -246,47 +259,3 @@ func (s *CertificateService) ValidatePrivateKey(ctx context.Context, req *dtos.C
 		IsValid: true,
 	}, nil
 }
-
-func buildExpireSoonNotification(certificates []*domain.Certificate) *struct {
-	Subject string
-	Message string
-} {
-	if len(certificates) == 0 {
-		return nil
-	}
-
-	subject := defaultExpireSubject
-	message := defaultExpireMessage
-
-	// 查询模板信息
-	settingsRepo := repository.NewSettingsRepository()
-	settings, err := settingsRepo.GetByName(context.Background(), "notifyTemplates")
-	if err == nil {
-		var templates *domain.NotifyTemplatesSettingsContent
-		json.Unmarshal([]byte(settings.Content), &templates)
-
-		if templates != nil && len(templates.NotifyTemplates) > 0 {
-			subject = templates.NotifyTemplates[0].Subject
-			message = templates.NotifyTemplates[0].Message
-		}
-	}
-
-	// 替换变量
-	count := len(certificates)
-	domains := make([]string, count)
-	for i, record := range certificates {
-		domains[i] = record.SubjectAltNames
-	}
-	countStr := strconv.Itoa(count)
-	domainStr := strings.Join(domains, ";")
-	subject = strings.ReplaceAll(subject, "${COUNT}", countStr)
-	subject = strings.ReplaceAll(subject, "${DOMAINS}", domainStr)
-	message = strings.ReplaceAll(message, "${COUNT}", countStr)
-	message = strings.ReplaceAll(message, "${DOMAINS}", domainStr)
-
-	// 返回消息
-	return &struct {
-		Subject string
-		Message string
-	}{Subject: subject, Message: message}
-}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/internal/secret"
 	"github.com/certimate-go/certimate/pkg/core"
 )
 
@@ -41,8 +42,13 @@ func NewWithWorkflowNode(config DeployerWithWorkflowNodeConfig) (Deployer, error
 		access, err := accessRepo.GetById(context.Background(), nodeCfg.ProviderAccessId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get access #%s record: %w", nodeCfg.ProviderAccessId, err)
+		}
+
+		accessConfig, err := secret.ResolveConfig(context.Background(), access.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve access #%s config: %w", nodeCfg.ProviderAccessId, err)
 		} else {
-			options.ProviderAccessConfig = access.Config
+			options.ProviderAccessConfig = accessConfig
 		}
 	}
 
@@ -42,6 +42,7 @@ import (
 	pBunnyCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/bunny-cdn"
 	pBytePlusCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/byteplus-cdn"
 	pCacheFly "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/cachefly"
+	pCaddy "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/caddy"
 	pCdnfly "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/cdnfly"
 	pCTCCCloudAO "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ctcccloud-ao"
 	pCTCCCloudCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ctcccloud-cdn"
@@ -49,11 +50,14 @@ import (
 	pCTCCCloudELB "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ctcccloud-elb"
 	pCTCCCloudICDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ctcccloud-icdn"
 	pCTCCCloudLVDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ctcccloud-lvdn"
+	pDocker "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/docker"
 	pDogeCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/dogecloud-cdn"
 	pEdgioApplications "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/edgio-applications"
+	pFileDrop "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/filedrop"
 	pFlexCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/flexcdn"
 	pGcoreCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/gcore-cdn"
 	pGoEdge "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/goedge"
+	pHAProxy "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/haproxy"
 	pHuaweiCloudCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/huaweicloud-cdn"
 	pHuaweiCloudELB "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/huaweicloud-elb"
 	pHuaweiCloudSCM "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/huaweicloud-scm"
@@ -62,11 +66,14 @@ import (
 	pJDCloudCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/jdcloud-cdn"
 	pJDCloudLive "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/jdcloud-live"
 	pJDCloudVOD "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/jdcloud-vod"
+	pK8sIngress "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/k8s-ingress"
 	pK8sSecret "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/k8s-secret"
 	pKong "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/kong"
 	pLeCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/lecdn"
 	pLocal "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/local"
 	pNetlifySite "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/netlify-site"
+	pOPNsense "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/opnsense"
+	pPfSense "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/pfsense"
 	pProxmoxVE "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/proxmoxve"
 	pQiniuCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/qiniu-cdn"
 	pQiniuPili "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/qiniu-pili"
@@ -75,6 +82,7 @@ import (
 	pRatPanelSite "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ratpanel-site"
 	pSafeLine "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/safeline"
 	pSSH "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ssh"
+	pSynologyDSM "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/synology-dsm"
 	pTencentCloudCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-cdn"
 	pTencentCloudCLB "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-clb"
 	pTencentCloudCOS "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-cos"
@@ -88,10 +96,13 @@ import (
 	pTencentCloudSSLUpdate "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-ssl-update"
 	pTencentCloudVOD "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-vod"
 	pTencentCloudWAF "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/tencentcloud-waf"
+	pTraefik "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/traefik"
+	pTrueNAS "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/truenas"
 	pUCloudUCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ucloud-ucdn"
 	pUCloudUS3 "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/ucloud-us3"
 	pUniCloudWebHost "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/unicloud-webhost"
 	pUpyunCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/upyun-cdn"
+	pVaultKV "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/vault-kv"
 	pVolcEngineALB "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/volcengine-alb"
 	pVolcEngineCDN "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/volcengine-cdn"
 	pVolcEngineCertCenter "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/volcengine-certcenter"
@@ -104,9 +115,12 @@ import (
 	pWangsuCDNPro "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/wangsu-cdnpro"
 	pWangsuCertificate "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/wangsu-certificate"
 	pWebhook "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/webhook"
+	pWebServer "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/webserver"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
 	xhttp "github.com/certimate-go/certimate/pkg/utils/http"
 	xmaps "github.com/certimate-go/certimate/pkg/utils/maps"
 	xslices "github.com/certimate-go/certimate/pkg/utils/slices"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
 )
 
 type deployerProviderOptions struct {
@@ -610,6 +624,22 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			return deployer, err
 		}
 
+	case domain.DeploymentProviderTypeCaddy:
+		{
+			access := domain.AccessConfigForCaddy{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pCaddy.NewSSLDeployerProvider(&pCaddy.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				LoadMode:                 pCaddy.LoadModeType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "loadMode", string(pCaddy.LOAD_MODE_LOAD_PEM))),
+				CertificateTag:           xmaps.GetString(options.ProviderServiceConfig, "certificateTag"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeCdnfly:
 		{
 			access := domain.AccessConfigForCdnfly{}
@@ -692,6 +722,28 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			}
 		}
 
+	case domain.DeploymentProviderTypeDocker:
+		{
+			access := domain.AccessConfigForDocker{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pDocker.NewSSLDeployerProvider(&pDocker.SSLDeployerProviderConfig{
+				DockerHost:               access.DockerHost,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				ContainerLabels:          xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "containerLabels"), ";"), func(s string) bool { return s != "" }),
+				WriteMode:                pDocker.WriteModeType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "writeMode", string(pDocker.WRITE_MODE_ARCHIVE))),
+				OutputCertPath:           xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputKeyPath:            xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				ReloadMode:               pDocker.ReloadModeType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "reloadMode", string(pDocker.RELOAD_MODE_NONE))),
+				ReloadSignal:             xmaps.GetString(options.ProviderServiceConfig, "reloadSignal"),
+				ReloadCommand:            xmaps.GetString(options.ProviderServiceConfig, "reloadCommand"),
+				RestartTimeout:           xmaps.GetInt32(options.ProviderServiceConfig, "restartTimeout"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeDogeCloudCDN:
 		{
 			access := domain.AccessConfigForDogeCloud{}
@@ -741,6 +793,34 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			return deployer, err
 		}
 
+	case domain.DeploymentProviderTypeFTP:
+		{
+			access := domain.AccessConfigForFTP{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pFileDrop.NewSSLDeployerProvider(&pFileDrop.SSLDeployerProviderConfig{
+				Transport:                pFileDrop.TRANSPORT_FTP,
+				FtpHost:                  access.Host,
+				FtpPort:                  access.Port,
+				FtpUsername:              access.Username,
+				FtpPassword:              access.Password,
+				FtpTLSMode:               access.TLSMode,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				OutputFormat:             xcert.OutputFormatType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "format", string(xcert.OUTPUT_FORMAT_PEM))),
+				OutputCertPath:           xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputServerCertPath:     xmaps.GetString(options.ProviderServiceConfig, "certPathForServerOnly"),
+				OutputIntermediaCertPath: xmaps.GetString(options.ProviderServiceConfig, "certPathForIntermediaOnly"),
+				OutputKeyPath:            xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				PfxPassword:              xmaps.GetString(options.ProviderServiceConfig, "pfxPassword"),
+				JksAlias:                 xmaps.GetString(options.ProviderServiceConfig, "jksAlias"),
+				JksKeypass:               xmaps.GetString(options.ProviderServiceConfig, "jksKeypass"),
+				JksStorepass:             xmaps.GetString(options.ProviderServiceConfig, "jksStorepass"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeGcoreCDN:
 		{
 			access := domain.AccessConfigForGcore{}
@@ -781,6 +861,21 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			return deployer, err
 		}
 
+	case domain.DeploymentProviderTypeHAProxy:
+		{
+			access := domain.AccessConfigForHAProxy{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pHAProxy.NewSSLDeployerProvider(&pHAProxy.SSLDeployerProviderConfig{
+				RuntimeApiAddress: access.RuntimeApiAddress,
+				CertificateName:   xmaps.GetString(options.ProviderServiceConfig, "certificateName"),
+				OutputCertPath:    xmaps.GetString(options.ProviderServiceConfig, "outputCertPath"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeHuaweiCloudCDN, domain.DeploymentProviderTypeHuaweiCloudELB, domain.DeploymentProviderTypeHuaweiCloudSCM, domain.DeploymentProviderTypeHuaweiCloudWAF:
 		{
 			access := domain.AccessConfigForHuaweiCloud{}
@@ -921,6 +1016,40 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 				JksAlias:                 xmaps.GetString(options.ProviderServiceConfig, "jksAlias"),
 				JksKeypass:               xmaps.GetString(options.ProviderServiceConfig, "jksKeypass"),
 				JksStorepass:             xmaps.GetString(options.ProviderServiceConfig, "jksStorepass"),
+				AtomicWrite:              xmaps.GetBool(options.ProviderServiceConfig, "atomicWrite"),
+				BackupEnabled:            xmaps.GetBool(options.ProviderServiceConfig, "backupEnabled"),
+				BackupDir:                xmaps.GetString(options.ProviderServiceConfig, "backupDir"),
+				FileMode:                 xmaps.GetString(options.ProviderServiceConfig, "fileMode"),
+				FileOwner:                xmaps.GetString(options.ProviderServiceConfig, "fileOwner"),
+				RollbackCommand:          xmaps.GetString(options.ProviderServiceConfig, "rollbackCommand"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeLocalApache, domain.DeploymentProviderTypeLocalNginx:
+		{
+			serverType := pWebServer.SERVER_TYPE_NGINX
+			if options.Provider == domain.DeploymentProviderTypeLocalApache {
+				serverType = pWebServer.SERVER_TYPE_APACHE
+			}
+
+			deployer, err := pWebServer.NewSSLDeployerProvider(&pWebServer.SSLDeployerProviderConfig{
+				ServerType:    serverType,
+				ConfigPath:    xmaps.GetString(options.ProviderServiceConfig, "configPath"),
+				ServerNames:   xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "serverNames"), ";"), func(s string) bool { return s != "" }),
+				TestCommand:   xmaps.GetString(options.ProviderServiceConfig, "testCommand"),
+				ReloadCommand: xmaps.GetString(options.ProviderServiceConfig, "reloadCommand"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeLocalTraefik:
+		{
+			deployer, err := pTraefik.NewSSLDeployerProvider(&pTraefik.SSLDeployerProviderConfig{
+				DynamicConfigPath: xmaps.GetString(options.ProviderServiceConfig, "dynamicConfigPath"),
+				OutputCertPath:    xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputKeyPath:     xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				TlsStores:         xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "tlsStores"), ";"), func(s string) bool { return s != "" }),
 			})
 			return deployer, err
 		}
@@ -943,6 +1072,28 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			return deployer, err
 		}
 
+	case domain.DeploymentProviderTypeKubernetesIngress:
+		{
+			access := domain.AccessConfigForKubernetes{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			resourceTypes := make([]pK8sIngress.ResourceType, 0)
+			for _, resourceType := range xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "resourceTypes"), ";"), func(s string) bool { return s != "" }) {
+				resourceTypes = append(resourceTypes, pK8sIngress.ResourceType(resourceType))
+			}
+
+			deployer, err := pK8sIngress.NewSSLDeployerProvider(&pK8sIngress.SSLDeployerProviderConfig{
+				KubeConfig:      access.KubeConfig,
+				Namespaces:      xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "namespaces"), ";"), func(s string) bool { return s != "" }),
+				ResourceTypes:   resourceTypes,
+				PatchIngressTLS: xmaps.GetBool(options.ProviderServiceConfig, "patchIngressTLS"),
+				SecretName:      xmaps.GetString(options.ProviderServiceConfig, "secretName"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeKubernetesSecret:
 		{
 			access := domain.AccessConfigForKubernetes{}
@@ -975,6 +1126,41 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			return deployer, err
 		}
 
+	case domain.DeploymentProviderTypeOPNsense:
+		{
+			access := domain.AccessConfigForOPNsense{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pOPNsense.NewSSLDeployerProvider(&pOPNsense.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				ApiSecret:                access.ApiSecret,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateDesc:          xmaps.GetOrDefaultString(options.ProviderServiceConfig, "certificateDesc", "certimate"),
+				RestartServices:          xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "restartServices"), ";"), func(s string) bool { return s != "" }),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypePfSense:
+		{
+			access := domain.AccessConfigForPfSense{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pPfSense.NewSSLDeployerProvider(&pPfSense.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateDesc:          xmaps.GetOrDefaultString(options.ProviderServiceConfig, "certificateDesc", "certimate"),
+				RestartServices:          xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "restartServices"), ";"), func(s string) bool { return s != "" }),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeProxmoxVE:
 		{
 			access := domain.AccessConfigForProxmoxVE{}
@@ -1076,6 +1262,35 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			}
 		}
 
+	case domain.DeploymentProviderTypeS3:
+		{
+			access := domain.AccessConfigForS3{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pFileDrop.NewSSLDeployerProvider(&pFileDrop.SSLDeployerProviderConfig{
+				Transport:                pFileDrop.TRANSPORT_S3,
+				S3Endpoint:               access.Endpoint,
+				S3AccessKey:              access.AccessKey,
+				S3SecretKey:              access.SecretKey,
+				S3Region:                 access.Region,
+				S3Bucket:                 xmaps.GetString(options.ProviderServiceConfig, "bucket"),
+				S3VirtualHostedStyle:     access.VirtualHostedStyle,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				OutputFormat:             xcert.OutputFormatType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "format", string(xcert.OUTPUT_FORMAT_PEM))),
+				OutputCertPath:           xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputServerCertPath:     xmaps.GetString(options.ProviderServiceConfig, "certPathForServerOnly"),
+				OutputIntermediaCertPath: xmaps.GetString(options.ProviderServiceConfig, "certPathForIntermediaOnly"),
+				OutputKeyPath:            xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				PfxPassword:              xmaps.GetString(options.ProviderServiceConfig, "pfxPassword"),
+				JksAlias:                 xmaps.GetString(options.ProviderServiceConfig, "jksAlias"),
+				JksKeypass:               xmaps.GetString(options.ProviderServiceConfig, "jksKeypass"),
+				JksStorepass:             xmaps.GetString(options.ProviderServiceConfig, "jksStorepass"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeSafeLine:
 		{
 			access := domain.AccessConfigForSafeLine{}
@@ -1100,16 +1315,20 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
 
-			jumpServers := make([]pSSH.JumpServerConfig, len(access.JumpServers))
-			for i, jumpServer := range access.JumpServers {
-				jumpServers[i] = pSSH.JumpServerConfig{
-					SshHost:          jumpServer.Host,
-					SshPort:          jumpServer.Port,
-					SshAuthMethod:    jumpServer.AuthMethod,
-					SshUsername:      jumpServer.Username,
-					SshPassword:      jumpServer.Password,
-					SshKey:           jumpServer.Key,
-					SshKeyPassphrase: jumpServer.KeyPassphrase,
+			jumpServers := buildJumpServerConfigs(access)
+
+			hosts := make([]pSSH.HostConfig, len(access.Hosts))
+			for i, host := range access.Hosts {
+				hosts[i] = pSSH.HostConfig{
+					Name:             host.Name,
+					Groups:           host.Groups,
+					SshHost:          host.Host,
+					SshPort:          host.Port,
+					SshAuthMethod:    host.AuthMethod,
+					SshUsername:      host.Username,
+					SshPassword:      host.Password,
+					SshKey:           host.Key,
+					SshKeyPassphrase: host.KeyPassphrase,
 				}
 			}
 
@@ -1122,6 +1341,12 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 				SshKey:                   access.Key,
 				SshKeyPassphrase:         access.KeyPassphrase,
 				JumpServers:              jumpServers,
+				Hosts:                    hosts,
+				HostGroup:                xmaps.GetString(options.ProviderServiceConfig, "hostGroup"),
+				RollingBatchSize:         xmaps.GetInt32(options.ProviderServiceConfig, "rollingBatchSize"),
+				RollingPauseSeconds:      xmaps.GetInt32(options.ProviderServiceConfig, "rollingPauseSeconds"),
+				RollingMaxFailures:       xmaps.GetInt32(options.ProviderServiceConfig, "rollingMaxFailures"),
+				HealthCheckCommand:       xmaps.GetString(options.ProviderServiceConfig, "healthCheckCommand"),
 				UseSCP:                   xmaps.GetBool(options.ProviderServiceConfig, "useSCP"),
 				PreCommand:               xmaps.GetString(options.ProviderServiceConfig, "preCommand"),
 				PostCommand:              xmaps.GetString(options.ProviderServiceConfig, "postCommand"),
@@ -1134,6 +1359,156 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 				JksAlias:                 xmaps.GetString(options.ProviderServiceConfig, "jksAlias"),
 				JksKeypass:               xmaps.GetString(options.ProviderServiceConfig, "jksKeypass"),
 				JksStorepass:             xmaps.GetString(options.ProviderServiceConfig, "jksStorepass"),
+				AtomicWrite:              xmaps.GetBool(options.ProviderServiceConfig, "atomicWrite"),
+				BackupEnabled:            xmaps.GetBool(options.ProviderServiceConfig, "backupEnabled"),
+				BackupDir:                xmaps.GetString(options.ProviderServiceConfig, "backupDir"),
+				FileMode:                 xmaps.GetString(options.ProviderServiceConfig, "fileMode"),
+				FileOwner:                xmaps.GetString(options.ProviderServiceConfig, "fileOwner"),
+				RollbackCommand:          xmaps.GetString(options.ProviderServiceConfig, "rollbackCommand"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeSSHApache, domain.DeploymentProviderTypeSSHNginx:
+		{
+			access := domain.AccessConfigForSSH{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			jumpServers := buildJumpServerConfigs(access)
+
+			serverType := pWebServer.SERVER_TYPE_NGINX
+			if options.Provider == domain.DeploymentProviderTypeSSHApache {
+				serverType = pWebServer.SERVER_TYPE_APACHE
+			}
+
+			deployer, err := pWebServer.NewSSLDeployerProvider(&pWebServer.SSLDeployerProviderConfig{
+				ServerType:       serverType,
+				UseSSH:           true,
+				SshHost:          access.Host,
+				SshPort:          access.Port,
+				SshAuthMethod:    access.AuthMethod,
+				SshUsername:      access.Username,
+				SshPassword:      access.Password,
+				SshKey:           access.Key,
+				SshKeyPassphrase: access.KeyPassphrase,
+				JumpServers:      jumpServers,
+				UseSCP:           xmaps.GetBool(options.ProviderServiceConfig, "useSCP"),
+				ConfigPath:       xmaps.GetString(options.ProviderServiceConfig, "configPath"),
+				ServerNames:      xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "serverNames"), ";"), func(s string) bool { return s != "" }),
+				TestCommand:      xmaps.GetString(options.ProviderServiceConfig, "testCommand"),
+				ReloadCommand:    xmaps.GetString(options.ProviderServiceConfig, "reloadCommand"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeSSHDocker:
+		{
+			access := domain.AccessConfigForSSH{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			jumpServers := buildJumpServerConfigs(access)
+
+			deployer, err := pDocker.NewSSLDeployerProvider(&pDocker.SSLDeployerProviderConfig{
+				DockerHost:       xmaps.GetString(options.ProviderServiceConfig, "dockerHost"),
+				UseSSH:           true,
+				SshHost:          access.Host,
+				SshPort:          access.Port,
+				SshAuthMethod:    access.AuthMethod,
+				SshUsername:      access.Username,
+				SshPassword:      access.Password,
+				SshKey:           access.Key,
+				SshKeyPassphrase: access.KeyPassphrase,
+				JumpServers:      jumpServers,
+				ContainerLabels:  xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "containerLabels"), ";"), func(s string) bool { return s != "" }),
+				WriteMode:        pDocker.WriteModeType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "writeMode", string(pDocker.WRITE_MODE_ARCHIVE))),
+				OutputCertPath:   xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputKeyPath:    xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				ReloadMode:       pDocker.ReloadModeType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "reloadMode", string(pDocker.RELOAD_MODE_NONE))),
+				ReloadSignal:     xmaps.GetString(options.ProviderServiceConfig, "reloadSignal"),
+				ReloadCommand:    xmaps.GetString(options.ProviderServiceConfig, "reloadCommand"),
+				RestartTimeout:   xmaps.GetInt32(options.ProviderServiceConfig, "restartTimeout"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeSSHSFTP:
+		{
+			access := domain.AccessConfigForSSH{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			jumpServers := buildJumpServerConfigs(access)
+
+			deployer, err := pFileDrop.NewSSLDeployerProvider(&pFileDrop.SSLDeployerProviderConfig{
+				Transport:                pFileDrop.TRANSPORT_SFTP,
+				SshHost:                  access.Host,
+				SshPort:                  access.Port,
+				SshAuthMethod:            access.AuthMethod,
+				SshUsername:              access.Username,
+				SshPassword:              access.Password,
+				SshKey:                   access.Key,
+				SshKeyPassphrase:         access.KeyPassphrase,
+				JumpServers:              jumpServers,
+				OutputFormat:             xcert.OutputFormatType(xmaps.GetOrDefaultString(options.ProviderServiceConfig, "format", string(xcert.OUTPUT_FORMAT_PEM))),
+				OutputCertPath:           xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputServerCertPath:     xmaps.GetString(options.ProviderServiceConfig, "certPathForServerOnly"),
+				OutputIntermediaCertPath: xmaps.GetString(options.ProviderServiceConfig, "certPathForIntermediaOnly"),
+				OutputKeyPath:            xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				PfxPassword:              xmaps.GetString(options.ProviderServiceConfig, "pfxPassword"),
+				JksAlias:                 xmaps.GetString(options.ProviderServiceConfig, "jksAlias"),
+				JksKeypass:               xmaps.GetString(options.ProviderServiceConfig, "jksKeypass"),
+				JksStorepass:             xmaps.GetString(options.ProviderServiceConfig, "jksStorepass"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeSSHTraefik:
+		{
+			access := domain.AccessConfigForSSH{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			jumpServers := buildJumpServerConfigs(access)
+
+			deployer, err := pTraefik.NewSSLDeployerProvider(&pTraefik.SSLDeployerProviderConfig{
+				UseSSH:            true,
+				SshHost:           access.Host,
+				SshPort:           access.Port,
+				SshAuthMethod:     access.AuthMethod,
+				SshUsername:       access.Username,
+				SshPassword:       access.Password,
+				SshKey:            access.Key,
+				SshKeyPassphrase:  access.KeyPassphrase,
+				JumpServers:       jumpServers,
+				UseSCP:            xmaps.GetBool(options.ProviderServiceConfig, "useSCP"),
+				DynamicConfigPath: xmaps.GetString(options.ProviderServiceConfig, "dynamicConfigPath"),
+				OutputCertPath:    xmaps.GetString(options.ProviderServiceConfig, "certPath"),
+				OutputKeyPath:     xmaps.GetString(options.ProviderServiceConfig, "keyPath"),
+				TlsStores:         xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "tlsStores"), ";"), func(s string) bool { return s != "" }),
+			})
+			return deployer, err
+		}
+
+	case domain.DeploymentProviderTypeSynologyDSM:
+		{
+			access := domain.AccessConfigForSynologyDSM{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pSynologyDSM.NewSSLDeployerProvider(&pSynologyDSM.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateDesc:          xmaps.GetString(options.ProviderServiceConfig, "certificateDesc"),
+				AsDefault:                xmaps.GetBool(options.ProviderServiceConfig, "asDefault"),
 			})
 			return deployer, err
 		}
@@ -1284,6 +1659,23 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			}
 		}
 
+	case domain.DeploymentProviderTypeTrueNAS:
+		{
+			access := domain.AccessConfigForTrueNAS{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pTrueNAS.NewSSLDeployerProvider(&pTrueNAS.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				SetAsUICertificate:       xmaps.GetBool(options.ProviderServiceConfig, "setAsUICertificate"),
+				AutoRestart:              xmaps.GetBool(options.ProviderServiceConfig, "autoRestart"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeUCloudUCDN, domain.DeploymentProviderTypeUCloudUS3:
 		{
 			access := domain.AccessConfigForUCloud{}
@@ -1355,6 +1747,32 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 			}
 		}
 
+	case domain.DeploymentProviderTypeVaultKV:
+		{
+			access := domain.AccessConfigForVault{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pVaultKV.NewSSLDeployerProvider(&pVaultKV.SSLDeployerProviderConfig{
+				ServerUrl:                access.ServerUrl,
+				Namespace:                access.Namespace,
+				AuthMethod:               pVaultKV.AuthMethodType(access.AuthMethod),
+				Token:                    access.Token,
+				AppRoleMountPath:         access.AppRoleMountPath,
+				AppRoleRoleId:            access.AppRoleRoleId,
+				AppRoleSecretId:          access.AppRoleSecretId,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				KVMountPath:              xmaps.GetString(options.ProviderServiceConfig, "kvMountPath"),
+				KVVersion:                xmaps.GetInt32(options.ProviderServiceConfig, "kvVersion"),
+				SecretPath:               xmaps.GetString(options.ProviderServiceConfig, "secretPath"),
+				FieldNameCertificate:     xmaps.GetString(options.ProviderServiceConfig, "fieldNameCertificate"),
+				FieldNameChain:           xmaps.GetString(options.ProviderServiceConfig, "fieldNameChain"),
+				FieldNamePrivateKey:      xmaps.GetString(options.ProviderServiceConfig, "fieldNamePrivateKey"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeploymentProviderTypeVolcEngineALB, domain.DeploymentProviderTypeVolcEngineCDN, domain.DeploymentProviderTypeVolcEngineCertCenter, domain.DeploymentProviderTypeVolcEngineCLB, domain.DeploymentProviderTypeVolcEngineDCDN, domain.DeploymentProviderTypeVolcEngineImageX, domain.DeploymentProviderTypeVolcEngineLive, domain.DeploymentProviderTypeVolcEngineTOS:
 		{
 			access := domain.AccessConfigForVolcEngine{}
@@ -1524,3 +1942,20 @@ func createSSLDeployerProvider(options *deployerProviderOptions) (core.SSLDeploy
 
 	return nil, fmt.Errorf("unsupported deployer provider '%s'", string(options.Provider))
 }
+
+func buildJumpServerConfigs(access domain.AccessConfigForSSH) []xssh.JumpServerConfig {
+	jumpServers := make([]xssh.JumpServerConfig, len(access.JumpServers))
+	for i, jumpServer := range access.JumpServers {
+		jumpServers[i] = xssh.JumpServerConfig{
+			SshHost:          jumpServer.Host,
+			SshPort:          jumpServer.Port,
+			SshAuthMethod:    jumpServer.AuthMethod,
+			SshUsername:      jumpServer.Username,
+			SshPassword:      jumpServer.Password,
+			SshKey:           jumpServer.Key,
+			SshKeyPassphrase: jumpServer.KeyPassphrase,
+		}
+	}
+
+	return jumpServers
+}
@@ -93,6 +93,11 @@ type AccessConfigForCacheFly struct {
 	ApiToken string `json:"apiToken"`
 }
 
+type AccessConfigForCaddy struct {
+	ServerUrl                string `json:"serverUrl"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForCdnfly struct {
 	ServerUrl                string `json:"serverUrl"`
 	ApiKey                   string `json:"apiKey"`
@@ -148,6 +153,11 @@ type AccessConfigForDNSLA struct {
 	ApiSecret string `json:"apiSecret"`
 }
 
+type AccessConfigForDocker struct {
+	DockerHost               string `json:"dockerHost,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForDogeCloud struct {
 	AccessKey string `json:"accessKey"`
 	SecretKey string `json:"secretKey"`
@@ -185,6 +195,15 @@ type AccessConfigForFlexCDN struct {
 	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
 }
 
+type AccessConfigForFTP struct {
+	Host                     string `json:"host"`
+	Port                     int32  `json:"port,omitempty"`
+	Username                 string `json:"username,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	TLSMode                  string `json:"tlsMode,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForGcore struct {
 	ApiToken string `json:"apiToken"`
 }
@@ -212,6 +231,10 @@ type AccessConfigForGoogleTrustServices struct {
 	EabHmacKey string `json:"eabHmacKey"`
 }
 
+type AccessConfigForHAProxy struct {
+	RuntimeApiAddress string `json:"runtimeApiAddress"`
+}
+
 type AccessConfigForHetzner struct {
 	ApiToken string `json:"apiToken"`
 }
@@ -250,6 +273,12 @@ type AccessConfigForLeCDN struct {
 	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
 }
 
+type AccessConfigForMatrix struct {
+	HomeserverUrl string `json:"homeserverUrl"`
+	AccessToken   string `json:"accessToken"`
+	DefaultRoomId string `json:"defaultRoomId,omitempty"`
+}
+
 type AccessConfigForMattermost struct {
 	ServerUrl        string `json:"serverUrl"`
 	Username         string `json:"username"`
@@ -257,6 +286,10 @@ type AccessConfigForMattermost struct {
 	DefaultChannelId string `json:"defaultChannelId,omitempty"`
 }
 
+type AccessConfigForMicrosoftTeams struct {
+	WebhookUrl string `json:"webhookUrl"`
+}
+
 type AccessConfigForNamecheap struct {
 	Username string `json:"username"`
 	ApiKey   string `json:"apiKey"`
@@ -285,6 +318,27 @@ type AccessConfigForNS1 struct {
 	ApiKey string `json:"apiKey"`
 }
 
+type AccessConfigForNtfy struct {
+	ServerUrl    string `json:"serverUrl,omitempty"`
+	AccessToken  string `json:"accessToken,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	DefaultTopic string `json:"defaultTopic,omitempty"`
+}
+
+type AccessConfigForOPNsense struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	ApiSecret                string `json:"apiSecret"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForPfSense struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForPorkbun struct {
 	ApiKey       string `json:"apiKey"`
 	SecretApiKey string `json:"secretApiKey"`
@@ -319,6 +373,15 @@ type AccessConfigForRatPanel struct {
 	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
 }
 
+type AccessConfigForS3 struct {
+	Endpoint                 string `json:"endpoint"`
+	AccessKey                string `json:"accessKey"`
+	SecretKey                string `json:"secretKey"`
+	Region                   string `json:"region,omitempty"`
+	VirtualHostedStyle       bool   `json:"virtualHostedStyle,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForSafeLine struct {
 	ServerUrl                string `json:"serverUrl"`
 	ApiToken                 string `json:"apiToken"`
@@ -352,6 +415,17 @@ type AccessConfigForSSH struct {
 		Key           string `json:"key,omitempty"`
 		KeyPassphrase string `json:"keyPassphrase,omitempty"`
 	} `json:"jumpServers,omitempty"`
+	Hosts []struct {
+		Name          string   `json:"name,omitempty"`
+		Groups        []string `json:"groups,omitempty"`
+		Host          string   `json:"host"`
+		Port          int32    `json:"port,omitempty"`
+		AuthMethod    string   `json:"authMethod,omitempty"`
+		Username      string   `json:"username,omitempty"`
+		Password      string   `json:"password,omitempty"`
+		Key           string   `json:"key,omitempty"`
+		KeyPassphrase string   `json:"keyPassphrase,omitempty"`
+	} `json:"hosts,omitempty"`
 }
 
 type AccessConfigForSSLCom struct {
@@ -359,6 +433,13 @@ type AccessConfigForSSLCom struct {
 	EabHmacKey string `json:"eabHmacKey"`
 }
 
+type AccessConfigForSynologyDSM struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForTelegramBot struct {
 	BotToken      string `json:"botToken"`
 	DefaultChatId int64  `json:"defaultChatId,omitempty"`
@@ -369,6 +450,17 @@ type AccessConfigForTencentCloud struct {
 	SecretKey string `json:"secretKey"`
 }
 
+type AccessConfigForTrueNAS struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForTwilio struct {
+	AccountSid string `json:"accountSid"`
+	AuthToken  string `json:"authToken"`
+}
+
 type AccessConfigForUCloud struct {
 	PrivateKey string `json:"privateKey"`
 	PublicKey  string `json:"publicKey"`
@@ -385,6 +477,17 @@ type AccessConfigForUpyun struct {
 	Password string `json:"password"`
 }
 
+type AccessConfigForVault struct {
+	ServerUrl                string `json:"serverUrl"`
+	Namespace                string `json:"namespace,omitempty"`
+	AuthMethod               string `json:"authMethod,omitempty"`
+	Token                    string `json:"token,omitempty"`
+	AppRoleMountPath         string `json:"appRoleMountPath,omitempty"`
+	AppRoleRoleId            string `json:"appRoleRoleId,omitempty"`
+	AppRoleSecretId          string `json:"appRoleSecretId,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForVercel struct {
 	ApiAccessToken string `json:"apiAccessToken"`
 	TeamId         string `json:"teamId,omitempty"`
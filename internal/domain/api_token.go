@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+)
+
+const CollectionNameAPIToken = "api_token"
+
+// API 令牌。
+// 用于自动化场景（如 CI）调用接口，按权限范围授权，可限制可访问的工作流、证书与授权。
+type APIToken struct {
+	Meta
+	Name           string              `json:"name" db:"name"`
+	TokenHash      string              `json:"-" db:"tokenHash"`
+	TokenPrefix    string              `json:"tokenPrefix" db:"tokenPrefix"`
+	Scopes         []APITokenScopeType `json:"scopes" db:"scopes"`
+	WorkflowIds    []string            `json:"workflowIds" db:"workflowIds"`
+	CertificateIds []string            `json:"certificateIds" db:"certificateIds"`
+	AccessIds      []string            `json:"accessIds" db:"accessIds"`
+	IPAllowlist    []string            `json:"ipAllowlist" db:"ipAllowlist"`
+	ExpireAt       time.Time           `json:"expireAt" db:"expireAt"`
+	LastUsedAt     time.Time           `json:"lastUsedAt" db:"lastUsedAt"`
+	LastUsedIp     string              `json:"lastUsedIp" db:"lastUsedIp"`
+}
+
+type APITokenScopeType string
+
+const (
+	APITokenScopeTypeAccessWrite       APITokenScopeType = "access:write"
+	APITokenScopeTypeCertificateExport APITokenScopeType = "certificate:export"
+	APITokenScopeTypeCertificateRead   APITokenScopeType = "certificate:read"
+	APITokenScopeTypeWorkflowRead      APITokenScopeType = "workflow:read"
+	APITokenScopeTypeWorkflowRun       APITokenScopeType = "workflow:run"
+)
+
+var APITokenScopeTypes = []APITokenScopeType{
+	APITokenScopeTypeAccessWrite,
+	APITokenScopeTypeCertificateExport,
+	APITokenScopeTypeCertificateRead,
+	APITokenScopeTypeWorkflowRead,
+	APITokenScopeTypeWorkflowRun,
+}
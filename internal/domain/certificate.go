@@ -15,25 +15,26 @@ const CollectionNameCertificate = "certificate"
 
 type Certificate struct {
 	Meta
-	Source            CertificateSourceType       `json:"source" db:"source"`
-	SubjectAltNames   string                      `json:"subjectAltNames" db:"subjectAltNames"`
-	SerialNumber      string                      `json:"serialNumber" db:"serialNumber"`
-	Certificate       string                      `json:"certificate" db:"certificate"`
-	PrivateKey        string                      `json:"privateKey" db:"privateKey"`
-	IssuerOrg         string                      `json:"issuerOrg" db:"issuerOrg"`
-	IssuerCertificate string                      `json:"issuerCertificate" db:"issuerCertificate"`
-	KeyAlgorithm      CertificateKeyAlgorithmType `json:"keyAlgorithm" db:"keyAlgorithm"`
-	EffectAt          time.Time                   `json:"effectAt" db:"effectAt"`
-	ExpireAt          time.Time                   `json:"expireAt" db:"expireAt"`
-	ACMEAccountUrl    string                      `json:"acmeAccountUrl" db:"acmeAccountUrl"`
-	ACMECertUrl       string                      `json:"acmeCertUrl" db:"acmeCertUrl"`
-	ACMECertStableUrl string                      `json:"acmeCertStableUrl" db:"acmeCertStableUrl"`
-	ACMERenewed       bool                        `json:"acmeRenewed" db:"acmeRenewed"`
-	WorkflowId        string                      `json:"workflowId" db:"workflowId"`
-	WorkflowNodeId    string                      `json:"workflowNodeId" db:"workflowNodeId"`
-	WorkflowRunId     string                      `json:"workflowRunId" db:"workflowRunId"`
-	WorkflowOutputId  string                      `json:"workflowOutputId" db:"workflowOutputId"`
-	DeletedAt         *time.Time                  `json:"deleted" db:"deleted"`
+	Source                  CertificateSourceType       `json:"source" db:"source"`
+	SubjectAltNames         string                      `json:"subjectAltNames" db:"subjectAltNames"`
+	SerialNumber            string                      `json:"serialNumber" db:"serialNumber"`
+	Certificate             string                      `json:"certificate" db:"certificate"`
+	PrivateKey              string                      `json:"privateKey" db:"privateKey"`
+	IssuerOrg               string                      `json:"issuerOrg" db:"issuerOrg"`
+	IssuerCertificate       string                      `json:"issuerCertificate" db:"issuerCertificate"`
+	KeyAlgorithm            CertificateKeyAlgorithmType `json:"keyAlgorithm" db:"keyAlgorithm"`
+	EffectAt                time.Time                   `json:"effectAt" db:"effectAt"`
+	ExpireAt                time.Time                   `json:"expireAt" db:"expireAt"`
+	ACMEAccountUrl          string                      `json:"acmeAccountUrl" db:"acmeAccountUrl"`
+	ACMECertUrl             string                      `json:"acmeCertUrl" db:"acmeCertUrl"`
+	ACMECertStableUrl       string                      `json:"acmeCertStableUrl" db:"acmeCertStableUrl"`
+	ACMERenewed             bool                        `json:"acmeRenewed" db:"acmeRenewed"`
+	WorkflowId              string                      `json:"workflowId" db:"workflowId"`
+	WorkflowNodeId          string                      `json:"workflowNodeId" db:"workflowNodeId"`
+	WorkflowRunId           string                      `json:"workflowRunId" db:"workflowRunId"`
+	WorkflowOutputId        string                      `json:"workflowOutputId" db:"workflowOutputId"`
+	ExpiryNotifiedThreshold int32                       `json:"expiryNotifiedThreshold" db:"expiryNotifiedThreshold"`
+	DeletedAt               *time.Time                  `json:"deleted" db:"deleted"`
 }
 
 func (c *Certificate) PopulateFromX509(certX509 *x509.Certificate) *Certificate {
@@ -0,0 +1,8 @@
+package dtos
+
+type AccessUpdateReq struct {
+	AccessId   string         `json:"-"`
+	Name       string         `json:"name"`
+	Config     map[string]any `json:"config"` // 仅更新传入的配置项，未传入的保持不变
+	ByAPIToken bool           `json:"-"`      // 是否由 API 令牌发起
+}
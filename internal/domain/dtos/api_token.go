@@ -0,0 +1,39 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+type APITokenCreateReq struct {
+	Name           string                     `json:"name"`
+	Scopes         []domain.APITokenScopeType `json:"scopes"`
+	WorkflowIds    []string                   `json:"workflowIds"`
+	CertificateIds []string                   `json:"certificateIds"`
+	AccessIds      []string                   `json:"accessIds"`
+	IPAllowlist    []string                   `json:"ipAllowlist"`
+	ExpireAt       time.Time                  `json:"expireAt"`
+}
+
+type APITokenCreateResp struct {
+	Token string           `json:"token"` // 令牌明文，仅在创建时返回一次
+	Item  *domain.APIToken `json:"item"`
+}
+
+type APITokenListResp struct {
+	Items []*domain.APIToken `json:"items"`
+}
+
+type APITokenRevokeReq struct {
+	TokenId string `json:"-"`
+}
+
+type APITokenAuthenticateReq struct {
+	Token         string
+	Scope         domain.APITokenScopeType
+	WorkflowId    string
+	CertificateId string
+	AccessId      string
+	ClientIp      string
+}
@@ -1,5 +1,32 @@
 package dtos
 
+import (
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+type CertificateGetReq struct {
+	CertificateId string `json:"-"`
+}
+
+type CertificateGetResp struct {
+	Id                string                             `json:"id"`
+	Source            domain.CertificateSourceType       `json:"source"`
+	SubjectAltNames   string                             `json:"subjectAltNames"`
+	SerialNumber      string                             `json:"serialNumber"`
+	IssuerOrg         string                             `json:"issuerOrg"`
+	KeyAlgorithm      domain.CertificateKeyAlgorithmType `json:"keyAlgorithm"`
+	Certificate       string                             `json:"certificate"`
+	IssuerCertificate string                             `json:"issuerCertificate"`
+	EffectAt          time.Time                          `json:"effectAt"`
+	ExpireAt          time.Time                          `json:"expireAt"`
+	WorkflowId        string                             `json:"workflowId"`
+	WorkflowRunId     string                             `json:"workflowRunId"`
+	CreatedAt         time.Time                          `json:"created"`
+	UpdatedAt         time.Time                          `json:"updated"`
+}
+
 type CertificateArchiveFileReq struct {
 	CertificateId string `json:"-"`
 	Format        string `json:"format"`
@@ -5,3 +5,24 @@ import "github.com/certimate-go/certimate/internal/domain"
 type NotifyTestPushReq struct {
 	Channel domain.NotifyChannelType `json:"channel"`
 }
+
+type NotifyAcknowledgeAlertReq struct {
+	AlertId  string `json:"-"`
+	Operator string `json:"-"`
+}
+
+type NotifyListDeliveriesReq struct {
+	WorkflowId    string                                `json:"-"`
+	WorkflowRunId string                                `json:"-"`
+	AlertId       string                                `json:"-"`
+	Status        domain.NotificationDeliveryStatusType `json:"-"`
+	Page          int                                   `json:"-"`
+	PerPage       int                                   `json:"-"`
+}
+
+type NotifyListDeliveriesResp struct {
+	Page       int                            `json:"page"`
+	PerPage    int                            `json:"perPage"`
+	TotalItems int                            `json:"totalItems"`
+	Items      []*domain.NotificationDelivery `json:"items"`
+}
@@ -11,3 +11,55 @@ type WorkflowCancelRunReq struct {
 	WorkflowId string `json:"-"`
 	RunId      string `json:"-"`
 }
+
+type WorkflowApproveRunReq struct {
+	WorkflowId string `json:"-"`
+	RunId      string `json:"-"`
+	Approved   bool   `json:"approved"`
+	Comment    string `json:"comment"`
+	Operator   string `json:"-"`
+}
+
+type WorkflowListVersionsReq struct {
+	WorkflowId string `json:"-"`
+}
+
+type WorkflowListVersionsResp struct {
+	Items []*domain.WorkflowVersion `json:"items"`
+}
+
+type WorkflowDiffVersionsReq struct {
+	WorkflowId  string `json:"-"`
+	FromVersion int    `json:"from"`
+	ToVersion   int    `json:"to"`
+}
+
+type WorkflowDiffVersionsResp struct {
+	FromVersion int                       `json:"from"`
+	ToVersion   int                       `json:"to"`
+	Changes     []*WorkflowNodeChangeItem `json:"changes"`
+}
+
+type WorkflowNodeChangeItem struct {
+	NodeId   string                     `json:"nodeId"`
+	NodeName string                     `json:"nodeName"`
+	NodeType domain.WorkflowNodeType    `json:"nodeType"`
+	Action   string                     `json:"action"` // 变更类型："added"、"removed"、"modified"
+	Fields   []*WorkflowFieldChangeItem `json:"fields,omitempty"`
+}
+
+type WorkflowFieldChangeItem struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type WorkflowRestoreVersionReq struct {
+	WorkflowId string `json:"-"`
+	Version    int    `json:"-"`
+}
+
+type WorkflowGetRunReq struct {
+	WorkflowId string `json:"-"`
+	RunId      string `json:"-"`
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+const CollectionNameNotificationRule = "notification_rule"
+
+type NotificationRule struct {
+	Meta
+	Name       string                      `json:"name" db:"name"`
+	Enabled    bool                        `json:"enabled" db:"enabled"`
+	Conditions *NotificationRuleConditions `json:"conditions" db:"conditions"`
+	Channels   []NotificationRuleChannel   `json:"channels" db:"channels"`
+}
+
+// 通知路由规则的匹配条件。
+// 各条件之间为“与”关系；单个条件为空时表示不限制。
+type NotificationRuleConditions struct {
+	EventTypes     []NotificationEventType `json:"eventTypes,omitempty"`     // 事件类型列表
+	Severities     []core.NotifySeverity   `json:"severities,omitempty"`     // 严重程度列表
+	WorkflowIds    []string                `json:"workflowIds,omitempty"`    // 工作流 ID 列表
+	Tags           []string                `json:"tags,omitempty"`           // 标签列表，事件包含任一标签即视为匹配
+	DomainPatterns []string                `json:"domainPatterns,omitempty"` // 证书域名匹配模式列表，支持通配符，形如 "*.example.com"
+}
+
+// 通知路由规则的通知渠道。
+// 按声明顺序逐级升级：告警产生后经过 [EscalateAfter] 分钟仍未被确认时，才会发送到该渠道。
+type NotificationRuleChannel struct {
+	Provider         string         `json:"provider"`                 // 通知提供商
+	ProviderAccessId string         `json:"providerAccessId"`         // 通知提供商授权记录 ID
+	ProviderConfig   map[string]any `json:"providerConfig,omitempty"` // 通知提供商额外配置
+	EscalateAfter    int32          `json:"escalateAfter,omitempty"`  // 升级等待时间（单位：分钟；零值时立即发送）
+}
+
+type NotificationEventType string
+
+const (
+	NotificationEventTypeWorkflowSucceeded   = NotificationEventType("workflow.succeeded")
+	NotificationEventTypeWorkflowFailed      = NotificationEventType("workflow.failed")
+	NotificationEventTypeCertificateExpiring = NotificationEventType("certificate.expiring")
+)
+
+const CollectionNameNotificationAlert = "notification_alert"
+
+type NotificationAlert struct {
+	Meta
+	RuleId           string                      `json:"ruleId" db:"ruleId"`
+	EventType        NotificationEventType       `json:"eventType" db:"eventType"`
+	WorkflowId       string                      `json:"workflowId" db:"workflowId"`
+	WorkflowRunId    string                      `json:"workflowRunId" db:"workflowRunId"`
+	Payload          *core.NotifyMessage         `json:"payload" db:"payload"`
+	Status           NotificationAlertStatusType `json:"status" db:"status"`
+	NotifiedChannels int                         `json:"notifiedChannels" db:"notifiedChannels"`
+	LastNotifiedAt   time.Time                   `json:"lastNotifiedAt" db:"lastNotifiedAt"`
+	AcknowledgedBy   string                      `json:"acknowledgedBy" db:"acknowledgedBy"`
+	AcknowledgedAt   time.Time                   `json:"acknowledgedAt" db:"acknowledgedAt"`
+	ResolvedAt       time.Time                   `json:"resolvedAt" db:"resolvedAt"`
+}
+
+type NotificationAlertStatusType string
+
+const (
+	NotificationAlertStatusTypeFiring       NotificationAlertStatusType = "firing"
+	NotificationAlertStatusTypeAcknowledged NotificationAlertStatusType = "acknowledged"
+	NotificationAlertStatusTypeResolved     NotificationAlertStatusType = "resolved"
+)
+
+const CollectionNameNotificationFingerprint = "notification_fingerprint"
+
+// 失败通知指纹。
+// 用于对同一工作流、同一节点、同一类错误的重复失败通知进行去重。
+type NotificationFingerprint struct {
+	Meta
+	Fingerprint    string    `json:"fingerprint" db:"fingerprint"`
+	WorkflowId     string    `json:"workflowId" db:"workflowId"`
+	NodeId         string    `json:"nodeId" db:"nodeId"`
+	NodeName       string    `json:"nodeName" db:"nodeName"`
+	NotifyNodeId   string    `json:"notifyNodeId" db:"notifyNodeId"`
+	ErrorClass     string    `json:"errorClass" db:"errorClass"`
+	Occurrences    int       `json:"occurrences" db:"occurrences"`
+	Suppressed     int       `json:"suppressed" db:"suppressed"`
+	FirstSeenAt    time.Time `json:"firstSeenAt" db:"firstSeenAt"`
+	LastSeenAt     time.Time `json:"lastSeenAt" db:"lastSeenAt"`
+	LastNotifiedAt time.Time `json:"lastNotifiedAt" db:"lastNotifiedAt"`
+}
+
+const CollectionNameNotificationDelivery = "notification_delivery"
+
+// 通知投递记录。
+// 每次发送尝试（包括重试）均会生成一条记录。
+type NotificationDelivery struct {
+	Meta
+	Provider         string                         `json:"provider" db:"provider"`
+	ProviderAccessId string                         `json:"providerAccessId" db:"providerAccessId"`
+	ProviderConfig   map[string]any                 `json:"providerConfig" db:"providerConfig"`
+	Target           string                         `json:"target" db:"target"`
+	WorkflowId       string                         `json:"workflowId" db:"workflowId"`
+	WorkflowRunId    string                         `json:"workflowRunId" db:"workflowRunId"`
+	AlertId          string                         `json:"alertId" db:"alertId"`
+	Subject          string                         `json:"subject" db:"subject"`
+	Payload          *core.NotifyMessage            `json:"payload" db:"payload"`
+	PayloadHash      string                         `json:"payloadHash" db:"payloadHash"`
+	Status           NotificationDeliveryStatusType `json:"status" db:"status"`
+	Attempt          int                            `json:"attempt" db:"attempt"`
+	RetryOf          string                         `json:"retryOf" db:"retryOf"`
+	Response         map[string]any                 `json:"response" db:"response"`
+	Error            string                         `json:"error" db:"error"`
+	Latency          int64                          `json:"latency" db:"latency"` // 单位：毫秒
+	NextRetryAt      time.Time                      `json:"nextRetryAt" db:"nextRetryAt"`
+}
+
+type NotificationDeliveryStatusType string
+
+const (
+	NotificationDeliveryStatusTypeFailed    NotificationDeliveryStatusType = "failed"
+	NotificationDeliveryStatusTypeRetrying  NotificationDeliveryStatusType = "retrying"
+	NotificationDeliveryStatusTypeSucceeded NotificationDeliveryStatusType = "succeeded"
+)
@@ -25,6 +25,7 @@ const (
 	AccessProviderTypeBunny               = AccessProviderType("bunny")
 	AccessProviderTypeBuypass             = AccessProviderType("buypass")
 	AccessProviderTypeCacheFly            = AccessProviderType("cachefly")
+	AccessProviderTypeCaddy               = AccessProviderType("caddy")
 	AccessProviderTypeCdnfly              = AccessProviderType("cdnfly")
 	AccessProviderTypeCloudflare          = AccessProviderType("cloudflare")
 	AccessProviderTypeClouDNS             = AccessProviderType("cloudns")
@@ -37,6 +38,7 @@ const (
 	AccessProviderTypeDingTalkBot         = AccessProviderType("dingtalkbot")
 	AccessProviderTypeDiscordBot          = AccessProviderType("discordbot")
 	AccessProviderTypeDNSLA               = AccessProviderType("dnsla")
+	AccessProviderTypeDocker              = AccessProviderType("docker")
 	AccessProviderTypeDogeCloud           = AccessProviderType("dogecloud")
 	AccessProviderTypeDuckDNS             = AccessProviderType("duckdns")
 	AccessProviderTypeDynv6               = AccessProviderType("dynv6")
@@ -44,11 +46,13 @@ const (
 	AccessProviderTypeEmail               = AccessProviderType("email")
 	AccessProviderTypeFastly              = AccessProviderType("fastly") // Fastly（预留）
 	AccessProviderTypeFlexCDN             = AccessProviderType("flexcdn")
+	AccessProviderTypeFTP                 = AccessProviderType("ftp")
 	AccessProviderTypeGname               = AccessProviderType("gname")
 	AccessProviderTypeGcore               = AccessProviderType("gcore")
 	AccessProviderTypeGoDaddy             = AccessProviderType("godaddy")
 	AccessProviderTypeGoEdge              = AccessProviderType("goedge")
 	AccessProviderTypeGoogleTrustServices = AccessProviderType("googletrustservices")
+	AccessProviderTypeHAProxy             = AccessProviderType("haproxy")
 	AccessProviderTypeHetzner             = AccessProviderType("hetzner")
 	AccessProviderTypeHuaweiCloud         = AccessProviderType("huaweicloud")
 	AccessProviderTypeJDCloud             = AccessProviderType("jdcloud")
@@ -59,13 +63,18 @@ const (
 	AccessProviderTypeLetsEncryptStaging  = AccessProviderType("letsencryptstaging")
 	AccessProviderTypeLeCDN               = AccessProviderType("lecdn")
 	AccessProviderTypeLocal               = AccessProviderType("local")
+	AccessProviderTypeMatrix              = AccessProviderType("matrix")
 	AccessProviderTypeMattermost          = AccessProviderType("mattermost")
+	AccessProviderTypeMicrosoftTeams      = AccessProviderType("microsoftteams")
 	AccessProviderTypeNamecheap           = AccessProviderType("namecheap")
 	AccessProviderTypeNameDotCom          = AccessProviderType("namedotcom")
 	AccessProviderTypeNameSilo            = AccessProviderType("namesilo")
 	AccessProviderTypeNetcup              = AccessProviderType("netcup")
 	AccessProviderTypeNetlify             = AccessProviderType("netlify")
 	AccessProviderTypeNS1                 = AccessProviderType("ns1")
+	AccessProviderTypeNtfy                = AccessProviderType("ntfy")
+	AccessProviderTypeOPNsense            = AccessProviderType("opnsense")
+	AccessProviderTypePfSense             = AccessProviderType("pfsense")
 	AccessProviderTypePorkbun             = AccessProviderType("porkbun")
 	AccessProviderTypePowerDNS            = AccessProviderType("powerdns")
 	AccessProviderTypeProxmoxVE           = AccessProviderType("proxmoxve")
@@ -73,16 +82,21 @@ const (
 	AccessProviderTypeQingCloud           = AccessProviderType("qingcloud") // 青云（预留）
 	AccessProviderTypeRainYun             = AccessProviderType("rainyun")
 	AccessProviderTypeRatPanel            = AccessProviderType("ratpanel")
+	AccessProviderTypeS3                  = AccessProviderType("s3")
 	AccessProviderTypeSafeLine            = AccessProviderType("safeline")
 	AccessProviderTypeSlackBot            = AccessProviderType("slackbot")
 	AccessProviderTypeSpaceship           = AccessProviderType("spaceship")
 	AccessProviderTypeSSH                 = AccessProviderType("ssh")
 	AccessProviderTypeSSLCOM              = AccessProviderType("sslcom")
+	AccessProviderTypeSynologyDSM         = AccessProviderType("synologydsm")
 	AccessProviderTypeTelegramBot         = AccessProviderType("telegrambot")
 	AccessProviderTypeTencentCloud        = AccessProviderType("tencentcloud")
+	AccessProviderTypeTrueNAS             = AccessProviderType("truenas")
+	AccessProviderTypeTwilio              = AccessProviderType("twilio")
 	AccessProviderTypeUCloud              = AccessProviderType("ucloud")
 	AccessProviderTypeUniCloud            = AccessProviderType("unicloud")
 	AccessProviderTypeUpyun               = AccessProviderType("upyun")
+	AccessProviderTypeVault               = AccessProviderType("vault")
 	AccessProviderTypeVercel              = AccessProviderType("vercel")
 	AccessProviderTypeVolcEngine          = AccessProviderType("volcengine")
 	AccessProviderTypeWangsu              = AccessProviderType("wangsu")
@@ -108,6 +122,7 @@ const (
 	CAProviderTypeLetsEncrypt         = CAProviderType(AccessProviderTypeLetsEncrypt)
 	CAProviderTypeLetsEncryptStaging  = CAProviderType(AccessProviderTypeLetsEncryptStaging)
 	CAProviderTypeSSLCom              = CAProviderType(AccessProviderTypeSSLCOM)
+	CAProviderTypeVaultPKI            = CAProviderType(AccessProviderTypeVault + "-pki")
 	CAProviderTypeZeroSSL             = CAProviderType(AccessProviderTypeZeroSSL)
 )
 
@@ -217,6 +232,7 @@ const (
 	DeploymentProviderTypeBunnyCDN              = DeploymentProviderType(AccessProviderTypeBunny + "-cdn")
 	DeploymentProviderTypeBytePlusCDN           = DeploymentProviderType(AccessProviderTypeBytePlus + "-cdn")
 	DeploymentProviderTypeCacheFly              = DeploymentProviderType(AccessProviderTypeCacheFly)
+	DeploymentProviderTypeCaddy                 = DeploymentProviderType(AccessProviderTypeCaddy)
 	DeploymentProviderTypeCdnfly                = DeploymentProviderType(AccessProviderTypeCdnfly)
 	DeploymentProviderTypeCTCCCloudAO           = DeploymentProviderType(AccessProviderTypeCTCCCloud + "-ao")
 	DeploymentProviderTypeCTCCCloudCDN          = DeploymentProviderType(AccessProviderTypeCTCCCloud + "-cdn")
@@ -224,11 +240,14 @@ const (
 	DeploymentProviderTypeCTCCCloudELB          = DeploymentProviderType(AccessProviderTypeCTCCCloud + "-elb")
 	DeploymentProviderTypeCTCCCloudICDN         = DeploymentProviderType(AccessProviderTypeCTCCCloud + "-icdn")
 	DeploymentProviderTypeCTCCCloudLVDN         = DeploymentProviderType(AccessProviderTypeCTCCCloud + "-ldvn")
+	DeploymentProviderTypeDocker                = DeploymentProviderType(AccessProviderTypeDocker)
 	DeploymentProviderTypeDogeCloudCDN          = DeploymentProviderType(AccessProviderTypeDogeCloud + "-cdn")
 	DeploymentProviderTypeEdgioApplications     = DeploymentProviderType(AccessProviderTypeEdgio + "-applications")
 	DeploymentProviderTypeFlexCDN               = DeploymentProviderType(AccessProviderTypeFlexCDN)
+	DeploymentProviderTypeFTP                   = DeploymentProviderType(AccessProviderTypeFTP)
 	DeploymentProviderTypeGcoreCDN              = DeploymentProviderType(AccessProviderTypeGcore + "-cdn")
 	DeploymentProviderTypeGoEdge                = DeploymentProviderType(AccessProviderTypeGoEdge)
+	DeploymentProviderTypeHAProxy               = DeploymentProviderType(AccessProviderTypeHAProxy)
 	DeploymentProviderTypeHuaweiCloudCDN        = DeploymentProviderType(AccessProviderTypeHuaweiCloud + "-cdn")
 	DeploymentProviderTypeHuaweiCloudELB        = DeploymentProviderType(AccessProviderTypeHuaweiCloud + "-elb")
 	DeploymentProviderTypeHuaweiCloudSCM        = DeploymentProviderType(AccessProviderTypeHuaweiCloud + "-scm")
@@ -238,10 +257,16 @@ const (
 	DeploymentProviderTypeJDCloudLive           = DeploymentProviderType(AccessProviderTypeJDCloud + "-live")
 	DeploymentProviderTypeJDCloudVOD            = DeploymentProviderType(AccessProviderTypeJDCloud + "-vod")
 	DeploymentProviderTypeKong                  = DeploymentProviderType(AccessProviderTypeKong)
+	DeploymentProviderTypeKubernetesIngress     = DeploymentProviderType(AccessProviderTypeKubernetes + "-ingress")
 	DeploymentProviderTypeKubernetesSecret      = DeploymentProviderType(AccessProviderTypeKubernetes + "-secret")
 	DeploymentProviderTypeLeCDN                 = DeploymentProviderType(AccessProviderTypeLeCDN)
 	DeploymentProviderTypeLocal                 = DeploymentProviderType(AccessProviderTypeLocal)
+	DeploymentProviderTypeLocalApache           = DeploymentProviderType(AccessProviderTypeLocal + "-apache")
+	DeploymentProviderTypeLocalNginx            = DeploymentProviderType(AccessProviderTypeLocal + "-nginx")
+	DeploymentProviderTypeLocalTraefik          = DeploymentProviderType(AccessProviderTypeLocal + "-traefik")
 	DeploymentProviderTypeNetlifySite           = DeploymentProviderType(AccessProviderTypeNetlify + "-site")
+	DeploymentProviderTypeOPNsense              = DeploymentProviderType(AccessProviderTypeOPNsense)
+	DeploymentProviderTypePfSense               = DeploymentProviderType(AccessProviderTypePfSense)
 	DeploymentProviderTypeProxmoxVE             = DeploymentProviderType(AccessProviderTypeProxmoxVE)
 	DeploymentProviderTypeQiniuCDN              = DeploymentProviderType(AccessProviderTypeQiniu + "-cdn")
 	DeploymentProviderTypeQiniuKodo             = DeploymentProviderType(AccessProviderTypeQiniu + "-kodo")
@@ -249,8 +274,15 @@ const (
 	DeploymentProviderTypeRainYunRCDN           = DeploymentProviderType(AccessProviderTypeRainYun + "-rcdn")
 	DeploymentProviderTypeRatPanelConsole       = DeploymentProviderType(AccessProviderTypeRatPanel + "-console")
 	DeploymentProviderTypeRatPanelSite          = DeploymentProviderType(AccessProviderTypeRatPanel + "-site")
+	DeploymentProviderTypeS3                    = DeploymentProviderType(AccessProviderTypeS3)
 	DeploymentProviderTypeSafeLine              = DeploymentProviderType(AccessProviderTypeSafeLine)
 	DeploymentProviderTypeSSH                   = DeploymentProviderType(AccessProviderTypeSSH)
+	DeploymentProviderTypeSSHApache             = DeploymentProviderType(AccessProviderTypeSSH + "-apache")
+	DeploymentProviderTypeSSHDocker             = DeploymentProviderType(AccessProviderTypeSSH + "-docker")
+	DeploymentProviderTypeSSHNginx              = DeploymentProviderType(AccessProviderTypeSSH + "-nginx")
+	DeploymentProviderTypeSSHSFTP               = DeploymentProviderType(AccessProviderTypeSSH + "-sftp")
+	DeploymentProviderTypeSSHTraefik            = DeploymentProviderType(AccessProviderTypeSSH + "-traefik")
+	DeploymentProviderTypeSynologyDSM           = DeploymentProviderType(AccessProviderTypeSynologyDSM)
 	DeploymentProviderTypeTencentCloudCDN       = DeploymentProviderType(AccessProviderTypeTencentCloud + "-cdn")
 	DeploymentProviderTypeTencentCloudCLB       = DeploymentProviderType(AccessProviderTypeTencentCloud + "-clb")
 	DeploymentProviderTypeTencentCloudCOS       = DeploymentProviderType(AccessProviderTypeTencentCloud + "-cos")
@@ -264,11 +296,13 @@ const (
 	DeploymentProviderTypeTencentCloudSSLUpdate = DeploymentProviderType(AccessProviderTypeTencentCloud + "-sslupdate")
 	DeploymentProviderTypeTencentCloudVOD       = DeploymentProviderType(AccessProviderTypeTencentCloud + "-vod")
 	DeploymentProviderTypeTencentCloudWAF       = DeploymentProviderType(AccessProviderTypeTencentCloud + "-waf")
+	DeploymentProviderTypeTrueNAS               = DeploymentProviderType(AccessProviderTypeTrueNAS)
 	DeploymentProviderTypeUCloudUCDN            = DeploymentProviderType(AccessProviderTypeUCloud + "-ucdn")
 	DeploymentProviderTypeUCloudUS3             = DeploymentProviderType(AccessProviderTypeUCloud + "-us3")
 	DeploymentProviderTypeUniCloudWebHost       = DeploymentProviderType(AccessProviderTypeUniCloud + "-webhost")
 	DeploymentProviderTypeUpyunCDN              = DeploymentProviderType(AccessProviderTypeUpyun + "-cdn")
 	DeploymentProviderTypeUpyunFile             = DeploymentProviderType(AccessProviderTypeUpyun + "-file")
+	DeploymentProviderTypeVaultKV               = DeploymentProviderType(AccessProviderTypeVault + "-kv")
 	DeploymentProviderTypeVolcEngineALB         = DeploymentProviderType(AccessProviderTypeVolcEngine + "-alb")
 	DeploymentProviderTypeVolcEngineCDN         = DeploymentProviderType(AccessProviderTypeVolcEngine + "-cdn")
 	DeploymentProviderTypeVolcEngineCertCenter  = DeploymentProviderType(AccessProviderTypeVolcEngine + "-certcenter")
@@ -293,13 +327,20 @@ type NotificationProviderType string
 	NOTICE: If you add new constant, please keep ASCII order.
 */
 const (
-	NotificationProviderTypeDingTalkBot = NotificationProviderType(AccessProviderTypeDingTalkBot)
-	NotificationProviderTypeDiscordBot  = NotificationProviderType(AccessProviderTypeDiscordBot)
-	NotificationProviderTypeEmail       = NotificationProviderType(AccessProviderTypeEmail)
-	NotificationProviderTypeLarkBot     = NotificationProviderType(AccessProviderTypeLarkBot)
-	NotificationProviderTypeMattermost  = NotificationProviderType(AccessProviderTypeMattermost)
-	NotificationProviderTypeSlackBot    = NotificationProviderType(AccessProviderTypeSlackBot)
-	NotificationProviderTypeTelegramBot = NotificationProviderType(AccessProviderTypeTelegramBot)
-	NotificationProviderTypeWebhook     = NotificationProviderType(AccessProviderTypeWebhook)
-	NotificationProviderTypeWeComBot    = NotificationProviderType(AccessProviderTypeWeComBot)
+	NotificationProviderTypeAliyunSMS       = NotificationProviderType(AccessProviderTypeAliyun + "-sms")
+	NotificationProviderTypeDingTalkBot     = NotificationProviderType(AccessProviderTypeDingTalkBot)
+	NotificationProviderTypeDiscordBot      = NotificationProviderType(AccessProviderTypeDiscordBot)
+	NotificationProviderTypeEmail           = NotificationProviderType(AccessProviderTypeEmail)
+	NotificationProviderTypeLarkBot         = NotificationProviderType(AccessProviderTypeLarkBot)
+	NotificationProviderTypeMatrix          = NotificationProviderType(AccessProviderTypeMatrix)
+	NotificationProviderTypeMattermost      = NotificationProviderType(AccessProviderTypeMattermost)
+	NotificationProviderTypeMicrosoftTeams  = NotificationProviderType(AccessProviderTypeMicrosoftTeams)
+	NotificationProviderTypeNtfy            = NotificationProviderType(AccessProviderTypeNtfy)
+	NotificationProviderTypeSlackBot        = NotificationProviderType(AccessProviderTypeSlackBot)
+	NotificationProviderTypeTelegramBot     = NotificationProviderType(AccessProviderTypeTelegramBot)
+	NotificationProviderTypeTencentCloudSMS = NotificationProviderType(AccessProviderTypeTencentCloud + "-sms")
+	NotificationProviderTypeTwilioSMS       = NotificationProviderType(AccessProviderTypeTwilio + "-sms")
+	NotificationProviderTypeTwilioVoice     = NotificationProviderType(AccessProviderTypeTwilio + "-voice")
+	NotificationProviderTypeWebhook         = NotificationProviderType(AccessProviderTypeWebhook)
+	NotificationProviderTypeWeComBot        = NotificationProviderType(AccessProviderTypeWeComBot)
 )
@@ -43,3 +43,10 @@ type PersistenceSettingsContent struct {
 	WorkflowRunsMaxDaysRetention        int `json:"workflowRunsMaxDaysRetention"`
 	ExpiredCertificatesMaxDaysRetention int `json:"expiredCertificatesMaxDaysRetention"`
 }
+
+type CertificateExpiryDigestSettingsContent struct {
+	Disabled   bool    `json:"disabled,omitempty"`   // 是否关闭证书过期摘要通知
+	Thresholds []int32 `json:"thresholds,omitempty"` // 提醒阈值列表（单位：天；零值时默认值 [30, 14, 7, 1]）
+	Schedule   string  `json:"schedule,omitempty"`   // 发送时间，采用 Cron 表达式（零值时默认值 "0 9 * * *"）
+	Timezone   string  `json:"timezone,omitempty"`   // 发送时间所在时区（零值时使用服务器本地时区）
+}
@@ -14,10 +14,12 @@ type Workflow struct {
 	Meta
 	Name          string                `json:"name" db:"name"`
 	Description   string                `json:"description" db:"description"`
+	Tags          []string              `json:"tags" db:"tags"`
 	Trigger       WorkflowTriggerType   `json:"trigger" db:"trigger"`
 	TriggerCron   string                `json:"triggerCron" db:"triggerCron"`
 	Enabled       bool                  `json:"enabled" db:"enabled"`
 	Content       *WorkflowNode         `json:"content" db:"content"`
+	Version       int                   `json:"version" db:"version"`
 	Draft         *WorkflowNode         `json:"draft" db:"draft"`
 	HasDraft      bool                  `json:"hasDraft" db:"hasDraft"`
 	LastRunId     string                `json:"lastRunId" db:"lastRunId"`
@@ -35,6 +37,10 @@ const (
 	WorkflowNodeTypeMonitor             = WorkflowNodeType("monitor")
 	WorkflowNodeTypeDeploy              = WorkflowNodeType("deploy")
 	WorkflowNodeTypeNotify              = WorkflowNodeType("notify")
+	WorkflowNodeTypeApproval            = WorkflowNodeType("approval")
+	WorkflowNodeTypeDelay               = WorkflowNodeType("delay")
+	WorkflowNodeTypeHttpRequest         = WorkflowNodeType("http_request")
+	WorkflowNodeTypeScript              = WorkflowNodeType("script")
 	WorkflowNodeTypeBranch              = WorkflowNodeType("branch")
 	WorkflowNodeTypeCondition           = WorkflowNodeType("condition")
 	WorkflowNodeTypeExecuteResultBranch = WorkflowNodeType("execute_result_branch")
@@ -114,6 +120,46 @@ type WorkflowNodeConfigForNotify struct {
 	Subject              string         `json:"subject"`                  // 通知主题
 	Message              string         `json:"message"`                  // 通知内容
 	SkipOnAllPrevSkipped bool           `json:"skipOnAllPrevSkipped"`     // 前序节点均已跳过时是否跳过
+	DedupDisabled        bool           `json:"dedupDisabled,omitempty"`  // 是否关闭重复失败通知的抑制
+	DedupWindow          int32          `json:"dedupWindow,omitempty"`    // 重复失败通知的抑制窗口（单位：分钟；零值时默认值 60）
+}
+
+type WorkflowNodeConfigForApproval struct {
+	Message string `json:"message,omitempty"` // 审批说明
+	Timeout int32  `json:"timeout,omitempty"` // 审批超时时间（单位：分钟；零值时不超时），超时后自动驳回
+}
+
+type WorkflowNodeConfigForDelay struct {
+	Mode        string `json:"mode"`                  // 等待方式：固定时长 "duration"、时间窗口 "window"
+	Duration    int32  `json:"duration,omitempty"`    // 等待时长（单位：分钟）
+	WindowStart string `json:"windowStart,omitempty"` // 时间窗口开始时间，形如 "02:00"
+	WindowEnd   string `json:"windowEnd,omitempty"`   // 时间窗口结束时间，形如 "04:00"
+	Timezone    string `json:"timezone,omitempty"`    // 时间窗口所在时区（零值时使用服务器本地时区）
+}
+
+const (
+	WorkflowNodeDelayModeDuration = "duration"
+	WorkflowNodeDelayModeWindow   = "window"
+)
+
+type WorkflowNodeConfigForHttpRequest struct {
+	Method                   string            `json:"method"`                             // 请求谓词
+	Url                      string            `json:"url"`                                // 请求地址，支持模板变量
+	Headers                  string            `json:"headers,omitempty"`                  // 请求标头，每行一个，支持模板变量
+	Body                     string            `json:"body,omitempty"`                     // 请求内容，支持模板变量
+	Timeout                  int32             `json:"timeout,omitempty"`                  // 请求超时时间（单位：秒；零值时默认值 30）
+	AllowInsecureConnections bool              `json:"allowInsecureConnections,omitempty"` // 是否允许不安全的连接
+	CACertificate            string            `json:"caCertificate,omitempty"`            // 自定义 CA 证书 PEM 内容
+	ExpectedStatusCodes      string            `json:"expectedStatusCodes,omitempty"`      // 期望的响应状态码，以半角分号分隔（零值时默认为 2xx）
+	Extractions              map[string]string `json:"extractions,omitempty"`              // 从 JSON 响应中提取的输出，key 为输出名称，value 为 JSONPath 表达式
+}
+
+type WorkflowNodeConfigForScript struct {
+	Certificate string `json:"certificate,omitempty"` // 前序节点输出的证书，形如“${NodeId}#certificate”（选填）
+	ShellEnv    string `json:"shellEnv,omitempty"`    // Shell 执行环境（零值时根据操作系统决定）
+	Script      string `json:"script"`                // 脚本内容
+	Environment string `json:"environment,omitempty"` // 额外的环境变量，每行一个，形如 "KEY=VALUE"，支持模板变量
+	Timeout     int32  `json:"timeout,omitempty"`     // 执行超时时间（单位：秒；零值时默认值 60）
 }
 
 type WorkflowNodeConfigForCondition struct {
@@ -180,6 +226,56 @@ func (n *WorkflowNode) GetConfigForNotify() WorkflowNodeConfigForNotify {
 		Subject:              xmaps.GetString(n.Config, "subject"),
 		Message:              xmaps.GetString(n.Config, "message"),
 		SkipOnAllPrevSkipped: xmaps.GetBool(n.Config, "skipOnAllPrevSkipped"),
+		DedupDisabled:        xmaps.GetBool(n.Config, "dedupDisabled"),
+		DedupWindow:          xmaps.GetOrDefaultInt32(n.Config, "dedupWindow", 60),
+	}
+}
+
+func (n *WorkflowNode) GetConfigForApproval() WorkflowNodeConfigForApproval {
+	return WorkflowNodeConfigForApproval{
+		Message: xmaps.GetString(n.Config, "message"),
+		Timeout: xmaps.GetInt32(n.Config, "timeout"),
+	}
+}
+
+func (n *WorkflowNode) GetConfigForDelay() WorkflowNodeConfigForDelay {
+	return WorkflowNodeConfigForDelay{
+		Mode:        xmaps.GetOrDefaultString(n.Config, "mode", WorkflowNodeDelayModeDuration),
+		Duration:    xmaps.GetInt32(n.Config, "duration"),
+		WindowStart: xmaps.GetString(n.Config, "windowStart"),
+		WindowEnd:   xmaps.GetString(n.Config, "windowEnd"),
+		Timezone:    xmaps.GetString(n.Config, "timezone"),
+	}
+}
+
+func (n *WorkflowNode) GetConfigForHttpRequest() WorkflowNodeConfigForHttpRequest {
+	extractions := make(map[string]string)
+	for k, v := range xmaps.GetKVMapAny(n.Config, "extractions") {
+		if s, ok := v.(string); ok {
+			extractions[k] = s
+		}
+	}
+
+	return WorkflowNodeConfigForHttpRequest{
+		Method:                   xmaps.GetOrDefaultString(n.Config, "method", "GET"),
+		Url:                      xmaps.GetString(n.Config, "url"),
+		Headers:                  xmaps.GetString(n.Config, "headers"),
+		Body:                     xmaps.GetString(n.Config, "body"),
+		Timeout:                  xmaps.GetOrDefaultInt32(n.Config, "timeout", 30),
+		AllowInsecureConnections: xmaps.GetBool(n.Config, "allowInsecureConnections"),
+		CACertificate:            xmaps.GetString(n.Config, "caCertificate"),
+		ExpectedStatusCodes:      xmaps.GetString(n.Config, "expectedStatusCodes"),
+		Extractions:              extractions,
+	}
+}
+
+func (n *WorkflowNode) GetConfigForScript() WorkflowNodeConfigForScript {
+	return WorkflowNodeConfigForScript{
+		Certificate: xmaps.GetString(n.Config, "certificate"),
+		ShellEnv:    xmaps.GetString(n.Config, "shellEnv"),
+		Script:      xmaps.GetString(n.Config, "script"),
+		Environment: xmaps.GetString(n.Config, "environment"),
+		Timeout:     xmaps.GetOrDefaultInt32(n.Config, "timeout", 60),
 	}
 }
 
@@ -8,13 +8,15 @@ const CollectionNameWorkflowRun = "workflow_run"
 
 type WorkflowRun struct {
 	Meta
-	WorkflowId string                `json:"workflowId" db:"workflowId"`
-	Status     WorkflowRunStatusType `json:"status" db:"status"`
-	Trigger    WorkflowTriggerType   `json:"trigger" db:"trigger"`
-	StartedAt  time.Time             `json:"startedAt" db:"startedAt"`
-	EndedAt    time.Time             `json:"endedAt" db:"endedAt"`
-	Detail     *WorkflowNode         `json:"detail" db:"detail"`
-	Error      string                `json:"error" db:"error"`
+	WorkflowId      string                 `json:"workflowId" db:"workflowId"`
+	WorkflowVersion int                    `json:"workflowVersion" db:"workflowVersion"`
+	Status          WorkflowRunStatusType  `json:"status" db:"status"`
+	Trigger         WorkflowTriggerType    `json:"trigger" db:"trigger"`
+	StartedAt       time.Time              `json:"startedAt" db:"startedAt"`
+	EndedAt         time.Time              `json:"endedAt" db:"endedAt"`
+	Detail          *WorkflowNode          `json:"detail" db:"detail"`
+	Checkpoint      *WorkflowRunCheckpoint `json:"checkpoint" db:"checkpoint"`
+	Error           string                 `json:"error" db:"error"`
 }
 
 type WorkflowRunStatusType string
@@ -22,7 +24,34 @@ type WorkflowRunStatusType string
 const (
 	WorkflowRunStatusTypePending   WorkflowRunStatusType = "pending"
 	WorkflowRunStatusTypeRunning   WorkflowRunStatusType = "running"
+	WorkflowRunStatusTypeSuspended WorkflowRunStatusType = "suspended"
 	WorkflowRunStatusTypeSucceeded WorkflowRunStatusType = "succeeded"
 	WorkflowRunStatusTypeFailed    WorkflowRunStatusType = "failed"
 	WorkflowRunStatusTypeCanceled  WorkflowRunStatusType = "canceled"
 )
+
+// 工作流执行断点。
+// 工作流被挂起时，会记录已执行完成的节点状态，以便恢复执行时跳过这些节点并还原其输出。
+type WorkflowRunCheckpoint struct {
+	Nodes      map[string]*WorkflowRunCheckpointNode `json:"nodes"`                // 已执行完成的节点状态，key 为节点 ID
+	Suspension *WorkflowRunSuspension                `json:"suspension,omitempty"` // 挂起信息
+}
+
+type WorkflowRunCheckpointNode struct {
+	Outputs map[string]any `json:"outputs,omitempty"` // 节点输出
+	Error   string         `json:"error,omitempty"`   // 节点错误信息
+}
+
+type WorkflowRunSuspension struct {
+	NodeId      string           `json:"nodeId"`             // 挂起的节点 ID
+	NodeType    WorkflowNodeType `json:"nodeType"`           // 挂起的节点类型
+	SuspendedAt time.Time        `json:"suspendedAt"`        // 首次挂起时间
+	ResumeAt    time.Time        `json:"resumeAt,omitempty"` // 自动恢复时间（零值时仅可由外部信号恢复）
+	Signal      map[string]any   `json:"signal,omitempty"`   // 外部信号数据，如审批结果
+}
+
+func NewWorkflowRunCheckpoint() *WorkflowRunCheckpoint {
+	return &WorkflowRunCheckpoint{
+		Nodes: make(map[string]*WorkflowRunCheckpointNode),
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+const CollectionNameWorkflowVersion = "workflow_version"
+
+type WorkflowVersion struct {
+	Meta
+	WorkflowId string        `json:"workflowId" db:"workflowId"`
+	Version    int           `json:"version" db:"version"`
+	Content    *WorkflowNode `json:"content" db:"content"`
+	Author     string        `json:"author" db:"author"`
+}
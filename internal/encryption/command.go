@@ -0,0 +1,84 @@
+package encryption
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/spf13/cobra"
+
+	"github.com/certimate-go/certimate/internal/app"
+)
+
+// 创建加密管理命令。
+func NewCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "encryption",
+		Short: "Manages the encryption of sensitive data at rest",
+	}
+
+	command.AddCommand(newKeygenCommand())
+	command.AddCommand(newRotateCommand())
+
+	return command
+}
+
+func newKeygenCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "keygen",
+		Short: "Generates a new master key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := GenerateMasterKey()
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(key)
+			return nil
+		},
+	}
+}
+
+func newRotateCommand() *cobra.Command {
+	var flagNewKey string
+	var flagNewKeyFile string
+
+	command := &cobra.Command{
+		Use:   "rotate",
+		Short: "Re-encrypts all sensitive data under a new master key",
+		Long: fmt.Sprintf(`Re-encrypts all sensitive data under a new master key.
+
+The current master key is read from the environment variable '%s' or '%s'.
+Please stop the server before rotating, and replace the master key in the environment with the new one after rotated.`, envMasterKey, envMasterKeyFile),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldKey, err := GetMasterKey()
+			if err != nil {
+				return fmt.Errorf("failed to load current master key: %w", err)
+			}
+
+			newKey, err := LoadMasterKey(flagNewKey, flagNewKeyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load new master key: %w", err)
+			} else if newKey == nil {
+				return errors.New("the new master key is required, please specify it by '--new-key' or '--new-key-file'")
+			}
+
+			var count int
+			err = app.GetApp().RunInTransaction(func(txApp core.App) error {
+				count, err = Rotate(txApp, oldKey, newKey)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to rotate master key: %w", err)
+			}
+
+			fmt.Printf("Successfully re-encrypted %d records under master key '%s'.\n", count, newKey.Id())
+			return nil
+		},
+	}
+
+	command.Flags().StringVar(&flagNewKey, "new-key", "", "the new master key, base64 or hex encoded")
+	command.Flags().StringVar(&flagNewKeyFile, "new-key-file", "", "the file containing the new master key")
+
+	return command
+}
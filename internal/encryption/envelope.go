@@ -0,0 +1,171 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const envelopePrefix = "enc:v1:"
+
+// 主密钥。
+// 采用信封加密：每个值使用随机生成的数据密钥加密，数据密钥再由主密钥加密后与密文一同保存。
+type MasterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+func NewMasterKey(key []byte) (*MasterKey, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid master key length: expected 32 bytes, got %d", len(key))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(key)
+	return &MasterKey{
+		id:   hex.EncodeToString(hash[:4]),
+		aead: aead,
+	}, nil
+}
+
+// 解析主密钥，支持 Base64 或十六进制编码的 32 字节密钥。
+func ParseMasterKey(s string) (*MasterKey, error) {
+	s = strings.TrimSpace(s)
+
+	if key, err := hex.DecodeString(s); err == nil && len(key) == 32 {
+		return NewMasterKey(key)
+	}
+
+	if key, err := base64.StdEncoding.DecodeString(s); err == nil {
+		return NewMasterKey(key)
+	}
+
+	return nil, errors.New("invalid master key: must be a base64 or hex encoded 32-byte key")
+}
+
+// 生成一个新的主密钥，返回其 Base64 编码。
+func GenerateMasterKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// 获取主密钥的标识，由密钥摘要派生，用于在解密时识别密文所使用的主密钥。
+func (k *MasterKey) Id() string {
+	return k.id
+}
+
+// 加密数据。
+// 返回的信封格式为 `enc:v1:<密钥标识>:<加密的数据密钥>:<密文>`。
+// aad 为附加认证数据，解密时须提供相同的值。
+func (k *MasterKey) Encrypt(plaintext []byte, aad []byte) (string, error) {
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return "", err
+	}
+
+	dekAEAD, err := newAEAD(dek)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedDEK, err := seal(k.aead, dek, []byte(k.id))
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dekAEAD, plaintext, aad)
+	if err != nil {
+		return "", err
+	}
+
+	return envelopePrefix + k.id + ":" + base64.RawStdEncoding.EncodeToString(wrappedDEK) + ":" + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// 解密由 [MasterKey.Encrypt] 生成的信封。
+func (k *MasterKey) Decrypt(envelope string, aad []byte) ([]byte, error) {
+	if !IsEncrypted(envelope) {
+		return nil, errors.New("invalid envelope: missing prefix")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(envelope, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return nil, errors.New("invalid envelope: malformed")
+	}
+
+	if parts[0] != k.id {
+		return nil, fmt.Errorf("the value was encrypted with master key '%s', but the current master key is '%s'", parts[0], k.id)
+	}
+
+	wrappedDEK, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+
+	dek, err := open(k.aead, wrappedDEK, []byte(k.id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+
+	dekAEAD, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := open(dekAEAD, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// 判断值是否为加密后的信封。
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, envelopePrefix)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, data []byte, aad []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
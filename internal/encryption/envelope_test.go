@@ -0,0 +1,62 @@
+package encryption
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestMasterKey_EncryptDecrypt(t *testing.T) {
+	key1, err := ParseMasterKey(strings.Repeat("ab", 32))
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	generated, err := GenerateMasterKey()
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	key2, err := ParseMasterKey(generated)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	plaintext := []byte(`{"accessKeyId":"AK","accessKeySecret":"SK"}`)
+	aad := []byte("access.config")
+
+	envelope, err := key1.Encrypt(plaintext, aad)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if !IsEncrypted(envelope) || strings.Contains(envelope, "SK") {
+		t.Errorf("unexpected envelope: %s", envelope)
+	}
+
+	if actual, err := key1.Decrypt(envelope, aad); err != nil {
+		t.Errorf("err: %+v", err)
+	} else if string(actual) != string(plaintext) {
+		t.Errorf("expected %s, got %s", plaintext, actual)
+	}
+
+	if _, err := key1.Decrypt(envelope, []byte("certificate.privateKey")); err == nil {
+		t.Errorf("expected error when decrypting with mismatched aad")
+	}
+
+	if _, err := key2.Decrypt(envelope, aad); err == nil || !strings.Contains(err.Error(), key1.Id()) {
+		t.Errorf("expected error when decrypting with another master key, got %v", err)
+	}
+
+	if another, _ := key1.Encrypt(plaintext, aad); another == envelope {
+		t.Errorf("expected different envelopes for the same plaintext")
+	}
+}
+
+func TestParseMasterKey(t *testing.T) {
+	if _, err := ParseMasterKey(hex.EncodeToString([]byte("short"))); err == nil {
+		t.Errorf("expected error when parsing a short key")
+	}
+
+	if _, err := ParseMasterKey("not a key"); err == nil {
+		t.Errorf("expected error when parsing an invalid key")
+	}
+}
@@ -0,0 +1,50 @@
+package encryption
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+const (
+	envMasterKey     = "CERTIMATE_ENCRYPTION_KEY"
+	envMasterKeyFile = "CERTIMATE_ENCRYPTION_KEY_FILE"
+)
+
+var (
+	masterKey     *MasterKey
+	masterKeyErr  error
+	masterKeyOnce sync.Once
+)
+
+// 获取当前配置的主密钥。
+// 主密钥通过环境变量 `CERTIMATE_ENCRYPTION_KEY` 或 `CERTIMATE_ENCRYPTION_KEY_FILE` 指定；均未指定时返回 nil，即不启用加密。
+func GetMasterKey() (*MasterKey, error) {
+	masterKeyOnce.Do(func() {
+		masterKey, masterKeyErr = LoadMasterKey(os.Getenv(envMasterKey), os.Getenv(envMasterKeyFile))
+	})
+
+	return masterKey, masterKeyErr
+}
+
+// 从密钥或密钥文件加载主密钥，二者均为空时返回 nil。
+func LoadMasterKey(key string, keyFile string) (*MasterKey, error) {
+	if key != "" && keyFile != "" {
+		return nil, fmt.Errorf("master key and master key file cannot be specified at the same time")
+	}
+
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read master key file: %w", err)
+		}
+
+		key = string(data)
+	}
+
+	if key == "" {
+		return nil, nil
+	}
+
+	return ParseMasterKey(key)
+}
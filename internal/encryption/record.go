@@ -0,0 +1,187 @@
+package encryption
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+// 需要加密存储的字段，key 为集合名称。
+var encryptedFields = map[string][]string{
+	domain.CollectionNameAccess:      {"config"},
+	domain.CollectionNameCertificate: {"privateKey"},
+}
+
+// 注册记录钩子。
+// 记录在写入数据库前自动加密敏感字段，通过 API 读取时自动解密，对前端与仓储层透明。
+func Register() {
+	pb := app.GetApp()
+
+	collections := make([]string, 0, len(encryptedFields))
+	for collection := range encryptedFields {
+		collections = append(collections, collection)
+	}
+
+	pb.OnRecordCreateExecute(collections...).BindFunc(onRecordExecute)
+	pb.OnRecordUpdateExecute(collections...).BindFunc(onRecordExecute)
+	pb.OnRecordEnrich(collections...).BindFunc(func(e *core.RecordEnrichEvent) error {
+		if err := DecryptRecord(e.Record); err != nil {
+			return err
+		}
+
+		return e.Next()
+	})
+}
+
+func onRecordExecute(e *core.RecordEvent) error {
+	key, err := GetMasterKey()
+	if err != nil {
+		return err
+	} else if key == nil {
+		return e.Next()
+	}
+
+	// 写入完成后还原内存中的明文，避免影响后续使用该记录的逻辑
+	originals := make(map[string]any)
+	for _, field := range encryptedFields[e.Record.Collection().Name] {
+		originals[field] = e.Record.Get(field)
+	}
+	defer func() {
+		for field, value := range originals {
+			e.Record.Set(field, value)
+		}
+	}()
+
+	if err := EncryptRecord(e.Record, key); err != nil {
+		return err
+	}
+
+	return e.Next()
+}
+
+// 使用指定的主密钥加密记录中的敏感字段，已加密的字段将被跳过。
+func EncryptRecord(record *core.Record, key *MasterKey) error {
+	for _, field := range encryptedFields[record.Collection().Name] {
+		value, encrypted := readField(record, field)
+		if encrypted || value == "" {
+			continue
+		}
+
+		// 新记录的 ID 在执行写入前已由 PocketBase 自动生成
+		if record.Id == "" {
+			return fmt.Errorf("failed to encrypt field '%s': the record id is empty", field)
+		}
+
+		envelope, err := key.Encrypt([]byte(value), fieldAAD(record, field))
+		if err != nil {
+			return fmt.Errorf("failed to encrypt field '%s' of record #%s: %w", field, record.Id, err)
+		}
+
+		record.Set(field, envelope)
+	}
+
+	return nil
+}
+
+// 使用当前配置的主密钥解密记录中的敏感字段，未加密的字段将被跳过。
+func DecryptRecord(record *core.Record) error {
+	fields := encryptedFields[record.Collection().Name]
+	if len(fields) == 0 {
+		return nil
+	}
+
+	key, err := GetMasterKey()
+	if err != nil {
+		return err
+	}
+
+	return decryptRecord(record, key)
+}
+
+func decryptRecord(record *core.Record, key *MasterKey) error {
+	for _, field := range encryptedFields[record.Collection().Name] {
+		value, encrypted := readField(record, field)
+		if !encrypted {
+			continue
+		}
+
+		if key == nil {
+			return fmt.Errorf("failed to decrypt field '%s' of record #%s: the master key is not configured, please set the environment variable '%s' or '%s'", field, record.Id, envMasterKey, envMasterKeyFile)
+		}
+
+		plaintext, err := key.Decrypt(value, fieldAAD(record, field))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt field '%s' of record #%s: %w", field, record.Id, err)
+		}
+
+		record.Set(field, string(plaintext))
+	}
+
+	return nil
+}
+
+// 读取字段的原始值，并判断其是否已加密。
+// JSON 字段加密后以 JSON 字符串的形式保存。
+func readField(record *core.Record, field string) (string, bool) {
+	if _, ok := record.Collection().Fields.GetByName(field).(*core.JSONField); ok {
+		raw, _ := record.Get(field).(types.JSONRaw)
+		if len(raw) == 0 || string(raw) == "null" {
+			return "", false
+		}
+
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil && IsEncrypted(s) {
+			return s, true
+		}
+
+		return string(raw), false
+	}
+
+	value := record.GetString(field)
+	return value, IsEncrypted(value)
+}
+
+// 附加认证数据绑定集合名称、记录 ID 与字段名称，防止密文被复制到其他记录或字段后仍能解密。
+func fieldAAD(record *core.Record, field string) []byte {
+	return []byte(record.Collection().Name + "/" + record.Id + "." + field)
+}
+
+// 使用新的主密钥重新加密所有记录中的敏感字段。
+// 旧主密钥用于解密已有的密文；尚未加密的明文字段也将一并加密。
+func Rotate(txApp core.App, oldKey *MasterKey, newKey *MasterKey) (int, error) {
+	if newKey == nil {
+		return 0, errors.New("the new master key is required")
+	}
+
+	count := 0
+	for collection := range encryptedFields {
+		records, err := txApp.FindAllRecords(collection)
+		if err != nil {
+			return count, err
+		}
+
+		for _, record := range records {
+			if err := decryptRecord(record, oldKey); err != nil {
+				return count, err
+			}
+
+			if err := EncryptRecord(record, newKey); err != nil {
+				return count, err
+			}
+
+			if err := txApp.SaveNoValidate(record); err != nil {
+				return count, fmt.Errorf("failed to save record #%s of collection '%s': %w", record.Id, collection, err)
+			}
+
+			count++
+		}
+	}
+
+	return count, nil
+}
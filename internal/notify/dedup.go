@@ -0,0 +1,220 @@
+package notify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/dbx"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+// 工作流节点执行失败的信息。
+type NotifyFailure struct {
+	WorkflowId string
+	NodeId     string
+	NodeName   string
+	Error      string
+}
+
+type notificationFingerprintRepository interface {
+	ListByWorkflowId(ctx context.Context, workflowId string) ([]*domain.NotificationFingerprint, error)
+	GetByFingerprint(ctx context.Context, fingerprint string) (*domain.NotificationFingerprint, error)
+	Save(ctx context.Context, fingerprint *domain.NotificationFingerprint) (*domain.NotificationFingerprint, error)
+	DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error)
+}
+
+// 失败通知去重器。
+// 按工作流、失败节点、通知节点与错误类别生成指纹，在抑制窗口内仅发送一次，
+// 窗口过后再次失败时发送汇总通知。
+type notifyDeduper struct {
+	repo         notificationFingerprintRepository
+	logger       *slog.Logger
+	failure      *NotifyFailure
+	notifyNodeId string
+	window       time.Duration
+}
+
+func (d *notifyDeduper) Notify(ctx context.Context, message *core.NotifyMessage, send func(ctx context.Context, message *core.NotifyMessage) error) error {
+	errorClass := classifyError(d.failure.Error)
+	fingerprint := computeFingerprint(d.failure.WorkflowId, d.failure.NodeId, d.notifyNodeId, errorClass)
+
+	now := time.Now()
+	record, err := d.repo.GetByFingerprint(ctx, fingerprint)
+	if err != nil && !domain.IsRecordNotFoundError(err) {
+		return fmt.Errorf("failed to get notification fingerprint: %w", err)
+	}
+
+	if record == nil {
+		record = &domain.NotificationFingerprint{
+			Fingerprint:  fingerprint,
+			WorkflowId:   d.failure.WorkflowId,
+			NodeId:       d.failure.NodeId,
+			NodeName:     d.failure.NodeName,
+			NotifyNodeId: d.notifyNodeId,
+			ErrorClass:   errorClass,
+			Occurrences:  1,
+			FirstSeenAt:  now,
+			LastSeenAt:   now,
+		}
+	} else {
+		record.Occurrences++
+		record.Suppressed++
+		record.LastSeenAt = now
+
+		if now.Before(record.LastNotifiedAt.Add(d.window)) {
+			d.logger.Info(fmt.Sprintf("notification suppressed, because the same failure has been notified at %s", record.LastNotifiedAt.Format(time.RFC3339)), slog.Int("occurrences", record.Occurrences))
+			_, err := d.repo.Save(ctx, record)
+			return err
+		}
+
+		message = buildStillFailingMessage(message, record)
+	}
+
+	if err := send(ctx, message); err != nil {
+		if _, err := d.repo.Save(ctx, record); err != nil {
+			d.logger.Warn("failed to save notification fingerprint", slog.Any("error", err))
+		}
+		return err
+	}
+
+	record.Suppressed = 0
+	record.LastNotifiedAt = now
+	if _, err := d.repo.Save(ctx, record); err != nil {
+		return fmt.Errorf("failed to save notification fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+// 发送已恢复通知。
+// 对于此前失败、而在本次执行中成功的节点，通过原失败通知节点发送一条恢复通知，并清除其去重指纹。
+func NotifyRecovered(ctx context.Context, workflowId string, workflowContent *domain.WorkflowNode, succeededNodeIds []string) error {
+	repo := repository.NewNotificationFingerprintRepository()
+	records, err := repo.ListByWorkflowId(ctx, workflowId)
+	if err != nil {
+		return fmt.Errorf("failed to get notification fingerprints: %w", err)
+	}
+
+	var errs []error
+	for _, record := range records {
+		if !slices.Contains(succeededNodeIds, record.NodeId) {
+			continue
+		}
+
+		if notifyNode := findWorkflowNode(workflowContent, record.NotifyNodeId); notifyNode != nil && notifyNode.Type == domain.WorkflowNodeTypeNotify {
+			nodeCfg := notifyNode.GetConfigForNotify()
+			notifier, err := createNotifierProviderWithAccess(nodeCfg.Provider, nodeCfg.ProviderAccessId, nodeCfg.ProviderConfig, app.GetLogger())
+			if err != nil {
+				errs = append(errs, err)
+			} else if err := defaultOutbox.Send(ctx, notifier, &deliveryTarget{
+				Provider:         nodeCfg.Provider,
+				ProviderAccessId: nodeCfg.ProviderAccessId,
+				ProviderConfig:   nodeCfg.ProviderConfig,
+				WorkflowId:       workflowId,
+			}, buildRecoveredMessage(record)); err != nil {
+				errs = append(errs, fmt.Errorf("failed to send recovered notification of node #%s: %w", record.NodeId, err))
+			}
+		}
+
+		if _, err := repo.DeleteWhere(ctx, dbx.HashExp{"id": record.Id}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func buildStillFailingMessage(message *core.NotifyMessage, record *domain.NotificationFingerprint) *core.NotifyMessage {
+	summary := fmt.Sprintf("自 %s 起已连续失败 %d 次，期间有 %d 条重复通知被抑制。", record.FirstSeenAt.Format(time.DateTime), record.Occurrences, record.Suppressed)
+
+	result := *message
+	result.Subject = fmt.Sprintf("%s（仍然失败 ×%d）", message.Subject, record.Occurrences)
+	result.Fields = append(slices.Clone(message.Fields), core.NotifyMessageField{Name: "Occurrences", Value: fmt.Sprintf("%d", record.Occurrences), Inline: true})
+	if result.Body != "" {
+		result.Body = result.Body + "\n\n" + summary
+	} else if result.Markdown == "" && result.HTML == "" {
+		result.Body = summary
+	}
+	if result.Markdown != "" {
+		result.Markdown = result.Markdown + "\n\n" + summary
+	}
+	if result.HTML != "" {
+		result.HTML = result.HTML + "<p>" + summary + "</p>"
+	}
+	return &result
+}
+
+func buildRecoveredMessage(record *domain.NotificationFingerprint) *core.NotifyMessage {
+	nodeName := record.NodeName
+	if nodeName == "" {
+		nodeName = record.NodeId
+	}
+
+	return &core.NotifyMessage{
+		Subject:  fmt.Sprintf("节点「%s」已恢复", nodeName),
+		Severity: core.NotifySeveritySuccess,
+		Body:     fmt.Sprintf("节点「%s」已恢复正常。此前自 %s 起连续失败 %d 次。", nodeName, record.FirstSeenAt.Format(time.DateTime), record.Occurrences),
+		Fields: []core.NotifyMessageField{
+			{Name: "Occurrences", Value: fmt.Sprintf("%d", record.Occurrences), Inline: true},
+		},
+	}
+}
+
+func computeFingerprint(parts ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(hash[:16])
+}
+
+var errorClassReplacers = []struct {
+	regexp      *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`"[^"]*"|'[^']*'|` + "`[^`]*`"), "<str>"},
+	{regexp.MustCompile(`https?://\S+`), "<url>"},
+	{regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`), "<uuid>"},
+	{regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b`), "<ip>"},
+	{regexp.MustCompile(`\b[0-9a-f]{8,}\b`), "<hex>"},
+	{regexp.MustCompile(`\d+`), "<n>"},
+	{regexp.MustCompile(`\s+`), " "},
+}
+
+// 将错误信息归类，去除其中的易变部分（如请求 ID、时间戳、地址等），使同类错误得到相同的结果。
+func classifyError(err string) string {
+	class := strings.ToLower(strings.TrimSpace(err))
+	for _, r := range errorClassReplacers {
+		class = r.regexp.ReplaceAllString(class, r.replacement)
+	}
+
+	if runes := []rune(class); len(runes) > 256 {
+		class = string(runes[:256])
+	}
+	return class
+}
+
+func findWorkflowNode(node *domain.WorkflowNode, nodeId string) *domain.WorkflowNode {
+	for ; node != nil; node = node.Next {
+		if node.Id == nodeId {
+			return node
+		}
+
+		for i := range node.Branches {
+			if found := findWorkflowNode(&node.Branches[i], nodeId); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pocketbase/dbx"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+func Test_classifyError(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{"request failed: status 500, request id 8f14e45f-ceea-467f-a0e1-3b4f0f9b3c2d", "request failed: status 500, request id 0c1d2e3f-1111-4222-8333-944455556666", true},
+		{"dial tcp 10.0.0.1:443: i/o timeout", "dial tcp 192.168.1.20:8443: i/o timeout", true},
+		{`domain "a.example.com" not found`, `domain "b.example.com" not found`, true},
+		{"failed to get https://example.com/a?x=1", "failed to get https://example.com/b", true},
+		{"i/o timeout", "access denied", false},
+	}
+
+	for _, tt := range tests {
+		if got := classifyError(tt.a) == classifyError(tt.b); got != tt.same {
+			t.Errorf("classifyError(%q) == classifyError(%q): got %v, want %v", tt.a, tt.b, got, tt.same)
+		}
+	}
+
+	if class := classifyError(strings.Repeat("x", 1000)); len([]rune(class)) != 256 {
+		t.Errorf("expected error class to be truncated, got length %d", len([]rune(class)))
+	}
+}
+
+type mockNotificationFingerprintRepository struct {
+	fingerprints map[string]*domain.NotificationFingerprint
+}
+
+func (r *mockNotificationFingerprintRepository) ListByWorkflowId(ctx context.Context, workflowId string) ([]*domain.NotificationFingerprint, error) {
+	return nil, nil
+}
+
+func (r *mockNotificationFingerprintRepository) GetByFingerprint(ctx context.Context, fingerprint string) (*domain.NotificationFingerprint, error) {
+	if record, ok := r.fingerprints[fingerprint]; ok {
+		return record, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockNotificationFingerprintRepository) Save(ctx context.Context, fingerprint *domain.NotificationFingerprint) (*domain.NotificationFingerprint, error) {
+	r.fingerprints[fingerprint.Fingerprint] = fingerprint
+	return fingerprint, nil
+}
+
+func (r *mockNotificationFingerprintRepository) DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error) {
+	return 0, nil
+}
+
+func TestNotifyDeduper_Notify(t *testing.T) {
+	repo := &mockNotificationFingerprintRepository{fingerprints: make(map[string]*domain.NotificationFingerprint)}
+	deduper := &notifyDeduper{
+		repo:         repo,
+		logger:       slog.Default(),
+		failure:      &NotifyFailure{WorkflowId: "wf1", NodeId: "n1", NodeName: "apply", Error: "request 123 failed"},
+		notifyNodeId: "n2",
+		window:       time.Hour,
+	}
+
+	sent := make([]*core.NotifyMessage, 0)
+	send := func(ctx context.Context, message *core.NotifyMessage) error {
+		sent = append(sent, message)
+		return nil
+	}
+
+	message := &core.NotifyMessage{Subject: "failed", Body: "failed"}
+	for i := 0; i < 3; i++ {
+		if err := deduper.Notify(context.Background(), message, send); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+	}
+	if len(sent) != 1 {
+		t.Fatalf("expected 1 notification sent, got %d", len(sent))
+	}
+
+	// 模拟抑制窗口已过
+	for _, record := range repo.fingerprints {
+		if record.Occurrences != 3 || record.Suppressed != 2 {
+			t.Errorf("unexpected fingerprint: %+v", record)
+		}
+		record.LastNotifiedAt = record.LastNotifiedAt.Add(-2 * time.Hour)
+	}
+
+	deduper.failure.Error = "request 456 failed"
+	if err := deduper.Notify(context.Background(), message, send); err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 notifications sent, got %d", len(sent))
+	}
+	if !strings.Contains(sent[1].Subject, "×4") || !strings.Contains(sent[1].Body, "3 条重复通知被抑制") {
+		t.Errorf("unexpected summary message: %+v", sent[1])
+	}
+	if message.Subject != "failed" {
+		t.Errorf("original message should not be modified")
+	}
+}
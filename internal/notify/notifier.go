@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/internal/secret"
 	"github.com/certimate-go/certimate/pkg/core"
 )
 
@@ -15,10 +17,18 @@ type Notifier interface {
 }
 
 type NotifierWithWorkflowNodeConfig struct {
-	Node    *domain.WorkflowNode
-	Logger  *slog.Logger
-	Subject string
-	Message string
+	Node          *domain.WorkflowNode
+	Logger        *slog.Logger
+	WorkflowId    string
+	WorkflowRunId string
+	Subject       string
+	Message       string
+	// 结构化消息。
+	// 非空时优先于 Subject、Message 使用，由通知器按渠道原生格式渲染。
+	Payload *core.NotifyMessage
+	// 前序节点执行失败的信息。
+	// 非空时将对相同的失败通知去重，参见 [notifyDeduper]。
+	Failure *NotifyFailure
 }
 
 func NewWithWorkflowNode(config NotifierWithWorkflowNodeConfig) (Notifier, error) {
@@ -30,19 +40,60 @@ func NewWithWorkflowNode(config NotifierWithWorkflowNodeConfig) (Notifier, error
 	}
 
 	nodeCfg := config.Node.GetConfigForNotify()
+	notifier, err := createNotifierProviderWithAccess(nodeCfg.Provider, nodeCfg.ProviderAccessId, nodeCfg.ProviderConfig, config.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var deduper *notifyDeduper
+	if config.Failure != nil && !nodeCfg.DedupDisabled {
+		deduper = &notifyDeduper{
+			repo:         repository.NewNotificationFingerprintRepository(),
+			logger:       config.Logger,
+			failure:      config.Failure,
+			notifyNodeId: config.Node.Id,
+			window:       time.Duration(nodeCfg.DedupWindow) * time.Minute,
+		}
+		if deduper.logger == nil {
+			deduper.logger = slog.New(slog.DiscardHandler)
+		}
+	}
+
+	return &notifierImpl{
+		provider: notifier,
+		target: &deliveryTarget{
+			Provider:         nodeCfg.Provider,
+			ProviderAccessId: nodeCfg.ProviderAccessId,
+			ProviderConfig:   nodeCfg.ProviderConfig,
+			WorkflowId:       config.WorkflowId,
+			WorkflowRunId:    config.WorkflowRunId,
+		},
+		subject: config.Subject,
+		message: config.Message,
+		payload: config.Payload,
+		deduper: deduper,
+	}, nil
+}
+
+func createNotifierProviderWithAccess(provider string, providerAccessId string, providerConfig map[string]any, logger *slog.Logger) (core.Notifier, error) {
 	options := &notifierProviderOptions{
-		Provider:              domain.NotificationProviderType(nodeCfg.Provider),
+		Provider:              domain.NotificationProviderType(provider),
 		ProviderAccessConfig:  make(map[string]any),
-		ProviderServiceConfig: nodeCfg.ProviderConfig,
+		ProviderServiceConfig: providerConfig,
 	}
 
 	accessRepo := repository.NewAccessRepository()
-	if nodeCfg.ProviderAccessId != "" {
-		access, err := accessRepo.GetById(context.Background(), nodeCfg.ProviderAccessId)
+	if providerAccessId != "" {
+		access, err := accessRepo.GetById(context.Background(), providerAccessId)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get access #%s record: %w", nodeCfg.ProviderAccessId, err)
+			return nil, fmt.Errorf("failed to get access #%s record: %w", providerAccessId, err)
+		}
+
+		accessConfig, err := secret.ResolveConfig(context.Background(), access.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve access #%s config: %w", providerAccessId, err)
 		} else {
-			options.ProviderAccessConfig = access.Config
+			options.ProviderAccessConfig = accessConfig
 		}
 	}
 
@@ -50,25 +101,41 @@ func NewWithWorkflowNode(config NotifierWithWorkflowNodeConfig) (Notifier, error
 	if err != nil {
 		return nil, err
 	} else {
-		notifier.SetLogger(config.Logger)
+		notifier.SetLogger(logger)
 	}
 
-	return &notifierImpl{
-		provider: notifier,
-		subject:  config.Subject,
-		message:  config.Message,
-	}, nil
+	return withRateLimit(notifier, options.Provider, providerAccessId), nil
 }
 
 type notifierImpl struct {
 	provider core.Notifier
+	target   *deliveryTarget
 	subject  string
 	message  string
+	payload  *core.NotifyMessage
+	deduper  *notifyDeduper
 }
 
 var _ Notifier = (*notifierImpl)(nil)
 
 func (n *notifierImpl) Notify(ctx context.Context) error {
-	_, err := n.provider.Notify(ctx, n.subject, n.message)
-	return err
+	if n.deduper != nil {
+		message := n.payload
+		if message == nil {
+			message = &core.NotifyMessage{Subject: n.subject, Body: n.message, Severity: core.NotifySeverityError}
+		}
+
+		return n.deduper.Notify(ctx, message, func(ctx context.Context, message *core.NotifyMessage) error {
+			return defaultOutbox.Send(ctx, n.provider, n.target, message)
+		})
+	}
+
+	if n.payload != nil {
+		return defaultOutbox.Send(ctx, n.provider, n.target, n.payload)
+	}
+
+	delivery := newDelivery(n.target, &core.NotifyMessage{Subject: n.subject, Body: n.message})
+	return defaultOutbox.deliver(ctx, delivery, func(ctx context.Context) (*core.NotifyResult, error) {
+		return n.provider.Notify(ctx, n.subject, n.message)
+	})
 }
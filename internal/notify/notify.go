@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 
 	"golang.org/x/sync/errgroup"
 
@@ -14,29 +15,30 @@ import (
 )
 
 // Deprecated: v0.4.x 将废弃
-func SendToAllChannels(subject, message string) error {
-	notifiers, err := getEnabledNotifiers()
+func sendToLegacyChannels(ctx context.Context, message *core.NotifyMessage) (bool, error) {
+	notifiers, err := getEnabledNotifiers(ctx)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if len(notifiers) == 0 {
-		return nil
+		return false, nil
 	}
 
+	var delivered atomic.Bool
 	var eg errgroup.Group
 	for _, n := range notifiers {
-		if n == nil {
-			continue
-		}
-
 		eg.Go(func() error {
-			_, err := n.Notify(context.Background(), subject, message)
-			return err
+			if _, err := core.NotifyWithMessage(ctx, n, message); err != nil {
+				return err
+			}
+
+			delivered.Store(true)
+			return nil
 		})
 	}
 
 	err = eg.Wait()
-	return err
+	return delivered.Load(), err
 }
 
 // Deprecated: v0.4.x 将废弃
@@ -51,14 +53,17 @@ func SendToChannel(subject, message string, channel string, channelConfig map[st
 }
 
 // Deprecated: v0.4.x 将废弃
-func getEnabledNotifiers() ([]core.Notifier, error) {
+func getEnabledNotifiers(ctx context.Context) ([]core.Notifier, error) {
 	settingsRepo := repository.NewSettingsRepository()
-	settings, err := settingsRepo.GetByName(context.Background(), "notifyChannels")
+	settings, err := settingsRepo.GetByName(ctx, "notifyChannels")
 	if err != nil {
+		if domain.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("find notifyChannels error: %w", err)
 	}
 
-	rs := make(map[string]map[string]any)
+	rs := domain.NotifyChannelsSettingsContent{}
 	if err := json.Unmarshal([]byte(settings.Content), &rs); err != nil {
 		return nil, fmt.Errorf("unmarshal notifyChannels error: %w", err)
 	}
@@ -0,0 +1,188 @@
+package notify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+// 通知投递失败后的重试间隔，依次递增，超过次数后不再重试。
+var deliveryRetryBackoffs = []time.Duration{
+	1 * time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	1 * time.Hour,
+	6 * time.Hour,
+}
+
+type notificationDeliveryRepository interface {
+	ListRetryDue(ctx context.Context, now time.Time) ([]*domain.NotificationDelivery, error)
+	Save(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error)
+}
+
+type accessRepository interface {
+	GetById(ctx context.Context, id string) (*domain.Access, error)
+}
+
+// 通知投递的渠道与来源。
+type deliveryTarget struct {
+	Provider         string
+	ProviderAccessId string
+	ProviderConfig   map[string]any
+	WorkflowId       string
+	WorkflowRunId    string
+	AlertId          string
+}
+
+// 通知发件箱。
+// 记录每一次发送尝试的结果，并对发送失败的通知按退避间隔在后台重试。
+type Outbox struct {
+	deliveryRepo notificationDeliveryRepository
+	accessRepo   accessRepository
+}
+
+func NewOutbox(deliveryRepo notificationDeliveryRepository, accessRepo accessRepository) *Outbox {
+	return &Outbox{
+		deliveryRepo: deliveryRepo,
+		accessRepo:   accessRepo,
+	}
+}
+
+var defaultOutbox = NewOutbox(repository.NewNotificationDeliveryRepository(), repository.NewAccessRepository())
+
+// 发送结构化消息并记录投递结果。
+// 发送失败时会安排后台重试，但仍返回本次的错误。
+func (o *Outbox) Send(ctx context.Context, notifier core.Notifier, target *deliveryTarget, message *core.NotifyMessage) error {
+	if message == nil {
+		message = &core.NotifyMessage{}
+	}
+
+	return o.deliver(ctx, newDelivery(target, message), func(ctx context.Context) (*core.NotifyResult, error) {
+		return core.NotifyWithMessage(ctx, notifier, message)
+	})
+}
+
+// 重试所有已到达重试时间的投递。
+func (o *Outbox) Retry(ctx context.Context) error {
+	deliveries, err := o.deliveryRepo.ListRetryDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to get notification deliveries to retry: %w", err)
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		// 先将原记录标记为失败，避免在重试耗时较长时被下一轮重复重试
+		delivery.Status = domain.NotificationDeliveryStatusTypeFailed
+		delivery.NextRetryAt = time.Time{}
+		if _, err := o.deliveryRepo.Save(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("failed to save notification delivery #%s: %w", delivery.Id, err))
+			continue
+		}
+
+		retry := &domain.NotificationDelivery{
+			Provider:         delivery.Provider,
+			ProviderAccessId: delivery.ProviderAccessId,
+			ProviderConfig:   delivery.ProviderConfig,
+			Target:           delivery.Target,
+			WorkflowId:       delivery.WorkflowId,
+			WorkflowRunId:    delivery.WorkflowRunId,
+			AlertId:          delivery.AlertId,
+			Subject:          delivery.Subject,
+			Payload:          delivery.Payload,
+			PayloadHash:      delivery.PayloadHash,
+			Attempt:          delivery.Attempt + 1,
+			RetryOf:          delivery.RetryOf,
+		}
+		if retry.RetryOf == "" {
+			retry.RetryOf = delivery.Id
+		}
+
+		err := o.deliver(ctx, retry, func(ctx context.Context) (*core.NotifyResult, error) {
+			notifier, err := createNotifierProviderWithAccess(retry.Provider, retry.ProviderAccessId, retry.ProviderConfig, app.GetLogger())
+			if err != nil {
+				return nil, err
+			}
+
+			return core.NotifyWithMessage(ctx, notifier, retry.Payload)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to retry notification delivery #%s: %w", retry.RetryOf, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (o *Outbox) deliver(ctx context.Context, delivery *domain.NotificationDelivery, send func(ctx context.Context) (*core.NotifyResult, error)) error {
+	if delivery.Target == "" && delivery.ProviderAccessId != "" {
+		if access, err := o.accessRepo.GetById(ctx, delivery.ProviderAccessId); err == nil {
+			delivery.Target = access.Name
+		}
+	}
+
+	startedAt := time.Now()
+	res, err := send(ctx)
+	delivery.Latency = time.Since(startedAt).Milliseconds()
+	if res != nil {
+		delivery.Response = res.ExtendedData
+	}
+
+	if err != nil {
+		delivery.Error = err.Error()
+		if delivery.Attempt <= len(deliveryRetryBackoffs) && !errors.Is(err, context.Canceled) {
+			delivery.Status = domain.NotificationDeliveryStatusTypeRetrying
+			delivery.NextRetryAt = time.Now().Add(deliveryRetryBackoffs[delivery.Attempt-1])
+		} else {
+			delivery.Status = domain.NotificationDeliveryStatusTypeFailed
+		}
+	} else {
+		delivery.Status = domain.NotificationDeliveryStatusTypeSucceeded
+	}
+
+	// 投递记录保存失败不影响发送结果
+	if _, serr := o.deliveryRepo.Save(context.Background(), delivery); serr != nil {
+		app.GetLogger().Warn("failed to save notification delivery", "err", serr)
+	}
+
+	return err
+}
+
+func newDelivery(target *deliveryTarget, message *core.NotifyMessage) *domain.NotificationDelivery {
+	// 附件可能较大，不在投递记录中保存，重试时也不再发送
+	payload := *message
+	payload.Attachments = nil
+
+	return &domain.NotificationDelivery{
+		Provider:         target.Provider,
+		ProviderAccessId: target.ProviderAccessId,
+		ProviderConfig:   target.ProviderConfig,
+		WorkflowId:       target.WorkflowId,
+		WorkflowRunId:    target.WorkflowRunId,
+		AlertId:          target.AlertId,
+		Subject:          message.Subject,
+		Payload:          &payload,
+		PayloadHash:      hashPayload(message),
+		Attempt:          1,
+	}
+}
+
+func hashPayload(message *core.NotifyMessage) string {
+	data, _ := json.Marshal(message)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
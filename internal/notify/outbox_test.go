@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type mockNotificationDeliveryRepository struct {
+	deliveries []*domain.NotificationDelivery
+}
+
+func (r *mockNotificationDeliveryRepository) ListRetryDue(ctx context.Context, now time.Time) ([]*domain.NotificationDelivery, error) {
+	return nil, nil
+}
+
+func (r *mockNotificationDeliveryRepository) Save(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	r.deliveries = append(r.deliveries, delivery)
+	return delivery, nil
+}
+
+func TestOutbox_deliver(t *testing.T) {
+	repo := &mockNotificationDeliveryRepository{}
+	outbox := NewOutbox(repo, nil)
+	target := &deliveryTarget{Provider: "webhook", WorkflowId: "wf1"}
+	message := &core.NotifyMessage{
+		Subject:     "hello",
+		Body:        "world",
+		Attachments: []core.NotifyMessageAttachment{{Name: "a.txt", Data: []byte("a")}},
+	}
+
+	err := outbox.deliver(context.Background(), newDelivery(target, message), func(ctx context.Context) (*core.NotifyResult, error) {
+		return &core.NotifyResult{ExtendedData: map[string]any{"code": 0}}, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if delivery := repo.deliveries[0]; delivery.Status != domain.NotificationDeliveryStatusTypeSucceeded || delivery.Response["code"] != 0 || delivery.PayloadHash == "" {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	} else if len(delivery.Payload.Attachments) != 0 || len(message.Attachments) != 1 {
+		t.Errorf("attachments should be stripped from the stored payload only")
+	}
+
+	sendErr := errors.New("connection refused")
+	err = outbox.deliver(context.Background(), newDelivery(target, message), func(ctx context.Context) (*core.NotifyResult, error) {
+		return nil, sendErr
+	})
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("expected send error, got %v", err)
+	}
+	if delivery := repo.deliveries[1]; delivery.Status != domain.NotificationDeliveryStatusTypeRetrying || delivery.NextRetryAt.IsZero() || delivery.Error != sendErr.Error() {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+
+	exhausted := newDelivery(target, message)
+	exhausted.Attempt = len(deliveryRetryBackoffs) + 1
+	outbox.deliver(context.Background(), exhausted, func(ctx context.Context) (*core.NotifyResult, error) {
+		return nil, sendErr
+	})
+	if delivery := repo.deliveries[2]; delivery.Status != domain.NotificationDeliveryStatusTypeFailed || !delivery.NextRetryAt.IsZero() {
+		t.Errorf("unexpected delivery: %+v", delivery)
+	}
+}
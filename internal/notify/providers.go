@@ -1,22 +1,32 @@
 package notify
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/pkg/core"
+	pAliyunSMS "github.com/certimate-go/certimate/pkg/core/notifier/providers/aliyun-sms"
 	pDingTalkBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/dingtalkbot"
 	pDiscordBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/discordbot"
 	pEmail "github.com/certimate-go/certimate/pkg/core/notifier/providers/email"
 	pLarkBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/larkbot"
+	pMatrix "github.com/certimate-go/certimate/pkg/core/notifier/providers/matrix"
 	pMattermost "github.com/certimate-go/certimate/pkg/core/notifier/providers/mattermost"
+	pMicrosoftTeams "github.com/certimate-go/certimate/pkg/core/notifier/providers/microsoftteams"
+	pNtfy "github.com/certimate-go/certimate/pkg/core/notifier/providers/ntfy"
 	pSlackBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/slackbot"
 	pTelegramBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/telegrambot"
+	pTencentCloudSMS "github.com/certimate-go/certimate/pkg/core/notifier/providers/tencentcloud-sms"
+	pTwilioSMS "github.com/certimate-go/certimate/pkg/core/notifier/providers/twilio-sms"
+	pTwilioVoice "github.com/certimate-go/certimate/pkg/core/notifier/providers/twilio-voice"
 	pWebhook "github.com/certimate-go/certimate/pkg/core/notifier/providers/webhook"
 	pWeComBot "github.com/certimate-go/certimate/pkg/core/notifier/providers/wecombot"
 	xhttp "github.com/certimate-go/certimate/pkg/utils/http"
 	xmaps "github.com/certimate-go/certimate/pkg/utils/maps"
+	xslices "github.com/certimate-go/certimate/pkg/utils/slices"
 )
 
 type notifierProviderOptions struct {
@@ -31,6 +41,23 @@ func createNotifierProvider(options *notifierProviderOptions) (core.Notifier, er
 	  NOTICE: If you add new constant, please keep ASCII order.
 	*/
 	switch options.Provider {
+	case domain.NotificationProviderTypeAliyunSMS:
+		{
+			access := domain.AccessConfigForAliyun{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pAliyunSMS.NewNotifierProvider(&pAliyunSMS.NotifierProviderConfig{
+				AccessKeyId:     access.AccessKeyId,
+				AccessKeySecret: access.AccessKeySecret,
+				SignName:        xmaps.GetString(options.ProviderServiceConfig, "signName"),
+				TemplateCode:    xmaps.GetString(options.ProviderServiceConfig, "templateCode"),
+				TemplateParams:  getStringKVMap(options.ProviderServiceConfig, "templateParams"),
+				PhoneNumbers:    xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "phoneNumbers"), ";"), func(s string) bool { return s != "" }),
+			})
+		}
+
 	case domain.NotificationProviderTypeDingTalkBot:
 		{
 			access := domain.AccessConfigForDingTalkBot{}
@@ -88,6 +115,20 @@ func createNotifierProvider(options *notifierProviderOptions) (core.Notifier, er
 			})
 		}
 
+	case domain.NotificationProviderTypeMatrix:
+		{
+			access := domain.AccessConfigForMatrix{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pMatrix.NewNotifierProvider(&pMatrix.NotifierProviderConfig{
+				HomeserverUrl: access.HomeserverUrl,
+				AccessToken:   access.AccessToken,
+				RoomId:        xmaps.GetOrDefaultString(options.ProviderServiceConfig, "roomId", access.DefaultRoomId),
+			})
+		}
+
 	case domain.NotificationProviderTypeMattermost:
 		{
 			access := domain.AccessConfigForMattermost{}
@@ -103,6 +144,36 @@ func createNotifierProvider(options *notifierProviderOptions) (core.Notifier, er
 			})
 		}
 
+	case domain.NotificationProviderTypeMicrosoftTeams:
+		{
+			access := domain.AccessConfigForMicrosoftTeams{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pMicrosoftTeams.NewNotifierProvider(&pMicrosoftTeams.NotifierProviderConfig{
+				WebhookUrl: access.WebhookUrl,
+			})
+		}
+
+	case domain.NotificationProviderTypeNtfy:
+		{
+			access := domain.AccessConfigForNtfy{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pNtfy.NewNotifierProvider(&pNtfy.NotifierProviderConfig{
+				ServerUrl:   access.ServerUrl,
+				AccessToken: access.AccessToken,
+				Username:    access.Username,
+				Password:    access.Password,
+				Topic:       xmaps.GetOrDefaultString(options.ProviderServiceConfig, "topic", access.DefaultTopic),
+				Priority:    xmaps.GetInt32(options.ProviderServiceConfig, "priority"),
+				Tags:        xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "tags"), ";"), func(s string) bool { return s != "" }),
+			})
+		}
+
 	case domain.NotificationProviderTypeSlackBot:
 		{
 			access := domain.AccessConfigForSlackBot{}
@@ -129,6 +200,62 @@ func createNotifierProvider(options *notifierProviderOptions) (core.Notifier, er
 			})
 		}
 
+	case domain.NotificationProviderTypeTencentCloudSMS:
+		{
+			access := domain.AccessConfigForTencentCloud{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pTencentCloudSMS.NewNotifierProvider(&pTencentCloudSMS.NotifierProviderConfig{
+				SecretId:       access.SecretId,
+				SecretKey:      access.SecretKey,
+				Region:         xmaps.GetString(options.ProviderServiceConfig, "region"),
+				SmsSdkAppId:    xmaps.GetString(options.ProviderServiceConfig, "smsSdkAppId"),
+				SignName:       xmaps.GetString(options.ProviderServiceConfig, "signName"),
+				TemplateId:     xmaps.GetString(options.ProviderServiceConfig, "templateId"),
+				TemplateParams: xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "templateParams"), ";"), func(s string) bool { return s != "" }),
+				PhoneNumbers:   xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "phoneNumbers"), ";"), func(s string) bool { return s != "" }),
+			})
+		}
+
+	case domain.NotificationProviderTypeTwilioSMS:
+		{
+			access := domain.AccessConfigForTwilio{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pTwilioSMS.NewNotifierProvider(&pTwilioSMS.NotifierProviderConfig{
+				AccountSid:          access.AccountSid,
+				AuthToken:           access.AuthToken,
+				FromNumber:          xmaps.GetString(options.ProviderServiceConfig, "fromNumber"),
+				MessagingServiceSid: xmaps.GetString(options.ProviderServiceConfig, "messagingServiceSid"),
+				ToNumbers:           xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "toNumbers"), ";"), func(s string) bool { return s != "" }),
+				ContentSid:          xmaps.GetString(options.ProviderServiceConfig, "contentSid"),
+				ContentVariables:    getStringKVMap(options.ProviderServiceConfig, "contentVariables"),
+				BodyTemplate:        xmaps.GetString(options.ProviderServiceConfig, "bodyTemplate"),
+			})
+		}
+
+	case domain.NotificationProviderTypeTwilioVoice:
+		{
+			access := domain.AccessConfigForTwilio{}
+			if err := xmaps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			return pTwilioVoice.NewNotifierProvider(&pTwilioVoice.NotifierProviderConfig{
+				AccountSid:     access.AccountSid,
+				AuthToken:      access.AuthToken,
+				FromNumber:     xmaps.GetString(options.ProviderServiceConfig, "fromNumber"),
+				ToNumbers:      xslices.Filter(strings.Split(xmaps.GetString(options.ProviderServiceConfig, "toNumbers"), ";"), func(s string) bool { return s != "" }),
+				SpeechTemplate: xmaps.GetString(options.ProviderServiceConfig, "speechTemplate"),
+				Language:       xmaps.GetString(options.ProviderServiceConfig, "language"),
+				Loop:           xmaps.GetInt32(options.ProviderServiceConfig, "loop"),
+			})
+		}
+
 	case domain.NotificationProviderTypeWebhook:
 		{
 			access := domain.AccessConfigForWebhook{}
@@ -180,3 +307,20 @@ func createNotifierProvider(options *notifierProviderOptions) (core.Notifier, er
 
 	return nil, fmt.Errorf("unsupported notifier provider '%s'", options.Provider)
 }
+
+// 获取字符串键值对映射。
+// 兼容 JSON 对象与 JSON 字符串两种形式的配置值。
+func getStringKVMap(dict map[string]any, key string) map[string]string {
+	raw := xmaps.GetKVMapAny(dict, key)
+	if len(raw) == 0 {
+		if str := xmaps.GetString(dict, key); str != "" {
+			_ = json.Unmarshal([]byte(str), &raw)
+		}
+	}
+
+	result := make(map[string]string, len(raw))
+	for k, v := range raw {
+		result[k] = fmt.Sprintf("%v", v)
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type rateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
+// 各通知提供商的发送频率限制，以单个机器人或 Webhook 为单位。
+var providerRateLimits = map[domain.NotificationProviderType]rateLimit{
+	domain.NotificationProviderTypeDingTalkBot: {Limit: 20, Window: time.Minute},  // REF: https://open.dingtalk.com/document/orgapp/custom-bot-send-message-type
+	domain.NotificationProviderTypeDiscordBot:  {Limit: 30, Window: time.Minute},  // REF: https://discord.com/developers/docs/topics/rate-limits
+	domain.NotificationProviderTypeLarkBot:     {Limit: 100, Window: time.Minute}, // REF: https://open.feishu.cn/document/client-docs/bot-v3/add-custom-bot
+	domain.NotificationProviderTypeSlackBot:    {Limit: 60, Window: time.Minute},  // REF: https://api.slack.com/apis/rate-limits
+	domain.NotificationProviderTypeTelegramBot: {Limit: 20, Window: time.Minute},  // REF: https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+	domain.NotificationProviderTypeWeComBot:    {Limit: 20, Window: time.Minute},  // REF: https://developer.work.weixin.qq.com/document/path/91770
+}
+
+// 滑动窗口限流器。
+// 按调用顺序预约发送时间，超出限制的调用将排队等待，从而保证先到先发。
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mutex sync.Mutex
+	slots []time.Time
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:  limit,
+		window: window,
+		slots:  make([]time.Time, 0, limit),
+	}
+}
+
+// 预约下一个可用的发送时间。
+func (l *rateLimiter) reserve(now time.Time) time.Time {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	// 清理已滑出窗口的预约
+	expired := 0
+	for expired < len(l.slots) && !l.slots[expired].Add(l.window).After(now) {
+		expired++
+	}
+	l.slots = l.slots[expired:]
+
+	at := now
+	if len(l.slots) >= l.limit {
+		at = l.slots[len(l.slots)-l.limit].Add(l.window)
+	}
+	l.slots = append(l.slots, at)
+	return at
+}
+
+// 等待直至可以发送。
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	delay := time.Until(l.reserve(time.Now()))
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var (
+	rateLimiters      = make(map[string]*rateLimiter)
+	rateLimitersMutex sync.Mutex
+)
+
+func getRateLimiter(provider domain.NotificationProviderType, key string) *rateLimiter {
+	limit, ok := providerRateLimits[provider]
+	if !ok {
+		return nil
+	}
+
+	rateLimitersMutex.Lock()
+	defer rateLimitersMutex.Unlock()
+
+	limiterKey := string(provider) + "#" + key
+	if limiter, ok := rateLimiters[limiterKey]; ok {
+		return limiter
+	}
+
+	limiter := newRateLimiter(limit.Limit, limit.Window)
+	rateLimiters[limiterKey] = limiter
+	return limiter
+}
+
+// 带发送频率限制的通知器。
+type rateLimitedNotifier struct {
+	notifier core.Notifier
+	limiter  *rateLimiter
+}
+
+var _ core.MessageNotifier = (*rateLimitedNotifier)(nil)
+
+func withRateLimit(notifier core.Notifier, provider domain.NotificationProviderType, key string) core.Notifier {
+	limiter := getRateLimiter(provider, key)
+	if limiter == nil {
+		return notifier
+	}
+
+	return &rateLimitedNotifier{
+		notifier: notifier,
+		limiter:  limiter,
+	}
+}
+
+func (n *rateLimitedNotifier) SetLogger(logger *slog.Logger) {
+	n.notifier.SetLogger(logger)
+}
+
+func (n *rateLimitedNotifier) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	if err := n.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return n.notifier.Notify(ctx, subject, message)
+}
+
+func (n *rateLimitedNotifier) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if err := n.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return core.NotifyWithMessage(ctx, n.notifier, message)
+}
@@ -0,0 +1,27 @@
+package notify
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	limiter := newRateLimiter(2, time.Minute)
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	if at := limiter.reserve(now); !at.Equal(now) {
+		t.Errorf("expected the 1st reservation at %v, got %v", now, at)
+	}
+	if at := limiter.reserve(now.Add(time.Second)); !at.Equal(now.Add(time.Second)) {
+		t.Errorf("expected the 2nd reservation at %v, got %v", now.Add(time.Second), at)
+	}
+	if at := limiter.reserve(now.Add(2 * time.Second)); !at.Equal(now.Add(time.Minute)) {
+		t.Errorf("expected the 3rd reservation to be queued at %v, got %v", now.Add(time.Minute), at)
+	}
+	if at := limiter.reserve(now.Add(3 * time.Second)); !at.Equal(now.Add(time.Minute + time.Second)) {
+		t.Errorf("expected the 4th reservation to be queued at %v, got %v", now.Add(time.Minute+time.Second), at)
+	}
+	if at := limiter.reserve(now.Add(2 * time.Minute)); !at.Equal(now.Add(2 * time.Minute)) {
+		t.Errorf("expected the 5th reservation at %v, got %v", now.Add(2*time.Minute), at)
+	}
+}
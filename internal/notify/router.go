@@ -0,0 +1,310 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/repository"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+const envLegacyChannelsFallback = "CERTIMATE_NOTIFY_LEGACY_CHANNELS_FALLBACK"
+
+// 是否在没有通知规则匹配时，将证书过期提醒回退发送到已废弃的全局通知渠道（即 `notifyChannels` 设置）。
+// 仅在显式设置环境变量 `CERTIMATE_NOTIFY_LEGACY_CHANNELS_FALLBACK` 为真值时启用，供尚未配置通知规则的用户过渡使用。
+var isLegacyChannelsFallbackEnabled = func() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(envLegacyChannelsFallback))
+	return enabled
+}
+
+// 通知事件。
+// 由工作流执行、证书过期检查等业务产生，经通知路由规则匹配后发送到对应渠道。
+type Event struct {
+	Type          domain.NotificationEventType
+	WorkflowId    string
+	WorkflowRunId string
+	Tags          []string
+	Domains       []string
+	Message       *core.NotifyMessage
+}
+
+type notificationRuleRepository interface {
+	ListEnabled(ctx context.Context) ([]*domain.NotificationRule, error)
+	GetById(ctx context.Context, id string) (*domain.NotificationRule, error)
+}
+
+type notificationAlertRepository interface {
+	ListByStatus(ctx context.Context, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error)
+	ListByWorkflowIdAndStatus(ctx context.Context, workflowId string, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error)
+	GetById(ctx context.Context, id string) (*domain.NotificationAlert, error)
+	Save(ctx context.Context, alert *domain.NotificationAlert) (*domain.NotificationAlert, error)
+	SaveEscalationIfFiring(ctx context.Context, alert *domain.NotificationAlert) (bool, error)
+}
+
+type Router struct {
+	ruleRepo  notificationRuleRepository
+	alertRepo notificationAlertRepository
+}
+
+func NewRouter(ruleRepo notificationRuleRepository, alertRepo notificationAlertRepository) *Router {
+	return &Router{
+		ruleRepo:  ruleRepo,
+		alertRepo: alertRepo,
+	}
+}
+
+// 使用默认的数据仓储路由一个通知事件。
+// 返回值表示是否有规则匹配或已成功发送到旧版全局通知渠道。
+func Dispatch(ctx context.Context, event *Event) (bool, error) {
+	router := NewRouter(repository.NewNotificationRuleRepository(), repository.NewNotificationAlertRepository())
+	return router.Dispatch(ctx, event)
+}
+
+// 路由一个通知事件。
+// 每条匹配的规则都会产生一条告警记录，并立即发送到无需等待升级的渠道。
+// 返回值表示是否有规则匹配或已成功发送到旧版全局通知渠道。
+func (r *Router) Dispatch(ctx context.Context, event *Event) (bool, error) {
+	if event == nil || event.Message == nil {
+		return false, errors.New("notification event is nil")
+	}
+
+	// 工作流执行成功时，自动解除该工作流此前未恢复的失败告警
+	if event.Type == domain.NotificationEventTypeWorkflowSucceeded && event.WorkflowId != "" {
+		if err := r.resolveWorkflowAlerts(ctx, event.WorkflowId); err != nil {
+			app.GetLogger().Error("failed to resolve notification alerts", "workflowId", event.WorkflowId, "err", err)
+		}
+	}
+
+	rules, err := r.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get notification rules: %w", err)
+	}
+
+	// 附件可能较大，不随告警记录持久化
+	payload := *event.Message
+	payload.Attachments = nil
+
+	var errs []error
+	matched := false
+	for _, rule := range rules {
+		if !matchRule(rule, event) {
+			continue
+		}
+
+		alert := &domain.NotificationAlert{
+			RuleId:        rule.Id,
+			EventType:     event.Type,
+			WorkflowId:    event.WorkflowId,
+			WorkflowRunId: event.WorkflowRunId,
+			Payload:       &payload,
+			Status:        domain.NotificationAlertStatusTypeFiring,
+		}
+		if _, err := r.alertRepo.Save(ctx, alert); err != nil {
+			errs = append(errs, fmt.Errorf("failed to save notification alert of rule #%s: %w", rule.Id, err))
+			continue
+		}
+
+		matched = true
+		if err := r.escalate(ctx, rule, alert, time.Now()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// 没有规则匹配时，如已显式启用，证书过期提醒回退到旧版全局通知渠道
+	if !matched && event.Type == domain.NotificationEventTypeCertificateExpiring && isLegacyChannelsFallbackEnabled() {
+		app.GetLogger().Warn("no notification rule matched, falling back to the deprecated notify channels; please configure notification rules instead", "event", event.Type)
+
+		delivered, err := sendToLegacyChannels(ctx, event.Message)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return delivered, errors.Join(errs...)
+	}
+
+	return matched, errors.Join(errs...)
+}
+
+// 检查所有未确认的告警，并发送到已到达升级时间的渠道。
+func (r *Router) Escalate(ctx context.Context) error {
+	alerts, err := r.alertRepo.ListByStatus(ctx, domain.NotificationAlertStatusTypeFiring)
+	if err != nil {
+		return fmt.Errorf("failed to get firing notification alerts: %w", err)
+	}
+
+	var errs []error
+	now := time.Now()
+	for _, alert := range alerts {
+		rule, err := r.ruleRepo.GetById(ctx, alert.RuleId)
+		if err != nil {
+			if domain.IsRecordNotFoundError(err) {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("failed to get notification rule #%s: %w", alert.RuleId, err))
+			continue
+		} else if !rule.Enabled {
+			continue
+		}
+
+		if err := r.escalate(ctx, rule, alert, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// 确认一条告警，确认后不再继续升级。
+func (r *Router) Acknowledge(ctx context.Context, alertId string, operator string) error {
+	alert, err := r.alertRepo.GetById(ctx, alertId)
+	if err != nil {
+		return err
+	} else if alert.Status != domain.NotificationAlertStatusTypeFiring {
+		return fmt.Errorf("could not acknowledge notification alert #%s with status '%s'", alertId, alert.Status)
+	}
+
+	alert.Status = domain.NotificationAlertStatusTypeAcknowledged
+	alert.AcknowledgedBy = operator
+	alert.AcknowledgedAt = time.Now()
+	if _, err := r.alertRepo.Save(ctx, alert); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Router) escalate(ctx context.Context, rule *domain.NotificationRule, alert *domain.NotificationAlert, now time.Time) error {
+	var errs []error
+	changed := false
+	for i := alert.NotifiedChannels; i < len(rule.Channels); i++ {
+		channel := rule.Channels[i]
+
+		// 渠道按声明顺序逐级升级，前一级未到期时后续渠道也不发送
+		escalateAt := alert.CreatedAt.Add(time.Duration(channel.EscalateAfter) * time.Minute)
+		if now.Before(escalateAt) {
+			break
+		}
+
+		// 逐级发送期间告警可能已被确认或解除，此时不再继续升级
+		if changed {
+			if latest, err := r.alertRepo.GetById(ctx, alert.Id); err != nil {
+				errs = append(errs, err)
+				break
+			} else if latest.Status != domain.NotificationAlertStatusTypeFiring {
+				break
+			}
+		}
+
+		// 发送失败时同样推进升级进度，避免重复发送到已成功的其他接收方
+		if err := sendToRuleChannel(ctx, &channel, alert); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send notification alert #%s to channel #%d of rule #%s: %w", alert.Id, i, rule.Id, err))
+		}
+
+		alert.NotifiedChannels = i + 1
+		alert.LastNotifiedAt = now
+		changed = true
+	}
+
+	// 发送可能较为耗时，期间告警可能已被确认或解除，因此仅更新升级进度，而不覆盖告警状态
+	if changed {
+		if _, err := r.alertRepo.SaveEscalationIfFiring(ctx, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Router) resolveWorkflowAlerts(ctx context.Context, workflowId string) error {
+	var errs []error
+	for _, status := range []domain.NotificationAlertStatusType{domain.NotificationAlertStatusTypeFiring, domain.NotificationAlertStatusTypeAcknowledged} {
+		alerts, err := r.alertRepo.ListByWorkflowIdAndStatus(ctx, workflowId, status)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, alert := range alerts {
+			if alert.EventType != domain.NotificationEventTypeWorkflowFailed {
+				continue
+			}
+
+			alert.Status = domain.NotificationAlertStatusTypeResolved
+			alert.ResolvedAt = time.Now()
+			if _, err := r.alertRepo.Save(ctx, alert); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sendToRuleChannel(ctx context.Context, channel *domain.NotificationRuleChannel, alert *domain.NotificationAlert) error {
+	notifier, err := createNotifierProviderWithAccess(channel.Provider, channel.ProviderAccessId, channel.ProviderConfig, app.GetLogger())
+	if err != nil {
+		return err
+	}
+
+	return defaultOutbox.Send(ctx, notifier, &deliveryTarget{
+		Provider:         channel.Provider,
+		ProviderAccessId: channel.ProviderAccessId,
+		ProviderConfig:   channel.ProviderConfig,
+		WorkflowId:       alert.WorkflowId,
+		WorkflowRunId:    alert.WorkflowRunId,
+		AlertId:          alert.Id,
+	}, alert.Payload)
+}
+
+func matchRule(rule *domain.NotificationRule, event *Event) bool {
+	conditions := rule.Conditions
+	if conditions == nil {
+		return true
+	}
+
+	if len(conditions.EventTypes) > 0 && !slices.Contains(conditions.EventTypes, event.Type) {
+		return false
+	}
+
+	if len(conditions.Severities) > 0 && !slices.Contains(conditions.Severities, event.Message.GetSeverity()) {
+		return false
+	}
+
+	if len(conditions.WorkflowIds) > 0 && !slices.Contains(conditions.WorkflowIds, event.WorkflowId) {
+		return false
+	}
+
+	if len(conditions.Tags) > 0 && !slices.ContainsFunc(conditions.Tags, func(tag string) bool {
+		return slices.ContainsFunc(event.Tags, func(t string) bool { return strings.EqualFold(t, tag) })
+	}) {
+		return false
+	}
+
+	if len(conditions.DomainPatterns) > 0 && !slices.ContainsFunc(conditions.DomainPatterns, func(pattern string) bool {
+		return slices.ContainsFunc(event.Domains, func(d string) bool { return matchDomainPattern(pattern, d) })
+	}) {
+		return false
+	}
+
+	return true
+}
+
+func matchDomainPattern(pattern string, name string) bool {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	name = strings.ToLower(strings.TrimSpace(name))
+	if pattern == "" || name == "" {
+		return false
+	}
+
+	matched, _ := path.Match(pattern, name)
+	return matched
+}
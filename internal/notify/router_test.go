@@ -0,0 +1,184 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+func Test_matchRule(t *testing.T) {
+	event := &Event{
+		Type:       domain.NotificationEventTypeWorkflowFailed,
+		WorkflowId: "wf1",
+		Tags:       []string{"Production", "web"},
+		Domains:    []string{"www.example.com", "api.example.org"},
+		Message:    &core.NotifyMessage{Severity: core.NotifySeverityError},
+	}
+
+	tests := []struct {
+		name       string
+		conditions *domain.NotificationRuleConditions
+		want       bool
+	}{
+		{"no conditions", nil, true},
+		{"empty conditions", &domain.NotificationRuleConditions{}, true},
+		{"event type matched", &domain.NotificationRuleConditions{EventTypes: []domain.NotificationEventType{domain.NotificationEventTypeWorkflowFailed}}, true},
+		{"event type mismatched", &domain.NotificationRuleConditions{EventTypes: []domain.NotificationEventType{domain.NotificationEventTypeCertificateExpiring}}, false},
+		{"severity matched", &domain.NotificationRuleConditions{Severities: []core.NotifySeverity{core.NotifySeverityWarning, core.NotifySeverityError}}, true},
+		{"severity mismatched", &domain.NotificationRuleConditions{Severities: []core.NotifySeverity{core.NotifySeverityInfo}}, false},
+		{"workflow matched", &domain.NotificationRuleConditions{WorkflowIds: []string{"wf1", "wf2"}}, true},
+		{"workflow mismatched", &domain.NotificationRuleConditions{WorkflowIds: []string{"wf2"}}, false},
+		{"tag matched case-insensitively", &domain.NotificationRuleConditions{Tags: []string{"production"}}, true},
+		{"tag mismatched", &domain.NotificationRuleConditions{Tags: []string{"staging"}}, false},
+		{"domain pattern matched", &domain.NotificationRuleConditions{DomainPatterns: []string{"*.example.org"}}, true},
+		{"domain pattern mismatched", &domain.NotificationRuleConditions{DomainPatterns: []string{"*.example.net"}}, false},
+		{"all conditions matched", &domain.NotificationRuleConditions{EventTypes: []domain.NotificationEventType{domain.NotificationEventTypeWorkflowFailed}, Tags: []string{"web"}, DomainPatterns: []string{"www.example.com"}}, true},
+		{"partial conditions matched", &domain.NotificationRuleConditions{EventTypes: []domain.NotificationEventType{domain.NotificationEventTypeWorkflowFailed}, Tags: []string{"staging"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &domain.NotificationRule{Conditions: tt.conditions}
+			if got := matchRule(rule, event); got != tt.want {
+				t.Errorf("matchRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type mockNotificationAlertRepository struct {
+	alerts map[string]*domain.NotificationAlert
+}
+
+func (r *mockNotificationAlertRepository) ListByStatus(ctx context.Context, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error) {
+	return nil, nil
+}
+
+func (r *mockNotificationAlertRepository) ListByWorkflowIdAndStatus(ctx context.Context, workflowId string, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error) {
+	return nil, nil
+}
+
+func (r *mockNotificationAlertRepository) GetById(ctx context.Context, id string) (*domain.NotificationAlert, error) {
+	if alert, ok := r.alerts[id]; ok {
+		return alert, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockNotificationAlertRepository) Save(ctx context.Context, alert *domain.NotificationAlert) (*domain.NotificationAlert, error) {
+	if alert.Id == "" {
+		alert.Id = fmt.Sprintf("a%d", len(r.alerts)+1)
+		alert.CreatedAt = time.Now()
+	}
+	r.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (r *mockNotificationAlertRepository) SaveEscalationIfFiring(ctx context.Context, alert *domain.NotificationAlert) (bool, error) {
+	stored, ok := r.alerts[alert.Id]
+	if !ok {
+		return false, domain.ErrRecordNotFound
+	} else if stored.Status != domain.NotificationAlertStatusTypeFiring {
+		return false, nil
+	}
+
+	stored.NotifiedChannels = alert.NotifiedChannels
+	stored.LastNotifiedAt = alert.LastNotifiedAt
+	return true, nil
+}
+
+type mockNotificationRuleRepository struct {
+	rules []*domain.NotificationRule
+}
+
+func (r *mockNotificationRuleRepository) ListEnabled(ctx context.Context) ([]*domain.NotificationRule, error) {
+	return r.rules, nil
+}
+
+func (r *mockNotificationRuleRepository) GetById(ctx context.Context, id string) (*domain.NotificationRule, error) {
+	for _, rule := range r.rules {
+		if rule.Id == id {
+			return rule, nil
+		}
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func TestRouter_Dispatch(t *testing.T) {
+	ruleRepo := &mockNotificationRuleRepository{
+		rules: []*domain.NotificationRule{
+			{
+				Meta:       domain.Meta{Id: "r1"},
+				Enabled:    true,
+				Conditions: &domain.NotificationRuleConditions{EventTypes: []domain.NotificationEventType{domain.NotificationEventTypeWorkflowFailed}},
+				Channels:   []domain.NotificationRuleChannel{{Provider: "webhook", EscalateAfter: 60}},
+			},
+		},
+	}
+	alertRepo := &mockNotificationAlertRepository{alerts: map[string]*domain.NotificationAlert{}}
+	router := NewRouter(ruleRepo, alertRepo)
+
+	delivered, err := router.Dispatch(context.Background(), &Event{
+		Type:    domain.NotificationEventTypeWorkflowSucceeded,
+		Message: &core.NotifyMessage{Subject: "test"},
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	} else if delivered {
+		t.Errorf("expected not delivered when no rule matched")
+	}
+
+	delivered, err = router.Dispatch(context.Background(), &Event{
+		Type:    domain.NotificationEventTypeWorkflowFailed,
+		Message: &core.NotifyMessage{Subject: "test"},
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	} else if !delivered {
+		t.Errorf("expected delivered when a rule matched")
+	}
+	if len(alertRepo.alerts) != 1 {
+		t.Errorf("expected 1 alert, got %d", len(alertRepo.alerts))
+	}
+
+	// 未显式启用时，不回退到旧版全局通知渠道
+	t.Setenv(envLegacyChannelsFallback, "")
+	delivered, err = router.Dispatch(context.Background(), &Event{
+		Type:    domain.NotificationEventTypeCertificateExpiring,
+		Message: &core.NotifyMessage{Subject: "test"},
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	} else if delivered {
+		t.Errorf("expected not delivered when no rule matched and legacy fallback is disabled")
+	}
+}
+
+func TestRouter_Acknowledge(t *testing.T) {
+	alertRepo := &mockNotificationAlertRepository{
+		alerts: map[string]*domain.NotificationAlert{
+			"a1": {Meta: domain.Meta{Id: "a1"}, Status: domain.NotificationAlertStatusTypeFiring},
+			"a2": {Meta: domain.Meta{Id: "a2"}, Status: domain.NotificationAlertStatusTypeResolved},
+		},
+	}
+	router := NewRouter(nil, alertRepo)
+
+	if err := router.Acknowledge(context.Background(), "a1", "admin@example.com"); err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if alert := alertRepo.alerts["a1"]; alert.Status != domain.NotificationAlertStatusTypeAcknowledged || alert.AcknowledgedBy != "admin@example.com" || alert.AcknowledgedAt.IsZero() {
+		t.Errorf("unexpected alert after acknowledged: %+v", alert)
+	}
+
+	if err := router.Acknowledge(context.Background(), "a2", "admin@example.com"); err == nil {
+		t.Errorf("expected error when acknowledging a resolved alert")
+	}
+
+	if err := router.Acknowledge(context.Background(), "a3", "admin@example.com"); !domain.IsRecordNotFoundError(err) {
+		t.Errorf("expected record not found error, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
 )
@@ -19,18 +20,85 @@ type settingsRepository interface {
 	GetByName(ctx context.Context, name string) (*domain.Settings, error)
 }
 
-// Deprecated: v0.4.x 将废弃
+type notificationDeliveryListRepository interface {
+	List(ctx context.Context, conditions map[string]any, page, perPage int) ([]*domain.NotificationDelivery, int, error)
+}
+
 type NotifyService struct {
 	settingsRepo settingsRepository
+	deliveryRepo notificationDeliveryListRepository
+	router       *Router
+	outbox       *Outbox
 }
 
-// Deprecated: v0.4.x 将废弃
-func NewNotifyService(settingsRepo settingsRepository) *NotifyService {
+func NewNotifyService(settingsRepo settingsRepository, ruleRepo notificationRuleRepository, alertRepo notificationAlertRepository, deliveryRepo notificationDeliveryListRepository) *NotifyService {
 	return &NotifyService{
 		settingsRepo: settingsRepo,
+		deliveryRepo: deliveryRepo,
+		router:       NewRouter(ruleRepo, alertRepo),
+		outbox:       defaultOutbox,
 	}
 }
 
+func (n *NotifyService) InitSchedule(ctx context.Context) error {
+	// 每分钟检查未确认的告警并逐级升级
+	app.GetScheduler().MustAdd("notificationAlertEscalate", "* * * * *", func() {
+		if err := n.router.Escalate(context.Background()); err != nil {
+			app.GetLogger().Error("failed to escalate notification alerts", "err", err)
+		}
+	})
+
+	// 每分钟重试发送失败的通知
+	app.GetScheduler().MustAdd("notificationDeliveryRetry", "* * * * *", func() {
+		if err := n.outbox.Retry(context.Background()); err != nil {
+			app.GetLogger().Error("failed to retry notification deliveries", "err", err)
+		}
+	})
+
+	return nil
+}
+
+func (n *NotifyService) AcknowledgeAlert(ctx context.Context, req *dtos.NotifyAcknowledgeAlertReq) error {
+	return n.router.Acknowledge(ctx, req.AlertId, req.Operator)
+}
+
+func (n *NotifyService) ListDeliveries(ctx context.Context, req *dtos.NotifyListDeliveriesReq) (*dtos.NotifyListDeliveriesResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PerPage <= 0 {
+		req.PerPage = 50
+	} else if req.PerPage > 500 {
+		req.PerPage = 500
+	}
+
+	conditions := make(map[string]any)
+	if req.WorkflowId != "" {
+		conditions["workflowId"] = req.WorkflowId
+	}
+	if req.WorkflowRunId != "" {
+		conditions["workflowRunId"] = req.WorkflowRunId
+	}
+	if req.AlertId != "" {
+		conditions["alertId"] = req.AlertId
+	}
+	if req.Status != "" {
+		conditions["status"] = string(req.Status)
+	}
+
+	deliveries, total, err := n.deliveryRepo.List(ctx, conditions, req.Page, req.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.NotifyListDeliveriesResp{
+		Page:       req.Page,
+		PerPage:    req.PerPage,
+		TotalItems: total,
+		Items:      deliveries,
+	}, nil
+}
+
 // Deprecated: v0.4.x 将废弃
 func (n *NotifyService) Test(ctx context.Context, req *dtos.NotifyTestPushReq) error {
 	settings, err := n.settingsRepo.GetByName(ctx, "notifyChannels")
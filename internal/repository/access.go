@@ -10,6 +10,7 @@ import (
 
 	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/encryption"
 )
 
 type AccessRepository struct{}
@@ -34,11 +35,48 @@ func (r *AccessRepository) GetById(ctx context.Context, id string) (*domain.Acce
 	return r.castRecordToModel(record)
 }
 
+func (r *AccessRepository) Save(ctx context.Context, access *domain.Access) (*domain.Access, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameAccess)
+	if err != nil {
+		return access, err
+	}
+
+	var record *core.Record
+	if access.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = app.GetApp().FindRecordById(collection, access.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return access, domain.ErrRecordNotFound
+			}
+			return access, err
+		}
+	}
+
+	record.Set("name", access.Name)
+	record.Set("provider", access.Provider)
+	record.Set("config", access.Config)
+	record.Set("reserve", access.Reserve)
+	if err := app.GetApp().Save(record); err != nil {
+		return access, err
+	}
+
+	access.Id = record.Id
+	access.CreatedAt = record.GetDateTime("created").Time()
+	access.UpdatedAt = record.GetDateTime("updated").Time()
+	return access, nil
+}
+
 func (r *AccessRepository) castRecordToModel(record *core.Record) (*domain.Access, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record is nil")
 	}
 
+	if err := encryption.DecryptRecord(record); err != nil {
+		return nil, err
+	}
+
 	config := make(map[string]any)
 	if err := record.UnmarshalJSONField("config", &config); err != nil {
 		return nil, err
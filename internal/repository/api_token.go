@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+type APITokenRepository struct{}
+
+func NewAPITokenRepository() *APITokenRepository {
+	return &APITokenRepository{}
+}
+
+func (r *APITokenRepository) List(ctx context.Context) ([]*domain.APIToken, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameAPIToken,
+		"",
+		"-created",
+		0, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*domain.APIToken, 0)
+	for _, record := range records {
+		token, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (r *APITokenRepository) GetById(ctx context.Context, id string) (*domain.APIToken, error) {
+	record, err := app.GetApp().FindRecordById(domain.CollectionNameAPIToken, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *APITokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.APIToken, error) {
+	record, err := app.GetApp().FindFirstRecordByFilter(
+		domain.CollectionNameAPIToken,
+		"tokenHash={:tokenHash}",
+		dbx.Params{"tokenHash": tokenHash},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *APITokenRepository) Save(ctx context.Context, token *domain.APIToken) (*domain.APIToken, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameAPIToken)
+	if err != nil {
+		return token, err
+	}
+
+	var record *core.Record
+	if token.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = app.GetApp().FindRecordById(collection, token.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return token, domain.ErrRecordNotFound
+			}
+			return token, err
+		}
+	}
+
+	record.Set("name", token.Name)
+	record.Set("tokenHash", token.TokenHash)
+	record.Set("tokenPrefix", token.TokenPrefix)
+	record.Set("scopes", token.Scopes)
+	record.Set("workflowIds", token.WorkflowIds)
+	record.Set("certificateIds", token.CertificateIds)
+	record.Set("accessIds", token.AccessIds)
+	record.Set("ipAllowlist", token.IPAllowlist)
+	record.Set("expireAt", token.ExpireAt)
+	record.Set("lastUsedAt", token.LastUsedAt)
+	record.Set("lastUsedIp", token.LastUsedIp)
+	if err := app.GetApp().Save(record); err != nil {
+		return token, err
+	}
+
+	token.Id = record.Id
+	token.CreatedAt = record.GetDateTime("created").Time()
+	token.UpdatedAt = record.GetDateTime("updated").Time()
+	return token, nil
+}
+
+func (r *APITokenRepository) DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error) {
+	records, err := app.GetApp().FindAllRecords(domain.CollectionNameAPIToken, exprs...)
+	if err != nil {
+		return 0, nil
+	}
+
+	var ret int
+	var errs []error
+	for _, record := range records {
+		if err := app.GetApp().Delete(record); err != nil {
+			errs = append(errs, err)
+		} else {
+			ret++
+		}
+	}
+
+	if len(errs) > 0 {
+		return ret, errors.Join(errs...)
+	}
+
+	return ret, nil
+}
+
+func (r *APITokenRepository) castRecordToModel(record *core.Record) (*domain.APIToken, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	token := &domain.APIToken{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		Name:        record.GetString("name"),
+		TokenHash:   record.GetString("tokenHash"),
+		TokenPrefix: record.GetString("tokenPrefix"),
+		ExpireAt:    record.GetDateTime("expireAt").Time(),
+		LastUsedAt:  record.GetDateTime("lastUsedAt").Time(),
+		LastUsedIp:  record.GetString("lastUsedIp"),
+	}
+	if err := record.UnmarshalJSONField("scopes", &token.Scopes); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("workflowIds", &token.WorkflowIds); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("certificateIds", &token.CertificateIds); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("accessIds", &token.AccessIds); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("ipAllowlist", &token.IPAllowlist); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
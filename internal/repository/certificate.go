@@ -8,6 +8,7 @@ import (
 
 	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/encryption"
 	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/core"
 )
@@ -18,11 +19,11 @@ func NewCertificateRepository() *CertificateRepository {
 	return &CertificateRepository{}
 }
 
-func (r *CertificateRepository) ListExpireSoon(ctx context.Context) ([]*domain.Certificate, error) {
+func (r *CertificateRepository) ListExpireSoon(ctx context.Context, withinDays int32) ([]*domain.Certificate, error) {
 	records, err := app.GetApp().FindAllRecords(
 		domain.CollectionNameCertificate,
 		dbx.NewExp("expireAt>DATETIME('now')"),
-		dbx.NewExp("expireAt<DATETIME('now', '+20 days')"),
+		dbx.NewExp(fmt.Sprintf("expireAt<DATETIME('now', '+%d days')", withinDays)),
 		dbx.NewExp("deleted=null"),
 	)
 	if err != nil {
@@ -134,6 +135,7 @@ func (r *CertificateRepository) Save(ctx context.Context, certificate *domain.Ce
 	record.Set("workflowRunId", certificate.WorkflowRunId)
 	record.Set("workflowNodeId", certificate.WorkflowNodeId)
 	record.Set("workflowOutputId", certificate.WorkflowOutputId)
+	record.Set("expiryNotifiedThreshold", certificate.ExpiryNotifiedThreshold)
 	if err := app.GetApp().Save(record); err != nil {
 		return certificate, err
 	}
@@ -172,30 +174,35 @@ func (r *CertificateRepository) castRecordToModel(record *core.Record) (*domain.
 		return nil, fmt.Errorf("record is nil")
 	}
 
+	if err := encryption.DecryptRecord(record); err != nil {
+		return nil, err
+	}
+
 	certificate := &domain.Certificate{
 		Meta: domain.Meta{
 			Id:        record.Id,
 			CreatedAt: record.GetDateTime("created").Time(),
 			UpdatedAt: record.GetDateTime("updated").Time(),
 		},
-		Source:            domain.CertificateSourceType(record.GetString("source")),
-		SubjectAltNames:   record.GetString("subjectAltNames"),
-		SerialNumber:      record.GetString("serialNumber"),
-		Certificate:       record.GetString("certificate"),
-		PrivateKey:        record.GetString("privateKey"),
-		IssuerOrg:         record.GetString("issuerOrg"),
-		IssuerCertificate: record.GetString("issuerCertificate"),
-		KeyAlgorithm:      domain.CertificateKeyAlgorithmType(record.GetString("keyAlgorithm")),
-		EffectAt:          record.GetDateTime("effectAt").Time(),
-		ExpireAt:          record.GetDateTime("expireAt").Time(),
-		ACMEAccountUrl:    record.GetString("acmeAccountUrl"),
-		ACMECertUrl:       record.GetString("acmeCertUrl"),
-		ACMECertStableUrl: record.GetString("acmeCertStableUrl"),
-		ACMERenewed:       record.GetBool("acmeRenewed"),
-		WorkflowId:        record.GetString("workflowId"),
-		WorkflowRunId:     record.GetString("workflowRunId"),
-		WorkflowNodeId:    record.GetString("workflowNodeId"),
-		WorkflowOutputId:  record.GetString("workflowOutputId"),
+		Source:                  domain.CertificateSourceType(record.GetString("source")),
+		SubjectAltNames:         record.GetString("subjectAltNames"),
+		SerialNumber:            record.GetString("serialNumber"),
+		Certificate:             record.GetString("certificate"),
+		PrivateKey:              record.GetString("privateKey"),
+		IssuerOrg:               record.GetString("issuerOrg"),
+		IssuerCertificate:       record.GetString("issuerCertificate"),
+		KeyAlgorithm:            domain.CertificateKeyAlgorithmType(record.GetString("keyAlgorithm")),
+		EffectAt:                record.GetDateTime("effectAt").Time(),
+		ExpireAt:                record.GetDateTime("expireAt").Time(),
+		ACMEAccountUrl:          record.GetString("acmeAccountUrl"),
+		ACMECertUrl:             record.GetString("acmeCertUrl"),
+		ACMECertStableUrl:       record.GetString("acmeCertStableUrl"),
+		ACMERenewed:             record.GetBool("acmeRenewed"),
+		WorkflowId:              record.GetString("workflowId"),
+		WorkflowRunId:           record.GetString("workflowRunId"),
+		WorkflowNodeId:          record.GetString("workflowNodeId"),
+		WorkflowOutputId:        record.GetString("workflowOutputId"),
+		ExpiryNotifiedThreshold: int32(record.GetInt("expiryNotifiedThreshold")),
 	}
 	return certificate, nil
 }
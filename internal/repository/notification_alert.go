@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+type NotificationAlertRepository struct{}
+
+func NewNotificationAlertRepository() *NotificationAlertRepository {
+	return &NotificationAlertRepository{}
+}
+
+func (r *NotificationAlertRepository) ListByStatus(ctx context.Context, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationAlert,
+		"status={:status}",
+		"created",
+		0, 0,
+		dbx.Params{"status": string(status)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*domain.NotificationAlert, 0)
+	for _, record := range records {
+		alert, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func (r *NotificationAlertRepository) ListByWorkflowIdAndStatus(ctx context.Context, workflowId string, status domain.NotificationAlertStatusType) ([]*domain.NotificationAlert, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationAlert,
+		"workflowId={:workflowId} && status={:status}",
+		"created",
+		0, 0,
+		dbx.Params{"workflowId": workflowId, "status": string(status)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*domain.NotificationAlert, 0)
+	for _, record := range records {
+		alert, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func (r *NotificationAlertRepository) GetById(ctx context.Context, id string) (*domain.NotificationAlert, error) {
+	record, err := app.GetApp().FindRecordById(domain.CollectionNameNotificationAlert, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *NotificationAlertRepository) Save(ctx context.Context, alert *domain.NotificationAlert) (*domain.NotificationAlert, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameNotificationAlert)
+	if err != nil {
+		return alert, err
+	}
+
+	var record *core.Record
+	if alert.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = app.GetApp().FindRecordById(collection, alert.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return alert, domain.ErrRecordNotFound
+			}
+			return alert, err
+		}
+	}
+
+	record.Set("ruleId", alert.RuleId)
+	record.Set("eventType", string(alert.EventType))
+	if alert.Payload != nil {
+		record.Set("severity", string(alert.Payload.GetSeverity()))
+	}
+	record.Set("workflowId", alert.WorkflowId)
+	record.Set("workflowRunId", alert.WorkflowRunId)
+	record.Set("payload", alert.Payload)
+	record.Set("status", string(alert.Status))
+	record.Set("notifiedChannels", alert.NotifiedChannels)
+	record.Set("lastNotifiedAt", alert.LastNotifiedAt)
+	record.Set("acknowledgedBy", alert.AcknowledgedBy)
+	record.Set("acknowledgedAt", alert.AcknowledgedAt)
+	record.Set("resolvedAt", alert.ResolvedAt)
+	if err := app.GetApp().Save(record); err != nil {
+		return alert, err
+	}
+
+	alert.Id = record.Id
+	alert.CreatedAt = record.GetDateTime("created").Time()
+	alert.UpdatedAt = record.GetDateTime("updated").Time()
+	return alert, nil
+}
+
+// 仅在告警仍处于触发状态时更新其升级进度，避免覆盖在发送期间被确认或解除的告警状态。
+// 返回值表示是否已更新。
+func (r *NotificationAlertRepository) SaveEscalationIfFiring(ctx context.Context, alert *domain.NotificationAlert) (bool, error) {
+	updated := false
+	err := app.GetApp().RunInTransaction(func(txApp core.App) error {
+		record, err := txApp.FindRecordById(domain.CollectionNameNotificationAlert, alert.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrRecordNotFound
+			}
+			return err
+		}
+
+		if record.GetString("status") != string(domain.NotificationAlertStatusTypeFiring) {
+			return nil
+		}
+
+		record.Set("notifiedChannels", alert.NotifiedChannels)
+		record.Set("lastNotifiedAt", alert.LastNotifiedAt)
+		if err := txApp.Save(record); err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+
+	return updated, err
+}
+
+func (r *NotificationAlertRepository) castRecordToModel(record *core.Record) (*domain.NotificationAlert, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	alert := &domain.NotificationAlert{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		RuleId:           record.GetString("ruleId"),
+		EventType:        domain.NotificationEventType(record.GetString("eventType")),
+		WorkflowId:       record.GetString("workflowId"),
+		WorkflowRunId:    record.GetString("workflowRunId"),
+		Status:           domain.NotificationAlertStatusType(record.GetString("status")),
+		NotifiedChannels: record.GetInt("notifiedChannels"),
+		LastNotifiedAt:   record.GetDateTime("lastNotifiedAt").Time(),
+		AcknowledgedBy:   record.GetString("acknowledgedBy"),
+		AcknowledgedAt:   record.GetDateTime("acknowledgedAt").Time(),
+		ResolvedAt:       record.GetDateTime("resolvedAt").Time(),
+	}
+	if err := record.UnmarshalJSONField("payload", &alert.Payload); err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
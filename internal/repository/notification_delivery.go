@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+type NotificationDeliveryRepository struct{}
+
+func NewNotificationDeliveryRepository() *NotificationDeliveryRepository {
+	return &NotificationDeliveryRepository{}
+}
+
+func (r *NotificationDeliveryRepository) List(ctx context.Context, conditions map[string]any, page, perPage int) ([]*domain.NotificationDelivery, int, error) {
+	filters := make([]string, 0, len(conditions))
+	params := dbx.Params{}
+	for key, value := range conditions {
+		filters = append(filters, fmt.Sprintf("%s={:%s}", key, key))
+		params[key] = value
+	}
+
+	total, err := app.GetApp().CountRecords(domain.CollectionNameNotificationDelivery, dbx.HashExp(conditions))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationDelivery,
+		strings.Join(filters, " && "),
+		"-created",
+		perPage, (page-1)*perPage,
+		params,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deliveries := make([]*domain.NotificationDelivery, 0)
+	for _, record := range records {
+		delivery, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, int(total), nil
+}
+
+func (r *NotificationDeliveryRepository) ListRetryDue(ctx context.Context, now time.Time) ([]*domain.NotificationDelivery, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationDelivery,
+		"status={:status} && nextRetryAt<={:now}",
+		"nextRetryAt",
+		0, 0,
+		dbx.Params{"status": string(domain.NotificationDeliveryStatusTypeRetrying)},
+		dbx.Params{"now": now.UTC().Format(types.DefaultDateLayout)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*domain.NotificationDelivery, 0)
+	for _, record := range records {
+		delivery, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (r *NotificationDeliveryRepository) GetById(ctx context.Context, id string) (*domain.NotificationDelivery, error) {
+	record, err := app.GetApp().FindRecordById(domain.CollectionNameNotificationDelivery, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *NotificationDeliveryRepository) Save(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameNotificationDelivery)
+	if err != nil {
+		return delivery, err
+	}
+
+	var record *core.Record
+	if delivery.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = app.GetApp().FindRecordById(collection, delivery.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return delivery, domain.ErrRecordNotFound
+			}
+			return delivery, err
+		}
+	}
+
+	record.Set("provider", delivery.Provider)
+	record.Set("providerAccessId", delivery.ProviderAccessId)
+	record.Set("providerConfig", delivery.ProviderConfig)
+	record.Set("target", delivery.Target)
+	record.Set("workflowId", delivery.WorkflowId)
+	record.Set("workflowRunId", delivery.WorkflowRunId)
+	record.Set("alertId", delivery.AlertId)
+	record.Set("subject", delivery.Subject)
+	record.Set("payload", delivery.Payload)
+	record.Set("payloadHash", delivery.PayloadHash)
+	record.Set("status", string(delivery.Status))
+	record.Set("attempt", delivery.Attempt)
+	record.Set("retryOf", delivery.RetryOf)
+	record.Set("response", delivery.Response)
+	record.Set("error", delivery.Error)
+	record.Set("latency", delivery.Latency)
+	record.Set("nextRetryAt", delivery.NextRetryAt)
+	if err := app.GetApp().Save(record); err != nil {
+		return delivery, err
+	}
+
+	delivery.Id = record.Id
+	delivery.CreatedAt = record.GetDateTime("created").Time()
+	delivery.UpdatedAt = record.GetDateTime("updated").Time()
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepository) castRecordToModel(record *core.Record) (*domain.NotificationDelivery, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	delivery := &domain.NotificationDelivery{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		Provider:         record.GetString("provider"),
+		ProviderAccessId: record.GetString("providerAccessId"),
+		Target:           record.GetString("target"),
+		WorkflowId:       record.GetString("workflowId"),
+		WorkflowRunId:    record.GetString("workflowRunId"),
+		AlertId:          record.GetString("alertId"),
+		Subject:          record.GetString("subject"),
+		PayloadHash:      record.GetString("payloadHash"),
+		Status:           domain.NotificationDeliveryStatusType(record.GetString("status")),
+		Attempt:          record.GetInt("attempt"),
+		RetryOf:          record.GetString("retryOf"),
+		Error:            record.GetString("error"),
+		Latency:          int64(record.GetInt("latency")),
+		NextRetryAt:      record.GetDateTime("nextRetryAt").Time(),
+	}
+	if err := record.UnmarshalJSONField("providerConfig", &delivery.ProviderConfig); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("payload", &delivery.Payload); err != nil {
+		return nil, err
+	}
+	if err := record.UnmarshalJSONField("response", &delivery.Response); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+type NotificationFingerprintRepository struct{}
+
+func NewNotificationFingerprintRepository() *NotificationFingerprintRepository {
+	return &NotificationFingerprintRepository{}
+}
+
+func (r *NotificationFingerprintRepository) ListByWorkflowId(ctx context.Context, workflowId string) ([]*domain.NotificationFingerprint, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationFingerprint,
+		"workflowId={:workflowId}",
+		"created",
+		0, 0,
+		dbx.Params{"workflowId": workflowId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprints := make([]*domain.NotificationFingerprint, 0)
+	for _, record := range records {
+		fingerprint, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	return fingerprints, nil
+}
+
+func (r *NotificationFingerprintRepository) GetByFingerprint(ctx context.Context, fingerprint string) (*domain.NotificationFingerprint, error) {
+	record, err := app.GetApp().FindFirstRecordByFilter(
+		domain.CollectionNameNotificationFingerprint,
+		"fingerprint={:fingerprint}",
+		dbx.Params{"fingerprint": fingerprint},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *NotificationFingerprintRepository) Save(ctx context.Context, fingerprint *domain.NotificationFingerprint) (*domain.NotificationFingerprint, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameNotificationFingerprint)
+	if err != nil {
+		return fingerprint, err
+	}
+
+	var record *core.Record
+	if fingerprint.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = app.GetApp().FindRecordById(collection, fingerprint.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fingerprint, domain.ErrRecordNotFound
+			}
+			return fingerprint, err
+		}
+	}
+
+	record.Set("fingerprint", fingerprint.Fingerprint)
+	record.Set("workflowId", fingerprint.WorkflowId)
+	record.Set("nodeId", fingerprint.NodeId)
+	record.Set("nodeName", fingerprint.NodeName)
+	record.Set("notifyNodeId", fingerprint.NotifyNodeId)
+	record.Set("errorClass", fingerprint.ErrorClass)
+	record.Set("occurrences", fingerprint.Occurrences)
+	record.Set("suppressed", fingerprint.Suppressed)
+	record.Set("firstSeenAt", fingerprint.FirstSeenAt)
+	record.Set("lastSeenAt", fingerprint.LastSeenAt)
+	record.Set("lastNotifiedAt", fingerprint.LastNotifiedAt)
+	if err := app.GetApp().Save(record); err != nil {
+		return fingerprint, err
+	}
+
+	fingerprint.Id = record.Id
+	fingerprint.CreatedAt = record.GetDateTime("created").Time()
+	fingerprint.UpdatedAt = record.GetDateTime("updated").Time()
+	return fingerprint, nil
+}
+
+func (r *NotificationFingerprintRepository) DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error) {
+	records, err := app.GetApp().FindAllRecords(domain.CollectionNameNotificationFingerprint, exprs...)
+	if err != nil {
+		return 0, nil
+	}
+
+	var ret int
+	var errs []error
+	for _, record := range records {
+		if err := app.GetApp().Delete(record); err != nil {
+			errs = append(errs, err)
+		} else {
+			ret++
+		}
+	}
+
+	if len(errs) > 0 {
+		return ret, errors.Join(errs...)
+	}
+
+	return ret, nil
+}
+
+func (r *NotificationFingerprintRepository) castRecordToModel(record *core.Record) (*domain.NotificationFingerprint, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	fingerprint := &domain.NotificationFingerprint{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		Fingerprint:    record.GetString("fingerprint"),
+		WorkflowId:     record.GetString("workflowId"),
+		NodeId:         record.GetString("nodeId"),
+		NodeName:       record.GetString("nodeName"),
+		NotifyNodeId:   record.GetString("notifyNodeId"),
+		ErrorClass:     record.GetString("errorClass"),
+		Occurrences:    record.GetInt("occurrences"),
+		Suppressed:     record.GetInt("suppressed"),
+		FirstSeenAt:    record.GetDateTime("firstSeenAt").Time(),
+		LastSeenAt:     record.GetDateTime("lastSeenAt").Time(),
+		LastNotifiedAt: record.GetDateTime("lastNotifiedAt").Time(),
+	}
+	return fingerprint, nil
+}
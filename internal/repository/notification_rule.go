@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+type NotificationRuleRepository struct{}
+
+func NewNotificationRuleRepository() *NotificationRuleRepository {
+	return &NotificationRuleRepository{}
+}
+
+func (r *NotificationRuleRepository) ListEnabled(ctx context.Context) ([]*domain.NotificationRule, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameNotificationRule,
+		"enabled={:enabled}",
+		"created",
+		0, 0,
+		dbx.Params{"enabled": true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*domain.NotificationRule, 0)
+	for _, record := range records {
+		rule, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *NotificationRuleRepository) GetById(ctx context.Context, id string) (*domain.NotificationRule, error) {
+	record, err := app.GetApp().FindRecordById(domain.CollectionNameNotificationRule, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *NotificationRuleRepository) castRecordToModel(record *core.Record) (*domain.NotificationRule, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	conditions := &domain.NotificationRuleConditions{}
+	if err := record.UnmarshalJSONField("conditions", &conditions); err != nil {
+		return nil, err
+	}
+
+	channels := make([]domain.NotificationRuleChannel, 0)
+	if err := record.UnmarshalJSONField("channels", &channels); err != nil {
+		return nil, err
+	}
+
+	rule := &domain.NotificationRule{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		Name:       record.GetString("name"),
+		Enabled:    record.GetBool("enabled"),
+		Conditions: conditions,
+		Channels:   channels,
+	}
+	return rule, nil
+}
@@ -76,6 +76,7 @@ func (r *WorkflowRepository) Save(ctx context.Context, workflow *domain.Workflow
 
 	record.Set("name", workflow.Name)
 	record.Set("description", workflow.Description)
+	record.Set("tags", workflow.Tags)
 	record.Set("trigger", string(workflow.Trigger))
 	record.Set("triggerCron", workflow.TriggerCron)
 	record.Set("enabled", workflow.Enabled)
@@ -110,6 +111,11 @@ func (r *WorkflowRepository) castRecordToModel(record *core.Record) (*domain.Wor
 		return nil, err
 	}
 
+	tags := make([]string, 0)
+	if err := record.UnmarshalJSONField("tags", &tags); err != nil {
+		return nil, err
+	}
+
 	workflow := &domain.Workflow{
 		Meta: domain.Meta{
 			Id:        record.Id,
@@ -118,10 +124,12 @@ func (r *WorkflowRepository) castRecordToModel(record *core.Record) (*domain.Wor
 		},
 		Name:          record.GetString("name"),
 		Description:   record.GetString("description"),
+		Tags:          tags,
 		Trigger:       domain.WorkflowTriggerType(record.GetString("trigger")),
 		TriggerCron:   record.GetString("triggerCron"),
 		Enabled:       record.GetBool("enabled"),
 		Content:       content,
+		Version:       record.GetInt("version"),
 		Draft:         draft,
 		HasDraft:      record.GetBool("hasDraft"),
 		LastRunId:     record.GetString("lastRunId"),
@@ -18,6 +18,31 @@ func NewWorkflowRunRepository() *WorkflowRunRepository {
 	return &WorkflowRunRepository{}
 }
 
+func (r *WorkflowRunRepository) ListByStatus(ctx context.Context, status domain.WorkflowRunStatusType) ([]*domain.WorkflowRun, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameWorkflowRun,
+		"status={:status}",
+		"startedAt",
+		0, 0,
+		dbx.Params{"status": string(status)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowRuns := make([]*domain.WorkflowRun, 0)
+	for _, record := range records {
+		workflowRun, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		workflowRuns = append(workflowRuns, workflowRun)
+	}
+
+	return workflowRuns, nil
+}
+
 func (r *WorkflowRunRepository) GetById(ctx context.Context, id string) (*domain.WorkflowRun, error) {
 	record, err := app.GetApp().FindRecordById(domain.CollectionNameWorkflowRun, id)
 	if err != nil {
@@ -51,11 +76,13 @@ func (r *WorkflowRunRepository) Save(ctx context.Context, workflowRun *domain.Wo
 
 	err = app.GetApp().RunInTransaction(func(txApp core.App) error {
 		record.Set("workflowId", workflowRun.WorkflowId)
+		record.Set("workflowVersion", workflowRun.WorkflowVersion)
 		record.Set("trigger", string(workflowRun.Trigger))
 		record.Set("status", string(workflowRun.Status))
 		record.Set("startedAt", workflowRun.StartedAt)
 		record.Set("endedAt", workflowRun.EndedAt)
 		record.Set("detail", workflowRun.Detail)
+		record.Set("checkpoint", workflowRun.Checkpoint)
 		record.Set("error", workflowRun.Error)
 		err = txApp.Save(record)
 		if err != nil {
@@ -130,19 +157,26 @@ func (r *WorkflowRunRepository) castRecordToModel(record *core.Record) (*domain.
 		return nil, err
 	}
 
+	var checkpoint *domain.WorkflowRunCheckpoint
+	if err := record.UnmarshalJSONField("checkpoint", &checkpoint); err != nil {
+		return nil, err
+	}
+
 	workflowRun := &domain.WorkflowRun{
 		Meta: domain.Meta{
 			Id:        record.Id,
 			CreatedAt: record.GetDateTime("created").Time(),
 			UpdatedAt: record.GetDateTime("updated").Time(),
 		},
-		WorkflowId: record.GetString("workflowId"),
-		Status:     domain.WorkflowRunStatusType(record.GetString("status")),
-		Trigger:    domain.WorkflowTriggerType(record.GetString("trigger")),
-		StartedAt:  record.GetDateTime("startedAt").Time(),
-		EndedAt:    record.GetDateTime("endedAt").Time(),
-		Detail:     detail,
-		Error:      record.GetString("error"),
+		WorkflowId:      record.GetString("workflowId"),
+		WorkflowVersion: record.GetInt("workflowVersion"),
+		Status:          domain.WorkflowRunStatusType(record.GetString("status")),
+		Trigger:         domain.WorkflowTriggerType(record.GetString("trigger")),
+		StartedAt:       record.GetDateTime("startedAt").Time(),
+		EndedAt:         record.GetDateTime("endedAt").Time(),
+		Detail:          detail,
+		Checkpoint:      checkpoint,
+		Error:           record.GetString("error"),
 	}
 	return workflowRun, nil
 }
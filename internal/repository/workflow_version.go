@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+type WorkflowVersionRepository struct{}
+
+func NewWorkflowVersionRepository() *WorkflowVersionRepository {
+	return &WorkflowVersionRepository{}
+}
+
+func (r *WorkflowVersionRepository) ListByWorkflowId(ctx context.Context, workflowId string) ([]*domain.WorkflowVersion, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameWorkflowVersion,
+		"workflowId={:workflowId}",
+		"-version",
+		0, 0,
+		dbx.Params{"workflowId": workflowId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowVersions := make([]*domain.WorkflowVersion, 0)
+	for _, record := range records {
+		workflowVersion, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		workflowVersions = append(workflowVersions, workflowVersion)
+	}
+
+	return workflowVersions, nil
+}
+
+func (r *WorkflowVersionRepository) GetByWorkflowIdAndVersion(ctx context.Context, workflowId string, version int) (*domain.WorkflowVersion, error) {
+	record, err := app.GetApp().FindFirstRecordByFilter(
+		domain.CollectionNameWorkflowVersion,
+		"workflowId={:workflowId} && version={:version}",
+		dbx.Params{"workflowId": workflowId, "version": version},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+func (r *WorkflowVersionRepository) GetLatestByWorkflowId(ctx context.Context, workflowId string) (*domain.WorkflowVersion, error) {
+	records, err := app.GetApp().FindRecordsByFilter(
+		domain.CollectionNameWorkflowVersion,
+		"workflowId={:workflowId}",
+		"-version",
+		1, 0,
+		dbx.Params{"workflowId": workflowId},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, domain.ErrRecordNotFound
+	}
+
+	return r.castRecordToModel(records[0])
+}
+
+func (r *WorkflowVersionRepository) Save(ctx context.Context, workflowVersion *domain.WorkflowVersion) (*domain.WorkflowVersion, error) {
+	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameWorkflowVersion)
+	if err != nil {
+		return workflowVersion, err
+	}
+
+	// 版本记录不可变，仅允许新增
+	if workflowVersion.Id != "" {
+		return workflowVersion, errors.New("workflow version is immutable")
+	}
+
+	record := core.NewRecord(collection)
+	err = app.GetApp().RunInTransaction(func(txApp core.App) error {
+		record.Set("workflowId", workflowVersion.WorkflowId)
+		record.Set("version", workflowVersion.Version)
+		record.Set("content", workflowVersion.Content)
+		record.Set("author", workflowVersion.Author)
+		if err := txApp.Save(record); err != nil {
+			return err
+		}
+
+		workflowVersion.Id = record.Id
+		workflowVersion.CreatedAt = record.GetDateTime("created").Time()
+		workflowVersion.UpdatedAt = record.GetDateTime("updated").Time()
+
+		// 事务级联更新所属工作流的当前版本号
+		workflowRecord, err := txApp.FindRecordById(domain.CollectionNameWorkflow, workflowVersion.WorkflowId)
+		if err != nil {
+			return err
+		} else if workflowRecord.GetInt("version") < workflowVersion.Version {
+			workflowRecord.IgnoreUnchangedFields(true)
+			workflowRecord.Set("version", workflowVersion.Version)
+			if err := txApp.Save(workflowRecord); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return workflowVersion, err
+	}
+
+	return workflowVersion, nil
+}
+
+func (r *WorkflowVersionRepository) castRecordToModel(record *core.Record) (*domain.WorkflowVersion, error) {
+	if record == nil {
+		return nil, fmt.Errorf("record is nil")
+	}
+
+	content := &domain.WorkflowNode{}
+	if err := record.UnmarshalJSONField("content", content); err != nil {
+		return nil, err
+	}
+
+	workflowVersion := &domain.WorkflowVersion{
+		Meta: domain.Meta{
+			Id:        record.Id,
+			CreatedAt: record.GetDateTime("created").Time(),
+			UpdatedAt: record.GetDateTime("updated").Time(),
+		},
+		WorkflowId: record.GetString("workflowId"),
+		Version:    record.GetInt("version"),
+		Content:    content,
+		Author:     record.GetString("author"),
+	}
+	return workflowVersion, nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+	"github.com/certimate-go/certimate/internal/rest/middlewares"
+	"github.com/certimate-go/certimate/internal/rest/resp"
+)
+
+type accessService interface {
+	UpdateAccess(ctx context.Context, req *dtos.AccessUpdateReq) error
+}
+
+type AccessHandler struct {
+	service accessService
+}
+
+func NewAccessHandler(router *router.RouterGroup[*core.RequestEvent], service accessService, tokenAuth *middlewares.APITokenAuth) {
+	handler := &AccessHandler{
+		service: service,
+	}
+
+	group := router.Group("/accesses")
+	tokenAuth.Allow(group.PUT("/{accessId}", handler.update), domain.APITokenScopeTypeAccessWrite)
+}
+
+func (handler *AccessHandler) update(e *core.RequestEvent) error {
+	req := &dtos.AccessUpdateReq{}
+	req.AccessId = e.Request.PathValue("accessId")
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+	if e.Get(middlewares.RequestStoreKeyAPIToken) != nil {
+		req.ByAPIToken = true
+	}
+
+	if err := handler.service.UpdateAccess(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	return resp.Ok(e, nil)
+}
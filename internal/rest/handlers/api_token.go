@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+	"github.com/certimate-go/certimate/internal/rest/resp"
+)
+
+type apiTokenService interface {
+	CreateToken(ctx context.Context, req *dtos.APITokenCreateReq) (*dtos.APITokenCreateResp, error)
+	ListTokens(ctx context.Context) (*dtos.APITokenListResp, error)
+	RevokeToken(ctx context.Context, req *dtos.APITokenRevokeReq) error
+}
+
+type APITokenHandler struct {
+	service apiTokenService
+}
+
+func NewAPITokenHandler(router *router.RouterGroup[*core.RequestEvent], service apiTokenService) {
+	handler := &APITokenHandler{
+		service: service,
+	}
+
+	group := router.Group("/api-tokens")
+	group.GET("", handler.list)
+	group.POST("", handler.create)
+	group.DELETE("/{tokenId}", handler.revoke)
+}
+
+func (handler *APITokenHandler) list(e *core.RequestEvent) error {
+	if res, err := handler.service.ListTokens(e.Request.Context()); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *APITokenHandler) create(e *core.RequestEvent) error {
+	req := &dtos.APITokenCreateReq{}
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	if res, err := handler.service.CreateToken(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *APITokenHandler) revoke(e *core.RequestEvent) error {
+	req := &dtos.APITokenRevokeReq{}
+	req.TokenId = e.Request.PathValue("tokenId")
+
+	if err := handler.service.RevokeToken(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	return resp.Ok(e, nil)
+}
@@ -6,11 +6,14 @@ import (
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
 
+	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
+	"github.com/certimate-go/certimate/internal/rest/middlewares"
 	"github.com/certimate-go/certimate/internal/rest/resp"
 )
 
 type certificateService interface {
+	GetCertificate(ctx context.Context, req *dtos.CertificateGetReq) (*dtos.CertificateGetResp, error)
 	ArchiveFile(ctx context.Context, req *dtos.CertificateArchiveFileReq) (*dtos.CertificateArchiveFileResp, error)
 	ValidateCertificate(ctx context.Context, req *dtos.CertificateValidateCertificateReq) (*dtos.CertificateValidateCertificateResp, error)
 	ValidatePrivateKey(ctx context.Context, req *dtos.CertificateValidatePrivateKeyReq) (*dtos.CertificateValidatePrivateKeyResp, error)
@@ -20,17 +23,29 @@ type CertificateHandler struct {
 	service certificateService
 }
 
-func NewCertificateHandler(router *router.RouterGroup[*core.RequestEvent], service certificateService) {
+func NewCertificateHandler(router *router.RouterGroup[*core.RequestEvent], service certificateService, tokenAuth *middlewares.APITokenAuth) {
 	handler := &CertificateHandler{
 		service: service,
 	}
 
 	group := router.Group("/certificates")
-	group.POST("/{certificateId}/archive", handler.archiveFile)
+	tokenAuth.Allow(group.GET("/{certificateId}", handler.get), domain.APITokenScopeTypeCertificateRead)
+	tokenAuth.Allow(group.POST("/{certificateId}/archive", handler.archiveFile), domain.APITokenScopeTypeCertificateExport)
 	group.POST("/validate/certificate", handler.validateCertificate)
 	group.POST("/validate/private-key", handler.validatePrivateKey)
 }
 
+func (handler *CertificateHandler) get(e *core.RequestEvent) error {
+	req := &dtos.CertificateGetReq{}
+	req.CertificateId = e.Request.PathValue("certificateId")
+
+	if res, err := handler.service.GetCertificate(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
 func (handler *CertificateHandler) archiveFile(e *core.RequestEvent) error {
 	req := &dtos.CertificateArchiveFileReq{}
 	req.CertificateId = e.Request.PathValue("certificateId")
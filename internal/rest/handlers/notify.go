@@ -2,16 +2,21 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
 
+	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
 	"github.com/certimate-go/certimate/internal/rest/resp"
 )
 
 type notifyService interface {
 	Test(ctx context.Context, req *dtos.NotifyTestPushReq) error
+	AcknowledgeAlert(ctx context.Context, req *dtos.NotifyAcknowledgeAlertReq) error
+	ListDeliveries(ctx context.Context, req *dtos.NotifyListDeliveriesReq) (*dtos.NotifyListDeliveriesResp, error)
 }
 
 type NotifyHandler struct {
@@ -25,6 +30,8 @@ func NewNotifyHandler(router *router.RouterGroup[*core.RequestEvent], service no
 
 	group := router.Group("/notify")
 	group.POST("/test", handler.test)
+	group.POST("/alerts/{alertId}/acknowledge", handler.acknowledgeAlert)
+	group.GET("/deliveries", handler.listDeliveries)
 }
 
 func (handler *NotifyHandler) test(e *core.RequestEvent) error {
@@ -39,3 +46,48 @@ func (handler *NotifyHandler) test(e *core.RequestEvent) error {
 
 	return resp.Ok(e, nil)
 }
+
+func (handler *NotifyHandler) acknowledgeAlert(e *core.RequestEvent) error {
+	req := &dtos.NotifyAcknowledgeAlertReq{}
+	req.AlertId = e.Request.PathValue("alertId")
+
+	if e.Auth != nil {
+		req.Operator = e.Auth.Email()
+	}
+
+	if err := handler.service.AcknowledgeAlert(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	return resp.Ok(e, nil)
+}
+
+func (handler *NotifyHandler) listDeliveries(e *core.RequestEvent) error {
+	query := e.Request.URL.Query()
+
+	req := &dtos.NotifyListDeliveriesReq{}
+	req.WorkflowId = query.Get("workflowId")
+	req.WorkflowRunId = query.Get("workflowRunId")
+	req.AlertId = query.Get("alertId")
+	req.Status = domain.NotificationDeliveryStatusType(query.Get("status"))
+	if query.Has("page") {
+		if v, err := strconv.Atoi(query.Get("page")); err != nil {
+			return resp.Err(e, errors.New("invalid query parameter 'page'"))
+		} else {
+			req.Page = v
+		}
+	}
+	if query.Has("perPage") {
+		if v, err := strconv.Atoi(query.Get("perPage")); err != nil {
+			return resp.Err(e, errors.New("invalid query parameter 'perPage'"))
+		} else {
+			req.PerPage = v
+		}
+	}
+
+	if res, err := handler.service.ListDeliveries(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
@@ -2,17 +2,26 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
 
+	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
+	"github.com/certimate-go/certimate/internal/rest/middlewares"
 	"github.com/certimate-go/certimate/internal/rest/resp"
 )
 
 type workflowService interface {
 	StartRun(ctx context.Context, req *dtos.WorkflowStartRunReq) error
 	CancelRun(ctx context.Context, req *dtos.WorkflowCancelRunReq) error
+	GetRun(ctx context.Context, req *dtos.WorkflowGetRunReq) (*domain.WorkflowRun, error)
+	ApproveRun(ctx context.Context, req *dtos.WorkflowApproveRunReq) error
+	ListVersions(ctx context.Context, req *dtos.WorkflowListVersionsReq) (*dtos.WorkflowListVersionsResp, error)
+	DiffVersions(ctx context.Context, req *dtos.WorkflowDiffVersionsReq) (*dtos.WorkflowDiffVersionsResp, error)
+	RestoreVersion(ctx context.Context, req *dtos.WorkflowRestoreVersionReq) error
 	Shutdown(ctx context.Context)
 }
 
@@ -20,14 +29,19 @@ type WorkflowHandler struct {
 	service workflowService
 }
 
-func NewWorkflowHandler(router *router.RouterGroup[*core.RequestEvent], service workflowService) {
+func NewWorkflowHandler(router *router.RouterGroup[*core.RequestEvent], service workflowService, tokenAuth *middlewares.APITokenAuth) {
 	handler := &WorkflowHandler{
 		service: service,
 	}
 
 	group := router.Group("/workflows")
-	group.POST("/{workflowId}/runs", handler.run)
-	group.POST("/{workflowId}/runs/{runId}/cancel", handler.cancel)
+	tokenAuth.Allow(group.POST("/{workflowId}/runs", handler.run), domain.APITokenScopeTypeWorkflowRun)
+	tokenAuth.Allow(group.GET("/{workflowId}/runs/{runId}", handler.getRun), domain.APITokenScopeTypeWorkflowRead)
+	tokenAuth.Allow(group.POST("/{workflowId}/runs/{runId}/cancel", handler.cancel), domain.APITokenScopeTypeWorkflowRun)
+	group.POST("/{workflowId}/runs/{runId}/approve", handler.approve)
+	tokenAuth.Allow(group.GET("/{workflowId}/versions", handler.listVersions), domain.APITokenScopeTypeWorkflowRead)
+	tokenAuth.Allow(group.GET("/{workflowId}/versions/diff", handler.diffVersions), domain.APITokenScopeTypeWorkflowRead)
+	group.POST("/{workflowId}/versions/{version}/restore", handler.restoreVersion)
 }
 
 func (handler *WorkflowHandler) run(e *core.RequestEvent) error {
@@ -44,6 +58,18 @@ func (handler *WorkflowHandler) run(e *core.RequestEvent) error {
 	return resp.Ok(e, nil)
 }
 
+func (handler *WorkflowHandler) getRun(e *core.RequestEvent) error {
+	req := &dtos.WorkflowGetRunReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	req.RunId = e.Request.PathValue("runId")
+
+	if res, err := handler.service.GetRun(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
 func (handler *WorkflowHandler) cancel(e *core.RequestEvent) error {
 	req := &dtos.WorkflowCancelRunReq{}
 	req.WorkflowId = e.Request.PathValue("workflowId")
@@ -55,3 +81,70 @@ func (handler *WorkflowHandler) cancel(e *core.RequestEvent) error {
 
 	return resp.Ok(e, nil)
 }
+
+func (handler *WorkflowHandler) approve(e *core.RequestEvent) error {
+	req := &dtos.WorkflowApproveRunReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	req.RunId = e.Request.PathValue("runId")
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	if e.Auth != nil {
+		req.Operator = e.Auth.Email()
+	}
+
+	if err := handler.service.ApproveRun(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	return resp.Ok(e, nil)
+}
+
+func (handler *WorkflowHandler) listVersions(e *core.RequestEvent) error {
+	req := &dtos.WorkflowListVersionsReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+
+	if res, err := handler.service.ListVersions(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *WorkflowHandler) diffVersions(e *core.RequestEvent) error {
+	req := &dtos.WorkflowDiffVersionsReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	if v, err := strconv.Atoi(e.Request.URL.Query().Get("from")); err != nil {
+		return resp.Err(e, errors.New("invalid query parameter 'from'"))
+	} else {
+		req.FromVersion = v
+	}
+	if v, err := strconv.Atoi(e.Request.URL.Query().Get("to")); err != nil {
+		return resp.Err(e, errors.New("invalid query parameter 'to'"))
+	} else {
+		req.ToVersion = v
+	}
+
+	if res, err := handler.service.DiffVersions(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *WorkflowHandler) restoreVersion(e *core.RequestEvent) error {
+	req := &dtos.WorkflowRestoreVersionReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	if v, err := strconv.Atoi(e.Request.PathValue("version")); err != nil {
+		return resp.Err(e, errors.New("invalid path parameter 'version'"))
+	} else {
+		req.Version = v
+	}
+
+	if err := handler.service.RestoreVersion(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	return resp.Ok(e, nil)
+}
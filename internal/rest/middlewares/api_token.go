@@ -0,0 +1,78 @@
+package middlewares
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/hook"
+	"github.com/pocketbase/pocketbase/tools/router"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+)
+
+const RequestStoreKeyAPIToken = "certimate.apiToken"
+
+type apiTokenService interface {
+	Authenticate(ctx context.Context, req *dtos.APITokenAuthenticateReq) (*domain.APIToken, error)
+}
+
+// API 令牌认证。
+// 路由默认仅允许超级管理员访问；通过 Allow 声明所需权限范围后，也可使用 API 令牌访问。
+type APITokenAuth struct {
+	service apiTokenService
+}
+
+func NewAPITokenAuth(service apiTokenService) *APITokenAuth {
+	return &APITokenAuth{
+		service: service,
+	}
+}
+
+// 允许持有指定权限范围的 API 令牌访问路由，同时保留超级管理员认证。
+func (a *APITokenAuth) Allow(route *router.Route[*core.RequestEvent], scope domain.APITokenScopeType) *router.Route[*core.RequestEvent] {
+	return route.
+		Unbind(apis.DefaultRequireSuperuserAuthMiddlewareId).
+		Bind(a.RequireSuperuserOrAPIToken(scope))
+}
+
+func (a *APITokenAuth) RequireSuperuserOrAPIToken(scope domain.APITokenScopeType) *hook.Handler[*core.RequestEvent] {
+	return &hook.Handler[*core.RequestEvent]{
+		Func: func(e *core.RequestEvent) error {
+			if e.HasSuperuserAuth() {
+				return e.Next()
+			}
+
+			token := strings.TrimSpace(e.Request.Header.Get("Authorization"))
+			token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+			if token == "" {
+				return e.UnauthorizedError("The request requires valid superuser authorization token or api token to be set.", nil)
+			}
+
+			apiToken, err := a.service.Authenticate(e.Request.Context(), &dtos.APITokenAuthenticateReq{
+				Token:         token,
+				Scope:         scope,
+				WorkflowId:    e.Request.PathValue("workflowId"),
+				CertificateId: e.Request.PathValue("certificateId"),
+				AccessId:      e.Request.PathValue("accessId"),
+				ClientIp:      e.RealIP(),
+			})
+			if err != nil {
+				if xerr, ok := err.(*domain.Error); ok {
+					switch xerr.Code {
+					case 401:
+						return e.UnauthorizedError(xerr.Msg, nil)
+					case 403:
+						return e.ForbiddenError(xerr.Msg, nil)
+					}
+				}
+				return e.InternalServerError("", err)
+			}
+
+			e.Set(RequestStoreKeyAPIToken, apiToken)
+			return e.Next()
+		},
+	}
+}
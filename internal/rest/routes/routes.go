@@ -7,10 +7,13 @@ import (
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
 
+	"github.com/certimate-go/certimate/internal/access"
+	"github.com/certimate-go/certimate/internal/apitoken"
 	"github.com/certimate-go/certimate/internal/certificate"
 	"github.com/certimate-go/certimate/internal/notify"
 	"github.com/certimate-go/certimate/internal/repository"
 	"github.com/certimate-go/certimate/internal/rest/handlers"
+	"github.com/certimate-go/certimate/internal/rest/middlewares"
 	"github.com/certimate-go/certimate/internal/statistics"
 	"github.com/certimate-go/certimate/internal/workflow"
 )
@@ -20,26 +23,41 @@ var (
 	workflowSvc    *workflow.WorkflowService
 	statisticsSvc  *statistics.StatisticsService
 	notifySvc      *notify.NotifyService
+	accessSvc      *access.AccessService
+	apiTokenSvc    *apitoken.APITokenService
 )
 
 func Register(router *router.Router[*core.RequestEvent]) {
 	workflowRepo := repository.NewWorkflowRepository()
 	workflowRunRepo := repository.NewWorkflowRunRepository()
+	workflowVersionRepo := repository.NewWorkflowVersionRepository()
 	certificateRepo := repository.NewCertificateRepository()
 	settingsRepo := repository.NewSettingsRepository()
 	statisticsRepo := repository.NewStatisticsRepository()
+	notificationRuleRepo := repository.NewNotificationRuleRepository()
+	notificationAlertRepo := repository.NewNotificationAlertRepository()
+	notificationDeliveryRepo := repository.NewNotificationDeliveryRepository()
+	accessRepo := repository.NewAccessRepository()
+	apiTokenRepo := repository.NewAPITokenRepository()
 
-	certificateSvc = certificate.NewCertificateService(certificateRepo, settingsRepo)
-	workflowSvc = workflow.NewWorkflowService(workflowRepo, workflowRunRepo, settingsRepo)
+	certificateSvc = certificate.NewCertificateService(certificateRepo, workflowRepo, settingsRepo)
+	workflowSvc = workflow.NewWorkflowService(workflowRepo, workflowRunRepo, workflowVersionRepo, settingsRepo)
 	statisticsSvc = statistics.NewStatisticsService(statisticsRepo)
-	notifySvc = notify.NewNotifyService(settingsRepo)
+	notifySvc = notify.NewNotifyService(settingsRepo, notificationRuleRepo, notificationAlertRepo, notificationDeliveryRepo)
+	accessSvc = access.NewAccessService(accessRepo)
+	apiTokenSvc = apitoken.NewAPITokenService(apiTokenRepo, certificateRepo)
+
+	// 路由默认仅允许超级管理员访问，部分路由额外允许持有相应权限范围的 API 令牌访问
+	tokenAuth := middlewares.NewAPITokenAuth(apiTokenSvc)
 
 	group := router.Group("/api")
 	group.Bind(apis.RequireSuperuserAuth())
-	handlers.NewCertificateHandler(group, certificateSvc)
-	handlers.NewWorkflowHandler(group, workflowSvc)
+	handlers.NewCertificateHandler(group, certificateSvc, tokenAuth)
+	handlers.NewWorkflowHandler(group, workflowSvc, tokenAuth)
+	handlers.NewAccessHandler(group, accessSvc, tokenAuth)
 	handlers.NewStatisticsHandler(group, statisticsSvc)
 	handlers.NewNotifyHandler(group, notifySvc)
+	handlers.NewAPITokenHandler(group, apiTokenSvc)
 }
 
 func Unregister() {
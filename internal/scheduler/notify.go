@@ -0,0 +1,11 @@
+package scheduler
+
+import "context"
+
+type notifyService interface {
+	InitSchedule(ctx context.Context) error
+}
+
+func InitNotifyScheduler(service notifyService) error {
+	return service.InitSchedule(context.Background())
+}
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/certificate"
+	"github.com/certimate-go/certimate/internal/notify"
 	"github.com/certimate-go/certimate/internal/repository"
 	"github.com/certimate-go/certimate/internal/workflow"
 )
@@ -10,11 +11,16 @@ import (
 func Register() {
 	workflowRepo := repository.NewWorkflowRepository()
 	workflowRunRepo := repository.NewWorkflowRunRepository()
+	workflowVersionRepo := repository.NewWorkflowVersionRepository()
 	certificateRepo := repository.NewCertificateRepository()
 	settingsRepo := repository.NewSettingsRepository()
+	notificationRuleRepo := repository.NewNotificationRuleRepository()
+	notificationAlertRepo := repository.NewNotificationAlertRepository()
+	notificationDeliveryRepo := repository.NewNotificationDeliveryRepository()
 
-	workflowSvc := workflow.NewWorkflowService(workflowRepo, workflowRunRepo, settingsRepo)
-	certificateSvc := certificate.NewCertificateService(certificateRepo, settingsRepo)
+	workflowSvc := workflow.NewWorkflowService(workflowRepo, workflowRunRepo, workflowVersionRepo, settingsRepo)
+	certificateSvc := certificate.NewCertificateService(certificateRepo, workflowRepo, settingsRepo)
+	notifySvc := notify.NewNotifyService(settingsRepo, notificationRuleRepo, notificationAlertRepo, notificationDeliveryRepo)
 
 	if err := InitWorkflowScheduler(workflowSvc); err != nil {
 		app.GetLogger().Error("failed to init workflow scheduler", "err", err)
@@ -23,4 +29,8 @@ func Register() {
 	if err := InitCertificateScheduler(certificateSvc); err != nil {
 		app.GetLogger().Error("failed to init certificate scheduler", "err", err)
 	}
+
+	if err := InitNotifyScheduler(notifySvc); err != nil {
+		app.GetLogger().Error("failed to init notify scheduler", "err", err)
+	}
 }
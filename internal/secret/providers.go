@@ -0,0 +1,197 @@
+package secret
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/certimate-go/certimate/internal/app"
+	vaultsdk "github.com/certimate-go/certimate/pkg/sdk3rd/vault"
+)
+
+const envSecretFileRoot = "CERTIMATE_SECRET_FILE_ROOT"
+
+// 获取 Certimate 的数据目录，引用该目录下的文件会被拒绝。
+var getDataDir = func() string {
+	return app.GetApp().DataDir()
+}
+
+// 解析 `env://NAME` 形式的引用，读取环境变量。
+func resolveEnv(ctx context.Context, ref string) (string, error) {
+	name := strings.TrimPrefix(ref, "env://")
+	if name == "" {
+		return "", fmt.Errorf("invalid secret reference '%s': missing environment variable name", ref)
+	}
+
+	// 不允许引用 Certimate 自身的配置项，避免泄露主密钥等敏感信息
+	if strings.HasPrefix(name, "CERTIMATE_") {
+		return "", fmt.Errorf("invalid secret reference '%s': environment variable '%s' is reserved", ref, name)
+	}
+
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable '%s' is not set", name)
+	}
+
+	return value, nil
+}
+
+// 解析 `file:///path/to/file` 形式的引用，读取文件内容（去除末尾换行）。
+// 仅允许读取环境变量 `CERTIMATE_SECRET_FILE_ROOT` 指定的目录下的文件；未指定时不允许使用此类引用。
+func resolveFile(ctx context.Context, ref string) (string, error) {
+	path := strings.TrimPrefix(ref, "file://")
+	if path == "" {
+		return "", fmt.Errorf("invalid secret reference '%s': missing file path", ref)
+	} else if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("invalid secret reference '%s': file path must be absolute", ref)
+	}
+
+	root := os.Getenv(envSecretFileRoot)
+	if root == "" {
+		return "", fmt.Errorf("invalid secret reference '%s': file references are disabled, please set environment variable '%s' first", ref, envSecretFileRoot)
+	}
+
+	realRoot, err := evalPath(root)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret file root '%s': %w", root, err)
+	}
+
+	// 解析符号链接后再检查，避免经由 `..`、符号链接等方式逃逸出允许的目录
+	realPath, err := evalPath(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file '%s': %w", path, err)
+	}
+
+	if !isPathWithin(realRoot, realPath) {
+		return "", fmt.Errorf("invalid secret reference '%s': file '%s' is outside of '%s'", ref, path, root)
+	}
+
+	// 不允许引用 Certimate 自身的主密钥文件、数据目录及系统伪文件系统，避免泄露敏感信息
+	reservedPaths := []string{"/proc", "/sys", "/dev", getDataDir()}
+	if keyFile := os.Getenv("CERTIMATE_ENCRYPTION_KEY_FILE"); keyFile != "" {
+		reservedPaths = append(reservedPaths, keyFile)
+	}
+	for _, reservedPath := range reservedPaths {
+		if reservedPath == "" {
+			continue
+		}
+
+		reservedPath, _ = evalPath(reservedPath)
+		if reservedPath != "" && isPathWithin(reservedPath, realPath) {
+			return "", fmt.Errorf("invalid secret reference '%s': file '%s' is reserved", ref, path)
+		}
+	}
+
+	data, err := os.ReadFile(realPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file '%s': %w", path, err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// 获取文件的绝对路径，并解析其中的符号链接。
+// 解析符号链接失败时（如文件不存在）仍会返回清理后的绝对路径。
+func evalPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	realPath, err := filepath.EvalSymlinks(absPath)
+	if err != nil {
+		return absPath, err
+	}
+
+	return realPath, nil
+}
+
+func isPathWithin(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// 解析 `vault://mount/data/path#key` 形式的引用，读取 Vault KV 机密引擎中的值。
+// 路径中包含 `/data/` 时视为 KV v2，否则视为 KV v1。
+// Vault 的连接信息通过环境变量 `VAULT_ADDR`、`VAULT_TOKEN`、`VAULT_NAMESPACE`、`VAULT_SKIP_VERIFY` 指定。
+func resolveVault(ctx context.Context, ref string) (string, error) {
+	path, key, _ := strings.Cut(strings.TrimPrefix(ref, "vault://"), "#")
+	mountPath, secretPath, ok := strings.Cut(strings.Trim(path, "/"), "/")
+	if !ok || mountPath == "" || secretPath == "" {
+		return "", fmt.Errorf("invalid secret reference '%s': expected format 'vault://<mount>/<path>#<key>'", ref)
+	}
+
+	version := int32(1)
+	if strings.HasPrefix(secretPath, "data/") {
+		version = 2
+		secretPath = strings.TrimPrefix(secretPath, "data/")
+	}
+
+	client, err := createVaultClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.KVReadWithContext(ctx, mountPath, secretPath, &vaultsdk.KVReadRequest{Version: version})
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault secret '%s': %w", path, err)
+	} else if len(resp.Data) == 0 {
+		return "", fmt.Errorf("vault secret '%s' is empty", path)
+	}
+
+	if key == "" {
+		if len(resp.Data) != 1 {
+			keys := slices.Sorted(maps.Keys(resp.Data))
+			return "", fmt.Errorf("invalid secret reference '%s': vault secret '%s' has multiple keys (%s), please specify one by '#<key>'", ref, path, strings.Join(keys, ", "))
+		}
+
+		for k := range resp.Data {
+			key = k
+		}
+	}
+
+	value, ok := resp.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' not found in vault secret '%s'", key, path)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+func createVaultClient() (*vaultsdk.Client, error) {
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return nil, errors.New("environment variable 'VAULT_ADDR' is not set")
+	}
+
+	client, err := vaultsdk.NewClient(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetToken(os.Getenv("VAULT_TOKEN"))
+	client.SetNamespace(os.Getenv("VAULT_NAMESPACE"))
+	if skip, _ := strconv.ParseBool(os.Getenv("VAULT_SKIP_VERIFY")); skip {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
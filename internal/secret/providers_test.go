@@ -0,0 +1,85 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_resolveFile(t *testing.T) {
+	rootDir := t.TempDir()
+	dataDir := filepath.Join(rootDir, "pb_data")
+	outsideDir := t.TempDir()
+
+	mustWriteFile := func(path string, data string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+	}
+	mustWriteFile(filepath.Join(rootDir, "secrets", "token"), "my-token\n")
+	mustWriteFile(filepath.Join(rootDir, "master.key"), "master-key")
+	mustWriteFile(filepath.Join(dataDir, "data.db"), "sqlite")
+	mustWriteFile(filepath.Join(outsideDir, "token"), "outside-token")
+	if err := os.Symlink(filepath.Join(outsideDir, "token"), filepath.Join(rootDir, "secrets", "link")); err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	getDataDirOrigin := getDataDir
+	getDataDir = func() string { return dataDir }
+	t.Cleanup(func() { getDataDir = getDataDirOrigin })
+
+	t.Setenv("CERTIMATE_ENCRYPTION_KEY_FILE", filepath.Join(rootDir, "master.key"))
+
+	t.Run("root not set", func(t *testing.T) {
+		t.Setenv("CERTIMATE_SECRET_FILE_ROOT", "")
+
+		if _, err := resolveFile(context.Background(), "file://"+filepath.Join(rootDir, "secrets", "token")); err == nil || !strings.Contains(err.Error(), "file references are disabled") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with root", func(t *testing.T) {
+		t.Setenv("CERTIMATE_SECRET_FILE_ROOT", rootDir)
+
+		value, err := resolveFile(context.Background(), "file://"+filepath.Join(rootDir, "secrets", "token"))
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		} else if value != "my-token" {
+			t.Errorf("unexpected value: %s", value)
+		}
+
+		tests := []struct {
+			name    string
+			ref     string
+			wantErr string
+		}{
+			{"relative path", "file://secrets/token", "must be absolute"},
+			{"outside root", "file://" + filepath.Join(outsideDir, "token"), "is outside of"},
+			{"dot dot", "file://" + rootDir + "/secrets/../../" + filepath.Base(outsideDir) + "/token", "is outside of"},
+			{"symlink", "file://" + filepath.Join(rootDir, "secrets", "link"), "is outside of"},
+			{"key file", "file://" + filepath.Join(rootDir, "master.key"), "is reserved"},
+			{"key file with double slashes", "file://" + strings.ReplaceAll(filepath.Join(rootDir, "master.key"), "/", "//"), "is reserved"},
+			{"data dir", "file://" + filepath.Join(dataDir, "data.db"), "is reserved"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := resolveFile(context.Background(), tt.ref); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("unexpected error: %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("proc", func(t *testing.T) {
+		t.Setenv("CERTIMATE_SECRET_FILE_ROOT", "/")
+
+		if _, err := resolveFile(context.Background(), "file:///proc/self/environ"); err == nil || !strings.Contains(err.Error(), "is reserved") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 外部机密引用的解析器。
+type resolveFunc func(ctx context.Context, ref string) (string, error)
+
+// 支持的引用协议，key 为协议前缀。
+var resolveFuncs = map[string]resolveFunc{
+	"env://":   resolveEnv,
+	"file://":  resolveFile,
+	"vault://": resolveVault,
+}
+
+const defaultCacheTTL = 5 * time.Minute
+
+type cacheEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// 机密引用解析器。
+// 授权配置中的字符串值可以是形如 `env://NAME`、`file:///path/to/file`、`vault://mount/data/path#key` 的引用，
+// 在使用时才解析为实际值，从而避免将长期有效的凭据保存在数据库中。解析结果将在 TTL 内被缓存。
+type Resolver struct {
+	ttl time.Duration
+
+	cache      map[string]*cacheEntry
+	cacheMutex sync.Mutex
+}
+
+func NewResolver(ttl time.Duration) *Resolver {
+	return &Resolver{
+		ttl:   ttl,
+		cache: make(map[string]*cacheEntry),
+	}
+}
+
+var defaultResolver = NewResolver(defaultCacheTTL)
+
+// 使用默认解析器解析配置中的所有机密引用，参见 [Resolver.ResolveConfig]。
+func ResolveConfig(ctx context.Context, config map[string]any) (map[string]any, error) {
+	return defaultResolver.ResolveConfig(ctx, config)
+}
+
+// 判断值是否为机密引用。
+func IsReference(s string) bool {
+	_, ok := lookupResolveFunc(s)
+	return ok
+}
+
+// 解析配置中的所有机密引用。
+// 返回解析后的副本，不会修改原配置；非引用的值原样保留。
+func (r *Resolver) ResolveConfig(ctx context.Context, config map[string]any) (map[string]any, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	resolved, err := r.resolveValue(ctx, "", config)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.(map[string]any), nil
+}
+
+// 解析单个机密引用。
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	resolve, ok := lookupResolveFunc(ref)
+	if !ok {
+		return "", fmt.Errorf("unsupported secret reference '%s'", ref)
+	}
+
+	r.cacheMutex.Lock()
+	if entry, ok := r.cache[ref]; ok && time.Now().Before(entry.expiresAt) {
+		r.cacheMutex.Unlock()
+		return entry.value, nil
+	}
+	r.cacheMutex.Unlock()
+
+	value, err := resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	if r.ttl > 0 {
+		r.cacheMutex.Lock()
+		r.cache[ref] = &cacheEntry{value: value, expiresAt: time.Now().Add(r.ttl)}
+		r.cacheMutex.Unlock()
+	}
+
+	return value, nil
+}
+
+func (r *Resolver) resolveValue(ctx context.Context, path string, value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		if !IsReference(v) {
+			return v, nil
+		}
+
+		resolved, err := r.Resolve(ctx, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve secret reference of config '%s': %w", path, err)
+		}
+		return resolved, nil
+
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+
+			resolved, err := r.resolveValue(ctx, itemPath, item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = resolved
+		}
+		return result, nil
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := r.resolveValue(ctx, fmt.Sprintf("%s[%d]", path, i), item)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+	}
+
+	return value, nil
+}
+
+func lookupResolveFunc(ref string) (resolveFunc, bool) {
+	for prefix, resolve := range resolveFuncs {
+		if strings.HasPrefix(ref, prefix) {
+			return resolve, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResolver_ResolveConfig(t *testing.T) {
+	t.Setenv("TEST_SECRET_AK", "my-access-key")
+
+	secretDir := t.TempDir()
+	secretFile := filepath.Join(secretDir, "sk")
+	if err := os.WriteFile(secretFile, []byte("my-secret-key\n"), 0o600); err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	t.Setenv("CERTIMATE_SECRET_FILE_ROOT", secretDir)
+
+	vaultRequests := 0
+	vaultServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vaultRequests++
+		if r.URL.Path != "/v1/secret/data/dns" || r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{"data":{"token":"dns-token","user":"admin"},"metadata":{"version":1}}}`))
+	}))
+	defer vaultServer.Close()
+	t.Setenv("VAULT_ADDR", vaultServer.URL)
+	t.Setenv("VAULT_TOKEN", "vault-token")
+
+	config := map[string]any{
+		"accessKeyId":     "env://TEST_SECRET_AK",
+		"accessKeySecret": "file://" + secretFile,
+		"dnsToken":        "vault://secret/data/dns#token",
+		"endpoint":        "https://example.com",
+		"nested": map[string]any{
+			"items": []any{"env://TEST_SECRET_AK", 1},
+		},
+	}
+
+	resolver := NewResolver(time.Minute)
+	for i := 0; i < 2; i++ {
+		resolved, err := resolver.ResolveConfig(context.Background(), config)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if resolved["accessKeyId"] != "my-access-key" || resolved["accessKeySecret"] != "my-secret-key" || resolved["dnsToken"] != "dns-token" || resolved["endpoint"] != "https://example.com" {
+			t.Errorf("unexpected resolved config: %+v", resolved)
+		}
+		if items := resolved["nested"].(map[string]any)["items"].([]any); items[0] != "my-access-key" || items[1] != 1 {
+			t.Errorf("unexpected resolved nested config: %+v", items)
+		}
+	}
+
+	if config["accessKeyId"] != "env://TEST_SECRET_AK" {
+		t.Errorf("original config should not be modified")
+	}
+	if vaultRequests != 1 {
+		t.Errorf("expected vault to be requested once due to caching, got %d", vaultRequests)
+	}
+}
+
+func TestResolver_ResolveConfigErrors(t *testing.T) {
+	t.Setenv("CERTIMATE_TEST_RESERVED", "x")
+	t.Setenv("CERTIMATE_SECRET_FILE_ROOT", "/")
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{"env not set", "env://TEST_SECRET_NOT_EXISTS", "environment variable 'TEST_SECRET_NOT_EXISTS' is not set"},
+		{"env reserved", "env://CERTIMATE_TEST_RESERVED", "is reserved"},
+		{"file not found", "file:///not/exists", "failed to read secret file '/not/exists'"},
+		{"vault malformed", "vault://secret", "expected format"},
+	}
+
+	resolver := NewResolver(time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolver.ResolveConfig(context.Background(), map[string]any{"secret": tt.value})
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+			if !strings.Contains(err.Error(), "config 'secret'") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package workflow
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/domain/dtos"
+)
+
+const (
+	nodeChangeActionAdded    = "added"
+	nodeChangeActionRemoved  = "removed"
+	nodeChangeActionModified = "modified"
+)
+
+type flattenedNode struct {
+	node   *domain.WorkflowNode
+	prevId string // 前驱节点 ID（分支中的首个节点为其所属分支节点 ID）
+	order  int    // 深度优先遍历的顺序
+}
+
+// 比较两个版本的工作流结构，返回节点级别的变更列表。
+// 节点以 ID 作为唯一标识；对于同时存在于两个版本中的节点，会逐项比较其名称、类型、配置和位置。
+func diffWorkflowNodes(from, to *domain.WorkflowNode) []*dtos.WorkflowNodeChangeItem {
+	fromNodes := flattenWorkflowNodes(from)
+	toNodes := flattenWorkflowNodes(to)
+
+	changes := make([]*dtos.WorkflowNodeChangeItem, 0)
+
+	for _, nodeId := range sortedNodeIds(toNodes) {
+		toNode := toNodes[nodeId]
+		fromNode, ok := fromNodes[nodeId]
+		if !ok {
+			changes = append(changes, &dtos.WorkflowNodeChangeItem{
+				NodeId:   nodeId,
+				NodeName: toNode.node.Name,
+				NodeType: toNode.node.Type,
+				Action:   nodeChangeActionAdded,
+			})
+			continue
+		}
+
+		fields := diffWorkflowNode(fromNode, toNode)
+		if len(fields) > 0 {
+			changes = append(changes, &dtos.WorkflowNodeChangeItem{
+				NodeId:   nodeId,
+				NodeName: toNode.node.Name,
+				NodeType: toNode.node.Type,
+				Action:   nodeChangeActionModified,
+				Fields:   fields,
+			})
+		}
+	}
+
+	for _, nodeId := range sortedNodeIds(fromNodes) {
+		if _, ok := toNodes[nodeId]; !ok {
+			fromNode := fromNodes[nodeId]
+			changes = append(changes, &dtos.WorkflowNodeChangeItem{
+				NodeId:   nodeId,
+				NodeName: fromNode.node.Name,
+				NodeType: fromNode.node.Type,
+				Action:   nodeChangeActionRemoved,
+			})
+		}
+	}
+
+	return changes
+}
+
+func diffWorkflowNode(from, to *flattenedNode) []*dtos.WorkflowFieldChangeItem {
+	fields := make([]*dtos.WorkflowFieldChangeItem, 0)
+
+	if from.node.Name != to.node.Name {
+		fields = append(fields, &dtos.WorkflowFieldChangeItem{Field: "name", From: from.node.Name, To: to.node.Name})
+	}
+	if from.node.Type != to.node.Type {
+		fields = append(fields, &dtos.WorkflowFieldChangeItem{Field: "type", From: from.node.Type, To: to.node.Type})
+	}
+	if from.prevId != to.prevId {
+		fields = append(fields, &dtos.WorkflowFieldChangeItem{Field: "position", From: from.prevId, To: to.prevId})
+	}
+
+	configKeys := make(map[string]struct{})
+	for k := range from.node.Config {
+		configKeys[k] = struct{}{}
+	}
+	for k := range to.node.Config {
+		configKeys[k] = struct{}{}
+	}
+	sortedConfigKeys := make([]string, 0, len(configKeys))
+	for k := range configKeys {
+		sortedConfigKeys = append(sortedConfigKeys, k)
+	}
+	sort.Strings(sortedConfigKeys)
+
+	for _, k := range sortedConfigKeys {
+		fromValue, fromOk := from.node.Config[k]
+		toValue, toOk := to.node.Config[k]
+		if fromOk && toOk && jsonEqual(fromValue, toValue) {
+			continue
+		}
+		if !fromOk && isZeroConfigValue(toValue) || !toOk && isZeroConfigValue(fromValue) {
+			continue
+		}
+
+		fields = append(fields, &dtos.WorkflowFieldChangeItem{Field: "config." + k, From: fromValue, To: toValue})
+	}
+
+	return fields
+}
+
+func flattenWorkflowNodes(root *domain.WorkflowNode) map[string]*flattenedNode {
+	nodes := make(map[string]*flattenedNode)
+
+	var walk func(node *domain.WorkflowNode, prevId string)
+	walk = func(node *domain.WorkflowNode, prevId string) {
+		for current := node; current != nil; current = current.Next {
+			if current.Id != "" {
+				nodes[current.Id] = &flattenedNode{node: current, prevId: prevId, order: len(nodes)}
+			}
+
+			for i := range current.Branches {
+				walk(&current.Branches[i], current.Id)
+			}
+
+			prevId = current.Id
+		}
+	}
+	walk(root, "")
+
+	return nodes
+}
+
+func sortedNodeIds(nodes map[string]*flattenedNode) []string {
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return nodes[ids[i]].order < nodes[ids[j]].order
+	})
+	return ids
+}
+
+func jsonEqual(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+
+	var va, vb any
+	json.Unmarshal(ja, &va)
+	json.Unmarshal(jb, &vb)
+	return reflect.DeepEqual(va, vb)
+}
+
+func isZeroConfigValue(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Map, reflect.Slice:
+		return rv.Len() == 0
+	}
+	return false
+}
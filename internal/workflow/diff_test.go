@@ -0,0 +1,55 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+func Test_diffWorkflowNodes(t *testing.T) {
+	from := &domain.WorkflowNode{
+		Id: "start", Type: domain.WorkflowNodeTypeStart, Name: "Start",
+		Next: &domain.WorkflowNode{
+			Id: "apply", Type: domain.WorkflowNodeTypeApply, Name: "Apply",
+			Config: map[string]any{"domains": "example.com", "provider": "aliyun"},
+			Next: &domain.WorkflowNode{
+				Id: "deploy", Type: domain.WorkflowNodeTypeDeploy, Name: "Deploy",
+				Config: map[string]any{"provider": "aliyun-cdn", "providerConfig": map[string]any{"domain": "a.example.com"}},
+			},
+		},
+	}
+	to := &domain.WorkflowNode{
+		Id: "start", Type: domain.WorkflowNodeTypeStart, Name: "Start",
+		Next: &domain.WorkflowNode{
+			Id: "apply", Type: domain.WorkflowNodeTypeApply, Name: "Apply",
+			Config: map[string]any{"domains": "example.com", "provider": "aliyun", "dnsTTL": ""},
+			Next: &domain.WorkflowNode{
+				Id: "deploy", Type: domain.WorkflowNodeTypeDeploy, Name: "Deploy to CDN",
+				Config: map[string]any{"provider": "aliyun-cdn", "providerConfig": map[string]any{"domain": "b.example.com"}},
+				Next: &domain.WorkflowNode{
+					Id: "notify", Type: domain.WorkflowNodeTypeNotify, Name: "Notify",
+				},
+			},
+		},
+	}
+
+	changes := diffWorkflowNodes(from, to)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+
+	if changes[0].NodeId != "deploy" || changes[0].Action != nodeChangeActionModified {
+		t.Errorf("unexpected change #0: %+v", changes[0])
+	} else if len(changes[0].Fields) != 2 || changes[0].Fields[0].Field != "name" || changes[0].Fields[1].Field != "config.providerConfig" {
+		t.Errorf("unexpected fields of change #0: %+v", changes[0].Fields)
+	}
+
+	if changes[1].NodeId != "notify" || changes[1].Action != nodeChangeActionAdded {
+		t.Errorf("unexpected change #1: %+v", changes[1])
+	}
+
+	reverse := diffWorkflowNodes(to, from)
+	if len(reverse) != 2 || reverse[1].NodeId != "notify" || reverse[1].Action != nodeChangeActionRemoved {
+		t.Errorf("unexpected reverse changes: %+v", reverse)
+	}
+}
@@ -9,11 +9,15 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/certimate-go/certimate/internal/app"
 	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/notify"
+	nodes "github.com/certimate-go/certimate/internal/workflow/node-processor"
+	"github.com/certimate-go/certimate/pkg/core"
 	xslices "github.com/certimate-go/certimate/pkg/utils/slices"
 )
 
@@ -60,6 +64,9 @@ type WorkflowDispatcher struct {
 	workflowRepo    workflowRepository
 	workflowRunRepo workflowRunRepository
 	workflowLogRepo workflowLogRepository
+
+	dispatchEvent   func(ctx context.Context, event *notify.Event) (bool, error)
+	notifyRecovered func(ctx context.Context, workflowId string, workflowContent *domain.WorkflowNode, succeededNodeIds []string) error
 }
 
 func newWorkflowDispatcher(workflowRepo workflowRepository, workflowRunRepo workflowRunRepository, workflowLogRepo workflowLogRepository) *WorkflowDispatcher {
@@ -79,6 +86,9 @@ func newWorkflowDispatcher(workflowRepo workflowRepository, workflowRunRepo work
 		workflowRepo:    workflowRepo,
 		workflowRunRepo: workflowRunRepo,
 		workflowLogRepo: workflowLogRepo,
+
+		dispatchEvent:   notify.Dispatch,
+		notifyRecovered: notify.NotifyRecovered,
 	}
 
 	go func() {
@@ -134,7 +144,7 @@ func (d *WorkflowDispatcher) Cancel(runId string) {
 	// 已挂起，查询 WorkflowRun 并更新其状态为 Canceled
 	if !hasWorker {
 		if run, err := d.workflowRunRepo.GetById(context.Background(), runId); err == nil {
-			if run.Status == domain.WorkflowRunStatusTypePending || run.Status == domain.WorkflowRunStatusTypeRunning {
+			if run.Status == domain.WorkflowRunStatusTypePending || run.Status == domain.WorkflowRunStatusTypeRunning || run.Status == domain.WorkflowRunStatusTypeSuspended {
 				run.Status = domain.WorkflowRunStatusTypeCanceled
 				d.workflowRunRepo.Save(context.Background(), run)
 			}
@@ -270,36 +280,117 @@ func (d *WorkflowDispatcher) work(ctx context.Context, data *WorkflowWorkerData)
 	}
 
 	// 执行工作流
-	invoker := newWorkflowInvokerWithData(d.workflowLogRepo, data)
-	if runErr := invoker.Invoke(ctx); runErr != nil {
-		if errors.Is(runErr, context.Canceled) {
+	invoker := newWorkflowInvokerWithData(d.workflowLogRepo, data, run.Checkpoint)
+	runErr := invoker.Invoke(ctx)
+
+	// 更新 WorkflowRun 状态为 Suspended/Canceled/Succeeded/Failed
+	run.Checkpoint = invoker.GetCheckpoint()
+	if runErr != nil {
+		if nodes.IsSuspendError(runErr) {
+			// 挂起工作流，保存执行断点后释放工作槽位，等待外部信号或调度器恢复执行
+			run.Status = domain.WorkflowRunStatusTypeSuspended
+		} else if errors.Is(runErr, context.Canceled) {
 			run.Status = domain.WorkflowRunStatusTypeCanceled
 		} else {
 			run.Status = domain.WorkflowRunStatusTypeFailed
 			run.EndedAt = time.Now()
 			run.Error = runErr.Error()
 		}
+	} else {
+		run.EndedAt = time.Now()
+		run.Error = invoker.GetLogs().ErrorString()
+		if run.Error == "" {
+			run.Status = domain.WorkflowRunStatusTypeSucceeded
+		} else {
+			run.Status = domain.WorkflowRunStatusTypeFailed
+		}
+	}
+	if _, err := d.workflowRunRepo.Save(ctx, run); err != nil {
+		if !(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+			panic(err)
+		}
+	}
 
-		if _, err := d.workflowRunRepo.Save(ctx, run); err != nil {
-			if !(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
-				panic(err)
-			}
+	// 挂起的工作流尚未结束，待恢复执行完成后再发送通知
+	if run.Status == domain.WorkflowRunStatusTypeSuspended {
+		return
+	}
+
+	go d.dispatchRunEvent(data, run)
+	go d.notifyRecoveredNodes(data, run)
+}
+
+func (d *WorkflowDispatcher) notifyRecoveredNodes(data *WorkflowWorkerData, run *domain.WorkflowRun) {
+	if run.Checkpoint == nil {
+		return
+	}
+
+	succeededNodeIds := make([]string, 0, len(run.Checkpoint.Nodes))
+	for nodeId, state := range run.Checkpoint.Nodes {
+		if state.Error == "" {
+			succeededNodeIds = append(succeededNodeIds, nodeId)
 		}
+	}
+
+	if err := d.notifyRecovered(context.Background(), data.WorkflowId, data.WorkflowContent, succeededNodeIds); err != nil {
+		app.GetLogger().Error(fmt.Sprintf("failed to send recovered notification of workflow run #%s", run.Id), "err", err)
+	}
+}
+
+func (d *WorkflowDispatcher) dispatchRunEvent(data *WorkflowWorkerData, run *domain.WorkflowRun) {
+	ctx := context.Background()
 
+	workflow, err := d.workflowRepo.GetById(ctx, data.WorkflowId)
+	if err != nil {
+		app.GetLogger().Error(fmt.Sprintf("failed to get workflow #%s", data.WorkflowId), "err", err)
 		return
 	}
 
-	// 更新 WorkflowRun 状态为 Succeeded/Failed
-	run.EndedAt = time.Now()
-	run.Error = invoker.GetLogs().ErrorString()
-	if run.Error == "" {
-		run.Status = domain.WorkflowRunStatusTypeSucceeded
-	} else {
-		run.Status = domain.WorkflowRunStatusTypeFailed
+	event := &notify.Event{
+		WorkflowId:    workflow.Id,
+		WorkflowRunId: run.Id,
+		Tags:          workflow.Tags,
+		Domains:       collectWorkflowDomains(data.WorkflowContent),
+		Message: &core.NotifyMessage{
+			Fields: []core.NotifyMessageField{
+				{Name: "Workflow", Value: workflow.Name, Inline: true},
+				{Name: "Run", Value: run.Id, Inline: true},
+			},
+		},
 	}
-	if _, err := d.workflowRunRepo.Save(ctx, run); err != nil {
-		if !(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
-			panic(err)
+	switch run.Status {
+	case domain.WorkflowRunStatusTypeSucceeded:
+		event.Type = domain.NotificationEventTypeWorkflowSucceeded
+		event.Message.Subject = fmt.Sprintf("工作流「%s」执行成功", workflow.Name)
+		event.Message.Severity = core.NotifySeveritySuccess
+		event.Message.Body = event.Message.Subject
+
+	case domain.WorkflowRunStatusTypeFailed:
+		event.Type = domain.NotificationEventTypeWorkflowFailed
+		event.Message.Subject = fmt.Sprintf("工作流「%s」执行失败", workflow.Name)
+		event.Message.Severity = core.NotifySeverityError
+		event.Message.Body = run.Error
+
+	default:
+		return
+	}
+
+	if _, err := d.dispatchEvent(ctx, event); err != nil {
+		app.GetLogger().Error(fmt.Sprintf("failed to dispatch notification event of workflow run #%s", run.Id), "err", err)
+	}
+}
+
+func collectWorkflowDomains(node *domain.WorkflowNode) []string {
+	domains := make([]string, 0)
+	for ; node != nil; node = node.Next {
+		if node.Type == domain.WorkflowNodeTypeApply {
+			domains = append(domains, xslices.Filter(strings.Split(node.GetConfigForApply().Domains, ";"), func(s string) bool { return s != "" })...)
+		}
+
+		for i := range node.Branches {
+			domains = append(domains, collectWorkflowDomains(&node.Branches[i])...)
 		}
 	}
+
+	return domains
 }
@@ -0,0 +1,131 @@
+package dispatcher
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/notify"
+)
+
+type mockWorkflowRepository struct {
+	workflows map[string]*domain.Workflow
+}
+
+func (r *mockWorkflowRepository) GetById(ctx context.Context, id string) (*domain.Workflow, error) {
+	if workflow, ok := r.workflows[id]; ok {
+		return workflow, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockWorkflowRepository) Save(ctx context.Context, workflow *domain.Workflow) (*domain.Workflow, error) {
+	r.workflows[workflow.Id] = workflow
+	return workflow, nil
+}
+
+type mockWorkflowRunRepository struct {
+	mutex sync.Mutex
+	runs  map[string]*domain.WorkflowRun
+}
+
+func (r *mockWorkflowRunRepository) GetById(ctx context.Context, id string) (*domain.WorkflowRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if run, ok := r.runs[id]; ok {
+		return run, nil
+	}
+	return nil, domain.ErrRecordNotFound
+}
+
+func (r *mockWorkflowRunRepository) Save(ctx context.Context, run *domain.WorkflowRun) (*domain.WorkflowRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.runs[run.Id] = run
+	return run, nil
+}
+
+type mockWorkflowLogRepository struct{}
+
+func (r *mockWorkflowLogRepository) ListByWorkflowRunId(ctx context.Context, workflowRunId string) ([]*domain.WorkflowLog, error) {
+	return nil, nil
+}
+
+func (r *mockWorkflowLogRepository) Save(ctx context.Context, log *domain.WorkflowLog) (*domain.WorkflowLog, error) {
+	return log, nil
+}
+
+func TestWorkflowDispatcher_work_FailedNodeFollowedByAnotherNode(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	content := &domain.WorkflowNode{
+		Id:     "start",
+		Type:   domain.WorkflowNodeTypeStart,
+		Config: map[string]any{"trigger": "manual"},
+		Next: &domain.WorkflowNode{
+			Id:     "failing",
+			Type:   domain.WorkflowNodeTypeHttpRequest,
+			Config: map[string]any{"url": "ftp://example.com"},
+			Next: &domain.WorkflowNode{
+				Id:     "following",
+				Type:   domain.WorkflowNodeTypeHttpRequest,
+				Config: map[string]any{"url": server.URL},
+			},
+		},
+	}
+
+	runRepo := &mockWorkflowRunRepository{
+		runs: map[string]*domain.WorkflowRun{
+			"r1": {Meta: domain.Meta{Id: "r1"}, WorkflowId: "wf1", Status: domain.WorkflowRunStatusTypePending},
+		},
+	}
+	events := make(chan *notify.Event, 1)
+	d := &WorkflowDispatcher{
+		semaphore:   make(chan struct{}, 1),
+		workers:     make(map[string]*workflowWorker),
+		workerIdMap: make(map[string]string),
+
+		workflowRepo:    &mockWorkflowRepository{workflows: map[string]*domain.Workflow{"wf1": {Meta: domain.Meta{Id: "wf1"}, Name: "test"}}},
+		workflowRunRepo: runRepo,
+		workflowLogRepo: &mockWorkflowLogRepository{},
+
+		dispatchEvent: func(ctx context.Context, event *notify.Event) (bool, error) {
+			events <- event
+			return true, nil
+		},
+		notifyRecovered: func(ctx context.Context, workflowId string, workflowContent *domain.WorkflowNode, succeededNodeIds []string) error {
+			return nil
+		},
+	}
+
+	d.semaphore <- struct{}{}
+	d.wg.Add(1)
+	d.work(context.Background(), &WorkflowWorkerData{WorkflowId: "wf1", WorkflowContent: content, RunId: "r1"})
+
+	run, _ := runRepo.GetById(context.Background(), "r1")
+	if run.Status != domain.WorkflowRunStatusTypeFailed || run.Error == "" {
+		t.Errorf("expected run to be failed, got status '%s' with error '%s'", run.Status, run.Error)
+	}
+	if requested {
+		t.Errorf("expected the node following the failed node not to be executed")
+	}
+
+	select {
+	case event := <-events:
+		if event.Type != domain.NotificationEventTypeWorkflowFailed || event.WorkflowRunId != "r1" {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected workflow failed event to be dispatched")
+	}
+}
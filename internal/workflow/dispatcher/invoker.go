@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/certimate-go/certimate/internal/domain"
 	nodes "github.com/certimate-go/certimate/internal/workflow/node-processor"
@@ -14,20 +15,28 @@ type workflowInvoker struct {
 	workflowId      string
 	workflowContent *domain.WorkflowNode
 	runId           string
+	checkpoint      *domain.WorkflowRunCheckpoint
 	logs            []domain.WorkflowLog
 
 	workflowLogRepo workflowLogRepository
 }
 
-func newWorkflowInvokerWithData(workflowLogRepo workflowLogRepository, data *WorkflowWorkerData) *workflowInvoker {
+func newWorkflowInvokerWithData(workflowLogRepo workflowLogRepository, data *WorkflowWorkerData, checkpoint *domain.WorkflowRunCheckpoint) *workflowInvoker {
 	if data == nil {
 		panic("worker data is nil")
 	}
 
+	if checkpoint == nil {
+		checkpoint = domain.NewWorkflowRunCheckpoint()
+	} else if checkpoint.Nodes == nil {
+		checkpoint.Nodes = make(map[string]*domain.WorkflowRunCheckpointNode)
+	}
+
 	return &workflowInvoker{
 		workflowId:      data.WorkflowId,
 		workflowContent: data.WorkflowContent,
 		runId:           data.RunId,
+		checkpoint:      checkpoint,
 		logs:            make([]domain.WorkflowLog, 0),
 
 		workflowLogRepo: workflowLogRepo,
@@ -37,6 +46,19 @@ func newWorkflowInvokerWithData(workflowLogRepo workflowLogRepository, data *Wor
 func (w *workflowInvoker) Invoke(ctx context.Context) error {
 	ctx = context.WithValue(ctx, "workflow_id", w.workflowId)
 	ctx = context.WithValue(ctx, "workflow_run_id", w.runId)
+
+	// 从断点恢复执行时，加载挂起前的日志
+	if len(w.checkpoint.Nodes) > 0 || w.checkpoint.Suspension != nil {
+		logs, err := w.workflowLogRepo.ListByWorkflowRunId(ctx, w.runId)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			w.logs = append(w.logs, *log)
+		}
+	}
+
 	return w.processNode(ctx, w.workflowContent)
 }
 
@@ -44,6 +66,10 @@ func (w *workflowInvoker) GetLogs() domain.WorkflowLogs {
 	return w.logs
 }
 
+func (w *workflowInvoker) GetCheckpoint() *domain.WorkflowRunCheckpoint {
+	return w.checkpoint
+}
+
 func (w *workflowInvoker) processNode(ctx context.Context, node *domain.WorkflowNode) error {
 	current := node
 	for current != nil {
@@ -57,7 +83,8 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 			for _, branch := range current.Branches {
 				if err := w.processNode(ctx, &branch); err != nil {
 					// 并行分支的某一分支发生错误时，忽略此错误，继续执行其他分支
-					if !(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+					// 但如果是被取消或挂起，则直接返回
+					if !(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || nodes.IsSuspendError(err)) {
 						continue
 					}
 					return err
@@ -69,6 +96,17 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 		var procErr error
 		for {
 			if current.Type != domain.WorkflowNodeTypeBranch && current.Type != domain.WorkflowNodeTypeExecuteResultBranch {
+				// 节点已在挂起前执行完成，直接还原其执行结果
+				if state, ok := w.checkpoint.Nodes[current.Id]; ok {
+					if len(state.Outputs) > 0 {
+						ctx = nodes.AddNodeOutput(ctx, current.Id, state.Outputs)
+					}
+					if state.Error != "" {
+						procErr = errors.New(state.Error)
+					}
+					break
+				}
+
 				processor, procErr = nodes.GetProcessor(current)
 				if procErr != nil {
 					panic(procErr)
@@ -96,15 +134,27 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 					},
 				})))
 
-				procErr = processor.Process(ctx)
+				procErr = processor.Process(nodes.WithNodeSuspension(ctx, w.checkpoint.Suspension))
+				if procErr != nil && nodes.IsSuspendError(procErr) {
+					return w.suspend(current, procErr)
+				}
+
+				nodeOutputs := processor.GetOutputs()
+				nodeState := &domain.WorkflowRunCheckpointNode{Outputs: nodeOutputs}
+				if w.checkpoint.Suspension != nil && w.checkpoint.Suspension.NodeId == current.Id {
+					w.checkpoint.Suspension = nil
+				}
 				if procErr != nil {
+					nodeState.Error = procErr.Error()
+					w.checkpoint.Nodes[current.Id] = nodeState
+
 					if current.Type != domain.WorkflowNodeTypeCondition {
 						processor.GetLogger().Error(procErr.Error())
 					}
 					break
 				}
 
-				nodeOutputs := processor.GetOutputs()
+				w.checkpoint.Nodes[current.Id] = nodeState
 				if len(nodeOutputs) > 0 {
 					ctx = nodes.AddNodeOutput(ctx, current.Id, nodeOutputs)
 				}
@@ -121,6 +171,7 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 		} else if procErr != nil && current.Next != nil && current.Next.Type != domain.WorkflowNodeTypeExecuteResultBranch {
 			return procErr
 		} else if procErr != nil && current.Next != nil && current.Next.Type == domain.WorkflowNodeTypeExecuteResultBranch {
+			ctx = nodes.WithNodeFailure(ctx, current, procErr)
 			current = w.getBranchByType(current.Next.Branches, domain.WorkflowNodeTypeExecuteFailure)
 		} else if procErr == nil && current.Next != nil && current.Next.Type == domain.WorkflowNodeTypeExecuteResultBranch {
 			current = w.getBranchByType(current.Next.Branches, domain.WorkflowNodeTypeExecuteSuccess)
@@ -132,6 +183,25 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 	return nil
 }
 
+func (w *workflowInvoker) suspend(node *domain.WorkflowNode, err error) error {
+	var suspendErr *nodes.SuspendError
+	errors.As(err, &suspendErr)
+
+	suspension := w.checkpoint.Suspension
+	if suspension == nil || suspension.NodeId != node.Id {
+		suspension = &domain.WorkflowRunSuspension{
+			NodeId:      node.Id,
+			NodeType:    node.Type,
+			SuspendedAt: time.Now(),
+		}
+	}
+	suspension.ResumeAt = suspendErr.ResumeAt
+	suspension.Signal = nil
+	w.checkpoint.Suspension = suspension
+
+	return err
+}
+
 func (w *workflowInvoker) getBranchByType(branches []domain.WorkflowNode, nodeType domain.WorkflowNodeType) *domain.WorkflowNode {
 	for _, branch := range branches {
 		if branch.Type == nodeType {
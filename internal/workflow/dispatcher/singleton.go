@@ -19,6 +19,7 @@ type workflowRunRepository interface {
 }
 
 type workflowLogRepository interface {
+	ListByWorkflowRunId(ctx context.Context, workflowRunId string) ([]*domain.WorkflowLog, error)
 	Save(ctx context.Context, workflowLog *domain.WorkflowLog) (*domain.WorkflowLog, error)
 }
 
@@ -23,6 +23,10 @@ func Register() {
 			return err
 		}
 
+		if err := onWorkflowRecordContentChange(e.Request.Context(), e.Record, e.Auth); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	app.OnRecordUpdateRequest(domain.CollectionNameWorkflow).BindFunc(func(e *core.RecordRequestEvent) error {
@@ -34,6 +38,10 @@ func Register() {
 			return err
 		}
 
+		if err := onWorkflowRecordContentChange(e.Request.Context(), e.Record, e.Auth); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	app.OnRecordDeleteRequest(domain.CollectionNameWorkflow).BindFunc(func(e *core.RecordRequestEvent) error {
@@ -65,7 +73,7 @@ func onWorkflowRecordCreateOrUpdate(ctx context.Context, record *core.Record) er
 
 	// 反之，重新添加定时任务
 	err := scheduler.Add(fmt.Sprintf("workflow#%s", workflowId), record.GetString("triggerCron"), func() {
-		workflowSrv := NewWorkflowService(repository.NewWorkflowRepository(), repository.NewWorkflowRunRepository(), repository.NewSettingsRepository())
+		workflowSrv := NewWorkflowService(repository.NewWorkflowRepository(), repository.NewWorkflowRunRepository(), repository.NewWorkflowVersionRepository(), repository.NewSettingsRepository())
 		workflowSrv.StartRun(ctx, &dtos.WorkflowStartRunReq{
 			WorkflowId: workflowId,
 			RunTrigger: domain.WorkflowTriggerTypeAuto,
@@ -87,3 +95,18 @@ func onWorkflowRecordDelete(_ context.Context, record *core.Record) error {
 
 	return nil
 }
+
+func onWorkflowRecordContentChange(ctx context.Context, record *core.Record, auth *core.Record) error {
+	// 工作流发布后，如内容有变化则生成新的版本
+	author := ""
+	if auth != nil {
+		author = auth.Email()
+	}
+
+	workflowSrv := NewWorkflowService(repository.NewWorkflowRepository(), repository.NewWorkflowRunRepository(), repository.NewWorkflowVersionRepository(), repository.NewSettingsRepository())
+	if err := workflowSrv.SnapshotVersion(ctx, record.Id, author); err != nil {
+		return fmt.Errorf("snapshot workflow version failed: %w", err)
+	}
+
+	return nil
+}
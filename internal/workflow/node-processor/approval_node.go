@@ -0,0 +1,79 @@
+package nodeprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	xmaps "github.com/certimate-go/certimate/pkg/utils/maps"
+)
+
+const (
+	ApprovalSignalKeyApproved = "approved"
+	ApprovalSignalKeyOperator = "operator"
+	ApprovalSignalKeyComment  = "comment"
+)
+
+type approvalNode struct {
+	node *domain.WorkflowNode
+	*nodeProcessor
+	*nodeOutputer
+}
+
+func NewApprovalNode(node *domain.WorkflowNode) *approvalNode {
+	return &approvalNode{
+		node:          node,
+		nodeProcessor: newNodeProcessor(node),
+		nodeOutputer:  newNodeOutputer(),
+	}
+}
+
+func (n *approvalNode) Process(ctx context.Context) error {
+	nodeCfg := n.node.GetConfigForApproval()
+
+	// 首次执行，挂起工作流并等待审批
+	suspension := getContextNodeSuspension(ctx, n.node.Id)
+	if suspension == nil {
+		n.logger.Info("ready to wait for approval ...", slog.Any("config", nodeCfg))
+
+		resumeAt := time.Time{}
+		if nodeCfg.Timeout > 0 {
+			resumeAt = time.Now().Add(time.Duration(nodeCfg.Timeout) * time.Minute)
+			n.logger.Info(fmt.Sprintf("the approval will expire at %s", resumeAt.Format(time.RFC3339)))
+		}
+
+		return &SuspendError{Reason: "waiting for approval", ResumeAt: resumeAt}
+	}
+
+	// 已收到审批结果
+	if suspension.Signal != nil {
+		approved := xmaps.GetBool(suspension.Signal, ApprovalSignalKeyApproved)
+		operator := xmaps.GetString(suspension.Signal, ApprovalSignalKeyOperator)
+		comment := xmaps.GetString(suspension.Signal, ApprovalSignalKeyComment)
+		n.outputs[outputKeyForApprovalApproved] = strconv.FormatBool(approved)
+		n.outputs[outputKeyForApprovalOperator] = operator
+
+		if !approved {
+			n.logger.Warn(fmt.Sprintf("the approval was rejected by '%s'", operator), slog.String("comment", comment))
+			return errors.New("approval rejected")
+		}
+
+		n.logger.Info(fmt.Sprintf("the approval was approved by '%s'", operator), slog.String("comment", comment))
+		n.logger.Info("approval completed")
+		return nil
+	}
+
+	// 审批超时，自动驳回
+	if !suspension.ResumeAt.IsZero() && !time.Now().Before(suspension.ResumeAt) {
+		n.outputs[outputKeyForApprovalApproved] = strconv.FormatBool(false)
+		n.logger.Warn("the approval has expired, auto rejected")
+		return errors.New("approval expired")
+	}
+
+	// 尚未审批，继续挂起
+	return &SuspendError{Reason: "waiting for approval", ResumeAt: suspension.ResumeAt}
+}
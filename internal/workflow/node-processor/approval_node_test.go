@@ -0,0 +1,59 @@
+package nodeprocessor
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+func Test_ApprovalNode(t *testing.T) {
+	node := &domain.WorkflowNode{
+		Id:   "test",
+		Type: domain.WorkflowNodeTypeApproval,
+		Name: "test",
+		Config: map[string]any{
+			"timeout": 60,
+		},
+	}
+
+	t.Run("Suspend", func(t *testing.T) {
+		processor := NewApprovalNode(node)
+		processor.SetLogger(slog.Default())
+		if err := processor.Process(context.Background()); !IsSuspendError(err) {
+			t.Errorf("expected suspend error, got %+v", err)
+		}
+	})
+
+	t.Run("Approved", func(t *testing.T) {
+		processor := NewApprovalNode(node)
+		processor.SetLogger(slog.Default())
+		ctx := WithNodeSuspension(context.Background(), &domain.WorkflowRunSuspension{
+			NodeId:   node.Id,
+			NodeType: node.Type,
+			ResumeAt: time.Now().Add(time.Hour),
+			Signal: map[string]any{
+				ApprovalSignalKeyApproved: true,
+				ApprovalSignalKeyOperator: "admin@example.com",
+			},
+		})
+		if err := processor.Process(ctx); err != nil {
+			t.Errorf("err: %+v", err)
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		processor := NewApprovalNode(node)
+		processor.SetLogger(slog.Default())
+		ctx := WithNodeSuspension(context.Background(), &domain.WorkflowRunSuspension{
+			NodeId:   node.Id,
+			NodeType: node.Type,
+			ResumeAt: time.Now().Add(-time.Minute),
+		})
+		if err := processor.Process(ctx); err == nil || IsSuspendError(err) {
+			t.Errorf("expected rejection error, got %+v", err)
+		}
+	})
+}
@@ -4,4 +4,17 @@ const (
 	outputKeyForCertificateValidity = "certificate.validity"
 	outputKeyForCertificateDaysLeft = "certificate.daysLeft"
 	outputKeyForNodeSkipped         = "node.skipped"
+	outputKeyForApprovalApproved    = "approval.approved"
+	outputKeyForApprovalOperator    = "approval.operator"
+	outputKeyForHttpStatusCode      = "http.statusCode"
 )
+
+// 内置的节点输出名称，不允许被用户自定义的输出覆盖。
+var reservedOutputKeys = []string{
+	outputKeyForCertificateValidity,
+	outputKeyForCertificateDaysLeft,
+	outputKeyForNodeSkipped,
+	outputKeyForApprovalApproved,
+	outputKeyForApprovalOperator,
+	outputKeyForHttpStatusCode,
+}
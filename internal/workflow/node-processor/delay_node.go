@@ -0,0 +1,117 @@
+package nodeprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+type delayNode struct {
+	node *domain.WorkflowNode
+	*nodeProcessor
+	*nodeOutputer
+}
+
+func NewDelayNode(node *domain.WorkflowNode) *delayNode {
+	return &delayNode{
+		node:          node,
+		nodeProcessor: newNodeProcessor(node),
+		nodeOutputer:  newNodeOutputer(),
+	}
+}
+
+func (n *delayNode) Process(ctx context.Context) error {
+	nodeCfg := n.node.GetConfigForDelay()
+
+	suspension := getContextNodeSuspension(ctx, n.node.Id)
+	if suspension == nil {
+		n.logger.Info("ready to wait ...", slog.Any("config", nodeCfg))
+	}
+
+	now := time.Now()
+	switch nodeCfg.Mode {
+	case domain.WorkflowNodeDelayModeDuration:
+		{
+			if nodeCfg.Duration <= 0 {
+				n.logger.Info("the duration is zero, continue immediately")
+				return nil
+			}
+
+			if suspension == nil {
+				resumeAt := now.Add(time.Duration(nodeCfg.Duration) * time.Minute)
+				n.logger.Info(fmt.Sprintf("the workflow will continue at %s", resumeAt.Format(time.RFC3339)))
+				return &SuspendError{Reason: "waiting for delay", ResumeAt: resumeAt}
+			}
+
+			if now.Before(suspension.ResumeAt) {
+				return &SuspendError{Reason: "waiting for delay", ResumeAt: suspension.ResumeAt}
+			}
+		}
+
+	case domain.WorkflowNodeDelayModeWindow:
+		{
+			location := time.Local
+			if nodeCfg.Timezone != "" {
+				loc, err := time.LoadLocation(nodeCfg.Timezone)
+				if err != nil {
+					return fmt.Errorf("invalid timezone '%s': %w", nodeCfg.Timezone, err)
+				}
+				location = loc
+			}
+
+			inWindow, nextStart, err := evalTimeWindow(now.In(location), nodeCfg.WindowStart, nodeCfg.WindowEnd)
+			if err != nil {
+				return err
+			}
+
+			// 不在时间窗口内（包括恢复时已错过时间窗口的情况），挂起至下一个时间窗口
+			if !inWindow {
+				n.logger.Info(fmt.Sprintf("out of the time window, the workflow will continue at %s", nextStart.Format(time.RFC3339)))
+				return &SuspendError{Reason: "waiting for time window", ResumeAt: nextStart}
+			}
+		}
+
+	default:
+		return fmt.Errorf("unsupported delay mode: %s", nodeCfg.Mode)
+	}
+
+	n.logger.Info("waiting completed")
+	return nil
+}
+
+// 判断指定时间是否在每日时间窗口内，并返回下一个时间窗口的开始时间。
+// 时间窗口支持跨越零点，如 "22:00" 至 "02:00"。
+func evalTimeWindow(now time.Time, windowStart, windowEnd string) (_inWindow bool, _nextStart time.Time, _err error) {
+	startClock, err := time.Parse("15:04", windowStart)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid time window start '%s'", windowStart)
+	}
+
+	endClock, err := time.Parse("15:04", windowEnd)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid time window end '%s'", windowEnd)
+	}
+
+	startMinutes := startClock.Hour()*60 + startClock.Minute()
+	endMinutes := endClock.Hour()*60 + endClock.Minute()
+	nowMinutes := now.Hour()*60 + now.Minute()
+
+	var inWindow bool
+	if startMinutes == endMinutes {
+		inWindow = true
+	} else if startMinutes < endMinutes {
+		inWindow = nowMinutes >= startMinutes && nowMinutes < endMinutes
+	} else {
+		inWindow = nowMinutes >= startMinutes || nowMinutes < endMinutes
+	}
+
+	nextStart := time.Date(now.Year(), now.Month(), now.Day(), startClock.Hour(), startClock.Minute(), 0, 0, now.Location())
+	if !nextStart.After(now) {
+		nextStart = nextStart.AddDate(0, 0, 1)
+	}
+
+	return inWindow, nextStart, nil
+}
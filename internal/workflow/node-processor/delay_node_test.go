@@ -0,0 +1,40 @@
+package nodeprocessor
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_evalTimeWindow(t *testing.T) {
+	tests := []struct {
+		name          string
+		now           string
+		windowStart   string
+		windowEnd     string
+		wantInWindow  bool
+		wantNextStart string
+	}{
+		{"before window", "2025-07-01T01:30:00Z", "02:00", "04:00", false, "2025-07-01T02:00:00Z"},
+		{"in window", "2025-07-01T03:00:00Z", "02:00", "04:00", true, "2025-07-02T02:00:00Z"},
+		{"after window", "2025-07-01T04:00:00Z", "02:00", "04:00", false, "2025-07-02T02:00:00Z"},
+		{"cross midnight, before midnight", "2025-07-01T23:00:00Z", "22:00", "02:00", true, "2025-07-02T22:00:00Z"},
+		{"cross midnight, after midnight", "2025-07-01T01:00:00Z", "22:00", "02:00", true, "2025-07-01T22:00:00Z"},
+		{"cross midnight, out of window", "2025-07-01T12:00:00Z", "22:00", "02:00", false, "2025-07-01T22:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now, _ := time.Parse(time.RFC3339, tt.now)
+			inWindow, nextStart, err := evalTimeWindow(now, tt.windowStart, tt.windowEnd)
+			if err != nil {
+				t.Fatalf("err: %+v", err)
+			}
+			if inWindow != tt.wantInWindow {
+				t.Errorf("inWindow = %v, want %v", inWindow, tt.wantInWindow)
+			}
+			if nextStart.Format(time.RFC3339) != tt.wantNextStart {
+				t.Errorf("nextStart = %v, want %v", nextStart.Format(time.RFC3339), tt.wantNextStart)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package nodeprocessor
+
+import (
+	"context"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+const (
+	nodeFailureKey workflowContextKey = "node_failure"
+)
+
+// 执行失败的节点信息
+type nodeFailure struct {
+	NodeId   string
+	NodeName string
+	Error    string
+}
+
+// 附加执行失败的节点信息到上下文，以供其失败分支中的节点使用
+func WithNodeFailure(ctx context.Context, node *domain.WorkflowNode, err error) context.Context {
+	return context.WithValue(ctx, nodeFailureKey, &nodeFailure{
+		NodeId:   node.Id,
+		NodeName: node.Name,
+		Error:    err.Error(),
+	})
+}
+
+// 从上下文获取执行失败的节点信息（不在失败分支中时返回 nil）
+func getContextNodeFailure(ctx context.Context) *nodeFailure {
+	value := ctx.Value(nodeFailureKey)
+	if value == nil {
+		return nil
+	}
+
+	return value.(*nodeFailure)
+}
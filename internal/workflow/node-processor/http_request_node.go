@@ -0,0 +1,269 @@
+package nodeprocessor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	xhttp "github.com/certimate-go/certimate/pkg/utils/http"
+)
+
+// 响应正文的最大读取长度。
+const maxHttpResponseBodySize = 10 << 20
+
+type httpRequestNode struct {
+	node *domain.WorkflowNode
+	*nodeProcessor
+	*nodeOutputer
+}
+
+func NewHttpRequestNode(node *domain.WorkflowNode) *httpRequestNode {
+	return &httpRequestNode{
+		node:          node,
+		nodeProcessor: newNodeProcessor(node),
+		nodeOutputer:  newNodeOutputer(),
+	}
+}
+
+func (n *httpRequestNode) Process(ctx context.Context) error {
+	nodeCfg := n.node.GetConfigForHttpRequest()
+
+	// 处理请求地址
+	reqUrl, err := url.Parse(renderTemplate(ctx, nodeCfg.Url))
+	if err != nil {
+		return fmt.Errorf("failed to parse request url: %w", err)
+	} else if reqUrl.Scheme != "http" && reqUrl.Scheme != "https" {
+		return fmt.Errorf("unsupported request url scheme '%s'", reqUrl.Scheme)
+	}
+
+	// 处理请求谓词
+	reqMethod := strings.ToUpper(nodeCfg.Method)
+	if reqMethod == "" {
+		reqMethod = http.MethodGet
+	}
+
+	// 请求标头与正文中可能包含凭据，日志中仅记录请求谓词与地址
+	n.logger.Info("ready to send http request ...", slog.String("method", reqMethod), slog.String("url", reqUrl.Redacted()))
+
+	// 检查输出名称，避免覆盖内置输出
+	for name := range nodeCfg.Extractions {
+		if slices.Contains(reservedOutputKeys, name) {
+			return fmt.Errorf("output name '%s' is reserved", name)
+		}
+	}
+
+	// 处理请求标头
+	reqHeaders, err := xhttp.ParseHeaders(renderTemplate(ctx, nodeCfg.Headers))
+	if err != nil {
+		return fmt.Errorf("failed to parse request headers: %w", err)
+	}
+
+	// 处理期望的响应状态码
+	expectedStatusCodes := make([]int, 0)
+	for _, s := range strings.Split(nodeCfg.ExpectedStatusCodes, ";") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid expected status code '%s'", s)
+		}
+		expectedStatusCodes = append(expectedStatusCodes, code)
+	}
+
+	// 生成请求
+	var reqBody io.Reader
+	if nodeCfg.Body != "" {
+		reqBody = strings.NewReader(renderTemplateWithEscaper(ctx, nodeCfg.Body, getBodyTemplateEscaper(reqHeaders.Get("Content-Type"))))
+	}
+	req, err := http.NewRequestWithContext(ctx, reqMethod, reqUrl.String(), reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create http request: %w", err)
+	}
+	req.Header = reqHeaders
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", "certimate")
+	}
+
+	// 发送请求
+	client, err := n.createHttpClient(nodeCfg)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxHttpResponseBodySize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read http response: %w", err)
+	} else if len(respBody) > maxHttpResponseBodySize {
+		return fmt.Errorf("http response body exceeds the limit of %d bytes", maxHttpResponseBodySize)
+	}
+
+	n.outputs[outputKeyForHttpStatusCode] = strconv.Itoa(resp.StatusCode)
+	n.logger.Debug("http request responded", slog.Int("statusCode", resp.StatusCode), slog.Int("bodySize", len(respBody)))
+
+	// 检查响应状态码
+	if len(expectedStatusCodes) == 0 {
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("unexpected http response status code: %d", resp.StatusCode)
+		}
+	} else {
+		matched := false
+		for _, code := range expectedStatusCodes {
+			if code == resp.StatusCode {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("unexpected http response status code: %d", resp.StatusCode)
+		}
+	}
+
+	// 从响应中提取输出
+	if len(nodeCfg.Extractions) > 0 {
+		var respData any
+		if err := json.Unmarshal(respBody, &respData); err != nil {
+			return fmt.Errorf("failed to unmarshal http response: %w", err)
+		}
+
+		for name, path := range nodeCfg.Extractions {
+			value, err := evalJSONPath(respData, path)
+			if err != nil {
+				return fmt.Errorf("failed to extract output '%s': %w", name, err)
+			}
+
+			switch v := value.(type) {
+			case string:
+				n.outputs[name] = v
+			case nil:
+				n.outputs[name] = ""
+			case bool, float64:
+				n.outputs[name] = fmt.Sprintf("%v", v)
+			default:
+				jsonb, _ := json.Marshal(v)
+				n.outputs[name] = string(jsonb)
+			}
+		}
+	}
+
+	n.logger.Info("http request completed")
+	return nil
+}
+
+// 根据请求正文的内容类型，返回模板变量值的转义函数。
+// JSON 正文中的变量值按 JSON 字符串转义（不含两侧引号），表单正文中的变量值按 URL 编码转义，其余类型不转义。
+func getBodyTemplateEscaper(contentType string) func(string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return func(s string) string {
+			jsonb, _ := json.Marshal(s)
+			return string(jsonb[1 : len(jsonb)-1])
+		}
+
+	case mediaType == "application/x-www-form-urlencoded":
+		return url.QueryEscape
+	}
+
+	return nil
+}
+
+func (n *httpRequestNode) createHttpClient(nodeCfg domain.WorkflowNodeConfigForHttpRequest) (*http.Client, error) {
+	transport := xhttp.NewDefaultTransport()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = nodeCfg.AllowInsecureConnections
+	if nodeCfg.CACertificate != "" {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(nodeCfg.CACertificate)) {
+			return nil, errors.New("failed to parse ca certificate")
+		}
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	timeout := time.Duration(nodeCfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+	return client, nil
+}
+
+var jsonPathTokenRegexp = regexp.MustCompile(`\.([^.\[\]]+)|\[(-?\d+)\]|\['([^']*)'\]|\["([^"]*)"\]`)
+
+// 按 JSONPath 表达式从 JSON 对象中取值。
+// 仅支持成员访问与数组下标，如 "$.data.items[0].id"、"$['data']['id']"。
+func evalJSONPath(data any, path string) (any, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid json path '%s': must start with '$'", path)
+	}
+
+	rest := path[1:]
+	current := data
+	for rest != "" {
+		loc := jsonPathTokenRegexp.FindStringSubmatchIndex(rest)
+		if loc == nil || loc[0] != 0 {
+			return nil, fmt.Errorf("invalid json path '%s'", path)
+		}
+
+		groups := jsonPathTokenRegexp.FindStringSubmatch(rest)
+		rest = rest[loc[1]:]
+
+		if groups[2] != "" {
+			index, _ := strconv.Atoi(groups[2])
+			array, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("json path '%s' not found", path)
+			}
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, fmt.Errorf("json path '%s' not found", path)
+			}
+			current = array[index]
+		} else {
+			key := groups[1] + groups[3] + groups[4]
+			object, ok := current.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("json path '%s' not found", path)
+			}
+			value, ok := object[key]
+			if !ok {
+				return nil, fmt.Errorf("json path '%s' not found", path)
+			}
+			current = value
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,122 @@
+package nodeprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+func Test_evalJSONPath(t *testing.T) {
+	var data any
+	json.Unmarshal([]byte(`{"data":{"items":[{"id":"a1","ok":true},{"id":"a2","ok":false}],"total":2},"with.dot":"x"}`), &data)
+
+	tests := []struct {
+		path    string
+		want    any
+		wantErr bool
+	}{
+		{"$.data.total", float64(2), false},
+		{"$.data.items[0].id", "a1", false},
+		{"$.data.items[-1].ok", false, false},
+		{"$['with.dot']", "x", false},
+		{"$[\"data\"].items[1].id", "a2", false},
+		{"$.data.items[2]", nil, true},
+		{"$.data.unknown", nil, true},
+		{"data.total", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := evalJSONPath(data, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_HttpRequestNode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"a1"}}`))
+	}))
+	defer server.Close()
+
+	t.Run("Extract", func(t *testing.T) {
+		processor := NewHttpRequestNode(&domain.WorkflowNode{
+			Id:   "test",
+			Type: domain.WorkflowNodeTypeHttpRequest,
+			Config: map[string]any{
+				"url":         server.URL,
+				"extractions": map[string]any{"id": "$.data.id"},
+			},
+		})
+		if err := processor.Process(context.Background()); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if outputs := processor.GetOutputs(); outputs["id"] != "a1" || outputs[outputKeyForHttpStatusCode] != "200" {
+			t.Errorf("unexpected outputs: %v", outputs)
+		}
+	})
+
+	t.Run("EscapeBody", func(t *testing.T) {
+		var received []byte
+		echo := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+		}))
+		defer echo.Close()
+
+		ctx := AddNodeOutput(context.Background(), "prev", map[string]any{"msg": "a \"quoted\"\nvalue & more"})
+
+		tests := []struct {
+			headers string
+			body    string
+			want    string
+		}{
+			{"Content-Type: application/json; charset=utf-8", `{"text":"${prev#msg}"}`, `{"text":"a \"quoted\"\nvalue \u0026 more"}`},
+			{"Content-Type: application/x-www-form-urlencoded", `text=${prev#msg}`, `text=a+%22quoted%22%0Avalue+%26+more`},
+			{"Content-Type: text/plain", `${prev#msg}`, "a \"quoted\"\nvalue & more"},
+		}
+		for _, tt := range tests {
+			processor := NewHttpRequestNode(&domain.WorkflowNode{
+				Id:   "test",
+				Type: domain.WorkflowNodeTypeHttpRequest,
+				Config: map[string]any{
+					"url":     echo.URL,
+					"method":  http.MethodPost,
+					"headers": tt.headers,
+					"body":    tt.body,
+				},
+			})
+			if err := processor.Process(ctx); err != nil {
+				t.Fatalf("err: %+v", err)
+			}
+			if string(received) != tt.want {
+				t.Errorf("%s: expected body %s, got %s", tt.headers, tt.want, string(received))
+			}
+		}
+	})
+
+	t.Run("ReservedOutputName", func(t *testing.T) {
+		processor := NewHttpRequestNode(&domain.WorkflowNode{
+			Id:   "test",
+			Type: domain.WorkflowNodeTypeHttpRequest,
+			Config: map[string]any{
+				"url":         server.URL,
+				"extractions": map[string]any{outputKeyForHttpStatusCode: "$.data.id"},
+			},
+		})
+		if err := processor.Process(context.Background()); err == nil || !strings.Contains(err.Error(), "reserved") {
+			t.Errorf("expected reserved output name error, got %v", err)
+		}
+	})
+}
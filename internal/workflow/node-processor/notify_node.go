@@ -66,12 +66,26 @@ func (n *notifyNode) Process(ctx context.Context) error {
 		return nil
 	}
 
+	// 若处于失败分支中，附加失败信息以便对重复的失败通知去重
+	var failure *notify.NotifyFailure
+	if nodeFailure := getContextNodeFailure(ctx); nodeFailure != nil {
+		failure = &notify.NotifyFailure{
+			WorkflowId: getContextWorkflowId(ctx),
+			NodeId:     nodeFailure.NodeId,
+			NodeName:   nodeFailure.NodeName,
+			Error:      nodeFailure.Error,
+		}
+	}
+
 	// 初始化通知器
-	deployer, err := notify.NewWithWorkflowNode(notify.NotifierWithWorkflowNodeConfig{
-		Node:    n.node,
-		Logger:  n.logger,
-		Subject: nodeCfg.Subject,
-		Message: nodeCfg.Message,
+	notifier, err := notify.NewWithWorkflowNode(notify.NotifierWithWorkflowNodeConfig{
+		Node:          n.node,
+		Logger:        n.logger,
+		WorkflowId:    getContextWorkflowId(ctx),
+		WorkflowRunId: getContextWorkflowRunId(ctx),
+		Subject:       nodeCfg.Subject,
+		Message:       nodeCfg.Message,
+		Failure:       failure,
 	})
 	if err != nil {
 		n.logger.Warn("failed to create notifier provider")
@@ -79,7 +93,7 @@ func (n *notifyNode) Process(ctx context.Context) error {
 	}
 
 	// 推送通知
-	if err := deployer.Notify(ctx); err != nil {
+	if err := notifier.Notify(ctx); err != nil {
 		n.logger.Warn("failed to send notification")
 		return err
 	}
@@ -84,6 +84,14 @@ func GetProcessor(node *domain.WorkflowNode) (NodeProcessor, error) {
 		return NewDeployNode(node), nil
 	case domain.WorkflowNodeTypeNotify:
 		return NewNotifyNode(node), nil
+	case domain.WorkflowNodeTypeApproval:
+		return NewApprovalNode(node), nil
+	case domain.WorkflowNodeTypeDelay:
+		return NewDelayNode(node), nil
+	case domain.WorkflowNodeTypeHttpRequest:
+		return NewHttpRequestNode(node), nil
+	case domain.WorkflowNodeTypeScript:
+		return NewScriptNode(node), nil
 	case domain.WorkflowNodeTypeCondition:
 		return NewConditionNode(node), nil
 	case domain.WorkflowNodeTypeExecuteSuccess:
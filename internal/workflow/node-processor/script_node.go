@@ -0,0 +1,211 @@
+package nodeprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+	"github.com/certimate-go/certimate/internal/repository"
+	xshell "github.com/certimate-go/certimate/pkg/utils/shell"
+)
+
+type scriptNode struct {
+	node *domain.WorkflowNode
+	*nodeProcessor
+	*nodeOutputer
+
+	certRepo certificateRepository
+}
+
+func NewScriptNode(node *domain.WorkflowNode) *scriptNode {
+	return &scriptNode{
+		node:          node,
+		nodeProcessor: newNodeProcessor(node),
+		nodeOutputer:  newNodeOutputer(),
+
+		certRepo: repository.NewCertificateRepository(),
+	}
+}
+
+func (n *scriptNode) Process(ctx context.Context) error {
+	nodeCfg := n.node.GetConfigForScript()
+	if strings.TrimSpace(nodeCfg.Script) == "" {
+		return errors.New("script is empty")
+	}
+
+	// 创建临时工作目录，执行结束后删除
+	workDir, err := os.MkdirTemp("", "certimate-script-*")
+	if err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// 脚本内容与环境变量中可能包含凭据，日志中仅记录执行环境
+	n.logger.Info("ready to execute script ...", slog.String("shellEnv", nodeCfg.ShellEnv), slog.String("workDir", workDir))
+
+	// 准备环境变量
+	env, err := n.prepareEnvironment(ctx, nodeCfg, workDir)
+	if err != nil {
+		return err
+	}
+
+	// 执行脚本
+	timeout := time.Duration(nodeCfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout, stderr, err := xshell.ExecCommand(execCtx, nodeCfg.ShellEnv, nodeCfg.Script, workDir, env)
+	n.logger.Debug("run script", slog.String("stdout", stdout), slog.String("stderr", stderr))
+	if err != nil {
+		if errors.Is(execCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("script execution timed out after %s", timeout)
+		}
+		return fmt.Errorf("failed to execute script (stdout: %s, stderr: %s): %w", stdout, stderr, err)
+	}
+
+	// 解析脚本标准输出中的 JSON 对象作为节点输出
+	if outputs, ok := parseScriptOutputs(stdout); ok {
+		for k, v := range outputs {
+			n.outputs[k] = v
+		}
+	} else if strings.TrimSpace(stdout) != "" {
+		n.logger.Warn("the stdout of the script is not a JSON object, ignore it as outputs")
+	}
+
+	n.logger.Info("script execution completed")
+	return nil
+}
+
+func (n *scriptNode) prepareEnvironment(ctx context.Context, nodeCfg domain.WorkflowNodeConfigForScript, workDir string) ([]string, error) {
+	// 仅继承最基本的系统环境变量，避免泄露 Certimate 进程的其他环境变量
+	env := make([]string, 0)
+	for _, key := range []string{"PATH", "LANG", "TZ", "SystemRoot", "ComSpec", "PATHEXT", "WINDIR"} {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+	env = append(env,
+		fmt.Sprintf("HOME=%s", workDir),
+		fmt.Sprintf("TMPDIR=%s", workDir),
+		fmt.Sprintf("CERTIMATE_WORKFLOW_ID=%s", getContextWorkflowId(ctx)),
+		fmt.Sprintf("CERTIMATE_WORKFLOW_RUN_ID=%s", getContextWorkflowRunId(ctx)),
+		fmt.Sprintf("CERTIMATE_WORKFLOW_NODE_ID=%s", n.node.Id),
+	)
+
+	// 前序节点输出，形如 "CERTIMATE_NODE_{NodeId}_{OutputName}"
+	allNodeOutputs := GetAllNodeOutputs(ctx)
+	nodeIds := make([]string, 0, len(allNodeOutputs))
+	for nodeId := range allNodeOutputs {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	sort.Strings(nodeIds)
+	for _, nodeId := range nodeIds {
+		for name, value := range allNodeOutputs[nodeId] {
+			env = append(env, fmt.Sprintf("CERTIMATE_NODE_%s_%s=%v", sanitizeEnvName(nodeId), sanitizeEnvName(name), value))
+		}
+	}
+
+	// 前序节点输出的证书，写入临时文件
+	if nodeCfg.Certificate != "" {
+		const DELIMITER = "#"
+		certificateSourceSlice := strings.Split(nodeCfg.Certificate, DELIMITER)
+		if len(certificateSourceSlice) != 2 {
+			n.logger.Warn("invalid certificate source", slog.String("certificate.source", nodeCfg.Certificate))
+			return nil, fmt.Errorf("invalid certificate source: %s", nodeCfg.Certificate)
+		}
+
+		certificate, err := n.certRepo.GetByWorkflowNodeId(ctx, certificateSourceSlice[0])
+		if err != nil {
+			n.logger.Warn("invalid certificate source", slog.String("certificate.source", nodeCfg.Certificate))
+			return nil, err
+		}
+
+		certPath := filepath.Join(workDir, "certificate.pem")
+		if err := os.WriteFile(certPath, []byte(certificate.Certificate), 0o600); err != nil {
+			return nil, fmt.Errorf("failed to save certificate file: %w", err)
+		}
+
+		keyPath := filepath.Join(workDir, "privkey.pem")
+		if err := os.WriteFile(keyPath, []byte(certificate.PrivateKey), 0o600); err != nil {
+			return nil, fmt.Errorf("failed to save private key file: %w", err)
+		}
+
+		env = append(env,
+			fmt.Sprintf("CERTIMATE_CERTIFICATE_PATH=%s", certPath),
+			fmt.Sprintf("CERTIMATE_PRIVATE_KEY_PATH=%s", keyPath),
+			fmt.Sprintf("CERTIMATE_CERTIFICATE_DOMAINS=%s", certificate.SubjectAltNames),
+		)
+	}
+
+	// 用户自定义环境变量
+	for _, line := range strings.Split(renderTemplate(ctx, nodeCfg.Environment), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !strings.Contains(line, "=") {
+			return nil, fmt.Errorf("invalid environment variable '%s'", line)
+		}
+		env = append(env, line)
+	}
+
+	return env, nil
+}
+
+// 解析脚本输出。
+// 优先将整个标准输出作为 JSON 对象解析，失败时再尝试解析最后一个非空行。
+func parseScriptOutputs(stdout string) (map[string]string, bool) {
+	stdout = strings.TrimSpace(stdout)
+	if stdout == "" {
+		return nil, false
+	}
+
+	candidates := []string{stdout}
+	if lines := strings.Split(stdout, "\n"); len(lines) > 1 {
+		candidates = append(candidates, strings.TrimSpace(lines[len(lines)-1]))
+	}
+
+	for _, candidate := range candidates {
+		data := make(map[string]any)
+		if err := json.Unmarshal([]byte(candidate), &data); err != nil {
+			continue
+		}
+
+		outputs := make(map[string]string, len(data))
+		for k, v := range data {
+			switch tv := v.(type) {
+			case string:
+				outputs[k] = tv
+			case nil:
+				outputs[k] = ""
+			case bool, float64:
+				outputs[k] = fmt.Sprintf("%v", tv)
+			default:
+				jsonb, _ := json.Marshal(tv)
+				outputs[k] = string(jsonb)
+			}
+		}
+		return outputs, true
+	}
+
+	return nil, false
+}
+
+var envNameSanitizeRegexp = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func sanitizeEnvName(name string) string {
+	return strings.ToUpper(envNameSanitizeRegexp.ReplaceAllString(name, "_"))
+}
@@ -0,0 +1,33 @@
+package nodeprocessor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseScriptOutputs(t *testing.T) {
+	tests := []struct {
+		name   string
+		stdout string
+		want   map[string]string
+		wantOk bool
+	}{
+		{"empty", "", nil, false},
+		{"plain text", "hello world", nil, false},
+		{"json object", `{"purged":true,"count":3,"id":"x1"}`, map[string]string{"purged": "true", "count": "3", "id": "x1"}, true},
+		{"json object on last line", "purging cache ...\ndone\n{\"status\":\"ok\"}\n", map[string]string{"status": "ok"}, true},
+		{"nested value", `{"data":{"a":1}}`, map[string]string{"data": `{"a":1}`}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseScriptOutputs(tt.stdout)
+			if ok != tt.wantOk {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package nodeprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/certimate-go/certimate/internal/domain"
+)
+
+const (
+	nodeSuspensionKey workflowContextKey = "node_suspension"
+)
+
+// 节点挂起时返回的错误。
+// 工作流执行器收到此错误后，会持久化执行断点并释放工作槽位，待外部信号或到达恢复时间后再从断点继续执行。
+type SuspendError struct {
+	Reason   string
+	ResumeAt time.Time
+}
+
+func (e *SuspendError) Error() string {
+	if e.ResumeAt.IsZero() {
+		return fmt.Sprintf("workflow suspended: %s", e.Reason)
+	}
+
+	return fmt.Sprintf("workflow suspended: %s (resume at %s)", e.Reason, e.ResumeAt.Format(time.RFC3339))
+}
+
+func IsSuspendError(err error) bool {
+	var suspendErr *SuspendError
+	return errors.As(err, &suspendErr)
+}
+
+// 附加节点上一次的挂起信息到上下文
+func WithNodeSuspension(ctx context.Context, suspension *domain.WorkflowRunSuspension) context.Context {
+	return context.WithValue(ctx, nodeSuspensionKey, suspension)
+}
+
+// 从上下文获取节点上一次的挂起信息（节点为首次执行时返回 nil）
+func getContextNodeSuspension(ctx context.Context, nodeId string) *domain.WorkflowRunSuspension {
+	value := ctx.Value(nodeSuspensionKey)
+	if value == nil {
+		return nil
+	}
+
+	suspension := value.(*domain.WorkflowRunSuspension)
+	if suspension == nil || suspension.NodeId != nodeId {
+		return nil
+	}
+
+	return suspension
+}
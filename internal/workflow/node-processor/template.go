@@ -0,0 +1,52 @@
+package nodeprocessor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// 模板变量，形如 "${NodeId#OutputName}"，引用前序节点的输出；
+// 另支持 "${WORKFLOW_ID}"、"${WORKFLOW_RUN_ID}" 两个内置变量。
+var templateVariableRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_\-]+)(?:#([^}]+))?\}`)
+
+// 渲染模板字符串，替换其中的模板变量。
+// 引用的节点输出不存在时替换为空字符串，无法识别的内置变量则保持原样。
+func renderTemplate(ctx context.Context, tmpl string) string {
+	return renderTemplateWithEscaper(ctx, tmpl, nil)
+}
+
+// 与 [renderTemplate] 类似，但替换前会使用 escape 转义变量的值。
+// escape 为 nil 时不转义。
+func renderTemplateWithEscaper(ctx context.Context, tmpl string, escape func(string) string) string {
+	if escape == nil {
+		escape = func(s string) string { return s }
+	}
+
+	if tmpl == "" {
+		return tmpl
+	}
+
+	variables := GetAllNodeOutputs(ctx)
+	return templateVariableRegexp.ReplaceAllStringFunc(tmpl, func(match string) string {
+		groups := templateVariableRegexp.FindStringSubmatch(match)
+		nodeId, outputName := groups[1], groups[2]
+		if outputName == "" {
+			switch nodeId {
+			case "WORKFLOW_ID":
+				return escape(getContextWorkflowId(ctx))
+			case "WORKFLOW_RUN_ID":
+				return escape(getContextWorkflowRunId(ctx))
+			}
+			return match
+		}
+
+		if outputs, ok := variables[nodeId]; ok {
+			if value, ok := outputs[outputName]; ok && value != nil {
+				return escape(fmt.Sprintf("%v", value))
+			}
+		}
+
+		return ""
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/certimate-go/certimate/internal/domain"
 	"github.com/certimate-go/certimate/internal/domain/dtos"
 	"github.com/certimate-go/certimate/internal/workflow/dispatcher"
+	nodes "github.com/certimate-go/certimate/internal/workflow/node-processor"
 )
 
 type workflowRepository interface {
@@ -22,11 +23,19 @@ type workflowRepository interface {
 }
 
 type workflowRunRepository interface {
+	ListByStatus(ctx context.Context, status domain.WorkflowRunStatusType) ([]*domain.WorkflowRun, error)
 	GetById(ctx context.Context, id string) (*domain.WorkflowRun, error)
 	Save(ctx context.Context, workflowRun *domain.WorkflowRun) (*domain.WorkflowRun, error)
 	DeleteWhere(ctx context.Context, exprs ...dbx.Expression) (int, error)
 }
 
+type workflowVersionRepository interface {
+	ListByWorkflowId(ctx context.Context, workflowId string) ([]*domain.WorkflowVersion, error)
+	GetByWorkflowIdAndVersion(ctx context.Context, workflowId string, version int) (*domain.WorkflowVersion, error)
+	GetLatestByWorkflowId(ctx context.Context, workflowId string) (*domain.WorkflowVersion, error)
+	Save(ctx context.Context, workflowVersion *domain.WorkflowVersion) (*domain.WorkflowVersion, error)
+}
+
 type settingsRepository interface {
 	GetByName(ctx context.Context, name string) (*domain.Settings, error)
 }
@@ -34,18 +43,20 @@ type settingsRepository interface {
 type WorkflowService struct {
 	dispatcher *dispatcher.WorkflowDispatcher
 
-	workflowRepo    workflowRepository
-	workflowRunRepo workflowRunRepository
-	settingsRepo    settingsRepository
+	workflowRepo        workflowRepository
+	workflowRunRepo     workflowRunRepository
+	workflowVersionRepo workflowVersionRepository
+	settingsRepo        settingsRepository
 }
 
-func NewWorkflowService(workflowRepo workflowRepository, workflowRunRepo workflowRunRepository, settingsRepo settingsRepository) *WorkflowService {
+func NewWorkflowService(workflowRepo workflowRepository, workflowRunRepo workflowRunRepository, workflowVersionRepo workflowVersionRepository, settingsRepo settingsRepository) *WorkflowService {
 	srv := &WorkflowService{
 		dispatcher: dispatcher.GetSingletonDispatcher(),
 
-		workflowRepo:    workflowRepo,
-		workflowRunRepo: workflowRunRepo,
-		settingsRepo:    settingsRepo,
+		workflowRepo:        workflowRepo,
+		workflowRunRepo:     workflowRunRepo,
+		workflowVersionRepo: workflowVersionRepo,
+		settingsRepo:        settingsRepo,
 	}
 	return srv
 }
@@ -66,6 +77,7 @@ func (s *WorkflowService) InitSchedule(ctx context.Context) error {
 				context.Background(),
 				dbx.NewExp(fmt.Sprintf("status!='%s'", string(domain.WorkflowRunStatusTypePending))),
 				dbx.NewExp(fmt.Sprintf("status!='%s'", string(domain.WorkflowRunStatusTypeRunning))),
+				dbx.NewExp(fmt.Sprintf("status!='%s'", string(domain.WorkflowRunStatusTypeSuspended))),
 				dbx.NewExp(fmt.Sprintf("endedAt<DATETIME('now', '-%d days')", settingsContent.WorkflowRunsMaxDaysRetention)),
 			)
 			if err != nil {
@@ -78,6 +90,30 @@ func (s *WorkflowService) InitSchedule(ctx context.Context) error {
 		}
 	})
 
+	// 每分钟恢复已到达恢复时间的挂起工作流
+	app.GetScheduler().MustAdd("workflowSuspendedRunsResume", "* * * * *", func() {
+		runs, err := s.workflowRunRepo.ListByStatus(ctx, domain.WorkflowRunStatusTypeSuspended)
+		if err != nil {
+			app.GetLogger().Error("failed to get suspended workflow runs", "err", err)
+			return
+		}
+
+		for _, run := range runs {
+			if run.Checkpoint == nil || run.Checkpoint.Suspension == nil {
+				continue
+			}
+
+			resumeAt := run.Checkpoint.Suspension.ResumeAt
+			if resumeAt.IsZero() || time.Now().Before(resumeAt) {
+				continue
+			}
+
+			if err := s.resumeRun(ctx, run); err != nil {
+				app.GetLogger().Error(fmt.Sprintf("failed to resume workflow run #%s", run.Id), "err", err)
+			}
+		}
+	})
+
 	// 工作流
 	{
 		workflows, err := s.workflowRepo.ListEnabledAuto(ctx)
@@ -113,16 +149,17 @@ func (s *WorkflowService) StartRun(ctx context.Context, req *dtos.WorkflowStartR
 		return err
 	}
 
-	if workflow.LastRunStatus == domain.WorkflowRunStatusTypePending || workflow.LastRunStatus == domain.WorkflowRunStatusTypeRunning {
-		return errors.New("workflow is already pending or running")
+	if workflow.LastRunStatus == domain.WorkflowRunStatusTypePending || workflow.LastRunStatus == domain.WorkflowRunStatusTypeRunning || workflow.LastRunStatus == domain.WorkflowRunStatusTypeSuspended {
+		return errors.New("workflow is already pending, running or suspended")
 	}
 
 	run := &domain.WorkflowRun{
-		WorkflowId: workflow.Id,
-		Status:     domain.WorkflowRunStatusTypePending,
-		Trigger:    req.RunTrigger,
-		StartedAt:  time.Now(),
-		Detail:     workflow.Content,
+		WorkflowId:      workflow.Id,
+		WorkflowVersion: workflow.Version,
+		Status:          domain.WorkflowRunStatusTypePending,
+		Trigger:         req.RunTrigger,
+		StartedAt:       time.Now(),
+		Detail:          workflow.Content,
 	}
 	if resp, err := s.workflowRunRepo.Save(ctx, run); err != nil {
 		return err
@@ -150,8 +187,8 @@ func (s *WorkflowService) CancelRun(ctx context.Context, req *dtos.WorkflowCance
 		return err
 	} else if workflowRun.WorkflowId != workflow.Id {
 		return errors.New("workflow run not found")
-	} else if workflowRun.Status != domain.WorkflowRunStatusTypePending && workflowRun.Status != domain.WorkflowRunStatusTypeRunning {
-		return errors.New("workflow run is not pending or running")
+	} else if workflowRun.Status != domain.WorkflowRunStatusTypePending && workflowRun.Status != domain.WorkflowRunStatusTypeRunning && workflowRun.Status != domain.WorkflowRunStatusTypeSuspended {
+		return errors.New("workflow run is not pending, running or suspended")
 	}
 
 	s.dispatcher.Cancel(workflowRun.Id)
@@ -159,6 +196,139 @@ func (s *WorkflowService) CancelRun(ctx context.Context, req *dtos.WorkflowCance
 	return nil
 }
 
+func (s *WorkflowService) GetRun(ctx context.Context, req *dtos.WorkflowGetRunReq) (*domain.WorkflowRun, error) {
+	workflowRun, err := s.workflowRunRepo.GetById(ctx, req.RunId)
+	if err != nil {
+		return nil, err
+	} else if workflowRun.WorkflowId != req.WorkflowId {
+		return nil, domain.ErrRecordNotFound
+	}
+
+	return workflowRun, nil
+}
+
+func (s *WorkflowService) ApproveRun(ctx context.Context, req *dtos.WorkflowApproveRunReq) error {
+	workflowRun, err := s.workflowRunRepo.GetById(ctx, req.RunId)
+	if err != nil {
+		return err
+	} else if workflowRun.WorkflowId != req.WorkflowId {
+		return errors.New("workflow run not found")
+	} else if workflowRun.Status != domain.WorkflowRunStatusTypeSuspended {
+		return errors.New("workflow run is not suspended")
+	} else if workflowRun.Checkpoint == nil || workflowRun.Checkpoint.Suspension == nil || workflowRun.Checkpoint.Suspension.NodeType != domain.WorkflowNodeTypeApproval {
+		return errors.New("workflow run is not waiting for approval")
+	}
+
+	workflowRun.Checkpoint.Suspension.Signal = map[string]any{
+		nodes.ApprovalSignalKeyApproved: req.Approved,
+		nodes.ApprovalSignalKeyOperator: req.Operator,
+		nodes.ApprovalSignalKeyComment:  req.Comment,
+	}
+
+	return s.resumeRun(ctx, workflowRun)
+}
+
+func (s *WorkflowService) ListVersions(ctx context.Context, req *dtos.WorkflowListVersionsReq) (*dtos.WorkflowListVersionsResp, error) {
+	if _, err := s.workflowRepo.GetById(ctx, req.WorkflowId); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.workflowVersionRepo.ListByWorkflowId(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.WorkflowListVersionsResp{Items: versions}, nil
+}
+
+func (s *WorkflowService) DiffVersions(ctx context.Context, req *dtos.WorkflowDiffVersionsReq) (*dtos.WorkflowDiffVersionsResp, error) {
+	fromVersion, err := s.workflowVersionRepo.GetByWorkflowIdAndVersion(ctx, req.WorkflowId, req.FromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not find workflow version #%d: %w", req.FromVersion, err)
+	}
+
+	toVersion, err := s.workflowVersionRepo.GetByWorkflowIdAndVersion(ctx, req.WorkflowId, req.ToVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not find workflow version #%d: %w", req.ToVersion, err)
+	}
+
+	return &dtos.WorkflowDiffVersionsResp{
+		FromVersion: fromVersion.Version,
+		ToVersion:   toVersion.Version,
+		Changes:     diffWorkflowNodes(fromVersion.Content, toVersion.Content),
+	}, nil
+}
+
+func (s *WorkflowService) RestoreVersion(ctx context.Context, req *dtos.WorkflowRestoreVersionReq) error {
+	workflow, err := s.workflowRepo.GetById(ctx, req.WorkflowId)
+	if err != nil {
+		return err
+	}
+
+	version, err := s.workflowVersionRepo.GetByWorkflowIdAndVersion(ctx, req.WorkflowId, req.Version)
+	if err != nil {
+		return fmt.Errorf("could not find workflow version #%d: %w", req.Version, err)
+	}
+
+	// 回滚时仅将历史版本写入草稿，需用户再次发布后才会生效并产生新的版本
+	workflow.Draft = version.Content
+	workflow.HasDraft = true
+	if _, err := s.workflowRepo.Save(ctx, workflow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 若工作流已发布的内容与最新版本不一致，则生成一个新的版本。
+func (s *WorkflowService) SnapshotVersion(ctx context.Context, workflowId string, author string) error {
+	workflow, err := s.workflowRepo.GetById(ctx, workflowId)
+	if err != nil {
+		return err
+	}
+
+	if workflow.Content == nil || workflow.Content.Id == "" {
+		return nil
+	}
+
+	nextVersion := 1
+	latest, err := s.workflowVersionRepo.GetLatestByWorkflowId(ctx, workflowId)
+	if err != nil {
+		if !domain.IsRecordNotFoundError(err) {
+			return err
+		}
+	} else {
+		if jsonEqual(latest.Content, workflow.Content) {
+			return nil
+		}
+
+		nextVersion = latest.Version + 1
+	}
+
+	_, err = s.workflowVersionRepo.Save(ctx, &domain.WorkflowVersion{
+		WorkflowId: workflow.Id,
+		Version:    nextVersion,
+		Content:    workflow.Content,
+		Author:     author,
+	})
+	return err
+}
+
+func (s *WorkflowService) resumeRun(ctx context.Context, run *domain.WorkflowRun) error {
+	run.Status = domain.WorkflowRunStatusTypePending
+	if _, err := s.workflowRunRepo.Save(ctx, run); err != nil {
+		return err
+	}
+
+	s.dispatcher.Dispatch(&dispatcher.WorkflowWorkerData{
+		WorkflowId:      run.WorkflowId,
+		WorkflowContent: run.Detail,
+		RunId:           run.Id,
+	})
+
+	return nil
+}
+
 func (s *WorkflowService) Shutdown(ctx context.Context) {
 	s.dispatcher.Shutdown()
 }
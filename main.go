@@ -14,6 +14,7 @@ import (
 	"github.com/pocketbase/pocketbase/tools/hook"
 
 	"github.com/certimate-go/certimate/internal/app"
+	"github.com/certimate-go/certimate/internal/encryption"
 	"github.com/certimate-go/certimate/internal/rest/routes"
 	"github.com/certimate-go/certimate/internal/scheduler"
 	"github.com/certimate-go/certimate/internal/workflow"
@@ -40,6 +41,9 @@ func main() {
 		Automigrate: strings.HasPrefix(os.Args[0], os.TempDir()),
 	})
 
+	encryption.Register()
+	app.RootCmd.AddCommand(encryption.NewCommand())
+
 	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
 		scheduler.Register()
 		workflow.Register()
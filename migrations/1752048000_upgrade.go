@@ -0,0 +1,228 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		tracer := NewTracer("(v0.3)1752048000")
+		tracer.Printf("go ...")
+
+		// update collection `workflow`
+		{
+			collection, err := app.FindCollectionByNameOrId("tovyif5ax6j62ur")
+			if err != nil {
+				return err
+			}
+
+			if err := collection.Fields.AddMarshaledJSONAt(7, []byte(`{
+				"hidden": false,
+				"id": "number3206337475",
+				"max": null,
+				"min": null,
+				"name": "version",
+				"onlyInt": true,
+				"presentable": false,
+				"required": false,
+				"system": false,
+				"type": "number"
+			}`)); err != nil {
+				return err
+			}
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+
+			tracer.Printf("collection '%s' updated", collection.Name)
+		}
+
+		// update collection `workflow_run`
+		{
+			collection, err := app.FindCollectionByNameOrId("qjp8lygssgwyqyz")
+			if err != nil {
+				return err
+			}
+
+			if err := collection.Fields.AddMarshaledJSONAt(2, []byte(`{
+				"hidden": false,
+				"id": "number2828515103",
+				"max": null,
+				"min": null,
+				"name": "workflowVersion",
+				"onlyInt": true,
+				"presentable": false,
+				"required": false,
+				"system": false,
+				"type": "number"
+			}`)); err != nil {
+				return err
+			}
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+
+			tracer.Printf("collection '%s' updated", collection.Name)
+		}
+
+		// create collection `workflow_version`
+		{
+			jsonData := `{
+				"createRule": null,
+				"deleteRule": null,
+				"fields": [
+					{
+						"autogeneratePattern": "[a-z0-9]{15}",
+						"hidden": false,
+						"id": "text3208210256",
+						"max": 15,
+						"min": 15,
+						"name": "id",
+						"pattern": "^[a-z0-9]+$",
+						"presentable": false,
+						"primaryKey": true,
+						"required": true,
+						"system": true,
+						"type": "text"
+					},
+					{
+						"cascadeDelete": true,
+						"collectionId": "tovyif5ax6j62ur",
+						"hidden": false,
+						"id": "relation3371272342",
+						"maxSelect": 1,
+						"minSelect": 0,
+						"name": "workflowId",
+						"presentable": false,
+						"required": true,
+						"system": false,
+						"type": "relation"
+					},
+					{
+						"hidden": false,
+						"id": "number3206337475",
+						"max": null,
+						"min": 1,
+						"name": "version",
+						"onlyInt": true,
+						"presentable": false,
+						"required": true,
+						"system": false,
+						"type": "number"
+					},
+					{
+						"hidden": false,
+						"id": "json4274335913",
+						"maxSize": 5000000,
+						"name": "content",
+						"presentable": false,
+						"required": false,
+						"system": false,
+						"type": "json"
+					},
+					{
+						"autogeneratePattern": "",
+						"hidden": false,
+						"id": "text3182418120",
+						"max": 0,
+						"min": 0,
+						"name": "author",
+						"pattern": "",
+						"presentable": false,
+						"primaryKey": false,
+						"required": false,
+						"system": false,
+						"type": "text"
+					},
+					{
+						"hidden": false,
+						"id": "autodate2990389176",
+						"name": "created",
+						"onCreate": true,
+						"onUpdate": false,
+						"presentable": false,
+						"system": false,
+						"type": "autodate"
+					},
+					{
+						"hidden": false,
+						"id": "autodate3332085495",
+						"name": "updated",
+						"onCreate": true,
+						"onUpdate": true,
+						"presentable": false,
+						"system": false,
+						"type": "autodate"
+					}
+				],
+				"id": "pbc_3176154483",
+				"indexes": [
+					"CREATE UNIQUE INDEX ` + "`" + `idx_Wv3rS1oNbK` + "`" + ` ON ` + "`" + `workflow_version` + "`" + ` (` + "`" + `workflowId` + "`" + `, ` + "`" + `version` + "`" + `)"
+				],
+				"listRule": null,
+				"name": "workflow_version",
+				"system": false,
+				"type": "base",
+				"updateRule": null,
+				"viewRule": null
+			}`
+
+			collection := &core.Collection{}
+			if err := json.Unmarshal([]byte(jsonData), &collection); err != nil {
+				return err
+			}
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+
+			tracer.Printf("collection '%s' created", collection.Name)
+		}
+
+		// migrate data
+		{
+			collection, err := app.FindCollectionByNameOrId("workflow_version")
+			if err != nil {
+				return err
+			}
+
+			workflows, err := app.FindAllRecords("workflow")
+			if err != nil {
+				return err
+			}
+
+			for _, workflow := range workflows {
+				content := make(map[string]any)
+				if err := workflow.UnmarshalJSONField("content", &content); err != nil || len(content) == 0 {
+					continue
+				}
+
+				// 为已发布的工作流生成初始版本
+				record := core.NewRecord(collection)
+				record.Set("workflowId", workflow.Id)
+				record.Set("version", 1)
+				record.Set("content", content)
+				if err := app.Save(record); err != nil {
+					return err
+				}
+
+				workflow.Set("version", 1)
+				if err := app.Save(workflow); err != nil {
+					return err
+				}
+
+				tracer.Printf("record #%s in collection '%s' updated", workflow.Id, workflow.Collection().Name)
+			}
+		}
+
+		tracer.Printf("done")
+		return nil
+	}, func(app core.App) error {
+		return nil
+	})
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+
+	"github.com/certimate-go/certimate/internal/encryption"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		tracer := NewTracer("(v0.3)1752480000")
+		tracer.Printf("go ...")
+
+		// encrypt sensitive fields of existing records
+		{
+			key, err := encryption.GetMasterKey()
+			if err != nil {
+				return err
+			}
+
+			if key == nil {
+				tracer.Printf("master key is not configured, skip encrypting existing records")
+			} else {
+				count, err := encryption.Rotate(app, key, key)
+				if err != nil {
+					return err
+				}
+
+				tracer.Printf("%d records encrypted under master key '%s'", count, key.Id())
+			}
+		}
+
+		tracer.Printf("done")
+		return nil
+	}, func(app core.App) error {
+		return nil
+	})
+}
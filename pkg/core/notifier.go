@@ -25,3 +25,34 @@ type Notifier interface {
 type NotifyResult struct {
 	ExtendedData map[string]any `json:"extendedData,omitempty"`
 }
+
+// 表示定义支持结构化消息的消息通知器的抽象类型接口。
+// 实现此接口的通知器可按渠道原生格式渲染消息（如卡片、富文本等）。
+type MessageNotifier interface {
+	Notifier
+
+	// 发送结构化消息通知。
+	//
+	// 入参：
+	//   - ctx：上下文。
+	//   - message：结构化消息。
+	//
+	// 出参：
+	//   - res：发送结果。
+	//   - err: 错误。
+	NotifyMessage(ctx context.Context, message *NotifyMessage) (_res *NotifyResult, _err error)
+}
+
+// 发送结构化消息通知。
+// 如果通知器不支持结构化消息，则降级为纯文本通知。
+func NotifyWithMessage(ctx context.Context, notifier Notifier, message *NotifyMessage) (*NotifyResult, error) {
+	if message == nil {
+		message = &NotifyMessage{}
+	}
+
+	if mn, ok := notifier.(MessageNotifier); ok {
+		return mn.NotifyMessage(ctx, message)
+	}
+
+	return notifier.Notify(ctx, message.Subject, message.PlainText())
+}
@@ -0,0 +1,155 @@
+package aliyunsms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	aliopen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/dara"
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type NotifierProviderConfig struct {
+	// 阿里云 AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// 阿里云 AccessKeySecret。
+	AccessKeySecret string `json:"accessKeySecret"`
+	// 短信签名名称。
+	SignName string `json:"signName"`
+	// 短信模板 Code。
+	TemplateCode string `json:"templateCode"`
+	// 短信模板变量映射。
+	// 键为模板中的变量名，值为支持变量替换的字符串，参见 [core.NotifyMessage.TemplateVariables]。
+	TemplateParams map[string]string `json:"templateParams,omitempty"`
+	// 接收短信的手机号码列表。
+	PhoneNumbers []string `json:"phoneNumbers"`
+}
+
+type NotifierProvider struct {
+	config    *NotifierProviderConfig
+	logger    *slog.Logger
+	sdkClient *aliopen.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client, err := createSDKClient(config.AccessKeyId, config.AccessKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &NotifierProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if n.config.SignName == "" {
+		return nil, errors.New("config `signName` is required")
+	}
+	if n.config.TemplateCode == "" {
+		return nil, errors.New("config `templateCode` is required")
+	}
+	if len(n.config.PhoneNumbers) == 0 {
+		return nil, errors.New("config `phoneNumbers` is required")
+	}
+
+	query := map[string]*string{
+		"PhoneNumbers": tea.String(strings.Join(n.config.PhoneNumbers, ",")),
+		"SignName":     tea.String(n.config.SignName),
+		"TemplateCode": tea.String(n.config.TemplateCode),
+	}
+	if len(n.config.TemplateParams) > 0 {
+		templateParams := make(map[string]string, len(n.config.TemplateParams))
+		for k, v := range n.config.TemplateParams {
+			templateParams[k] = message.RenderTemplate(v)
+		}
+
+		templateParamsJson, err := json.Marshal(templateParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal template params: %w", err)
+		}
+		query["TemplateParam"] = tea.String(string(templateParamsJson))
+	}
+
+	// 发送短信
+	// REF: https://help.aliyun.com/zh/sms/developer-reference/api-dysmsapi-2017-05-25-sendsms
+	sendSmsParams := &aliopen.Params{
+		Action:      tea.String("SendSms"),
+		Version:     tea.String("2017-05-25"),
+		Protocol:    tea.String("HTTPS"),
+		Pathname:    tea.String("/"),
+		Method:      tea.String("POST"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("RPC"),
+		ReqBodyType: tea.String("formData"),
+		BodyType:    tea.String("json"),
+	}
+	sendSmsReq := &aliopen.OpenApiRequest{Query: query}
+	sendSmsResp, err := n.sdkClient.CallApi(sendSmsParams, sendSmsReq, &dara.RuntimeOptions{})
+	n.logger.Debug("sdk request 'dysmsapi.SendSms'", slog.Any("request", sendSmsReq), slog.Any("response", sendSmsResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'dysmsapi.SendSms': %w", err)
+	}
+
+	var sendSmsRespBody struct {
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+		BizId   string `json:"BizId"`
+	}
+	if body, ok := sendSmsResp["body"]; !ok {
+		return nil, errors.New("failed to execute sdk request 'dysmsapi.SendSms': empty response body")
+	} else if bodyJson, err := json.Marshal(body); err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'dysmsapi.SendSms': %w", err)
+	} else if err := json.Unmarshal(bodyJson, &sendSmsRespBody); err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'dysmsapi.SendSms': %w", err)
+	} else if sendSmsRespBody.Code != "OK" {
+		return nil, fmt.Errorf("failed to execute sdk request 'dysmsapi.SendSms': code='%s', message='%s'", sendSmsRespBody.Code, sendSmsRespBody.Message)
+	}
+
+	return &core.NotifyResult{
+		ExtendedData: map[string]any{
+			"bizId": sendSmsRespBody.BizId,
+		},
+	}, nil
+}
+
+func createSDKClient(accessKeyId, accessKeySecret string) (*aliopen.Client, error) {
+	config := &aliopen.Config{
+		Endpoint:        tea.String("dysmsapi.aliyuncs.com"),
+		AccessKeyId:     tea.String(accessKeyId),
+		AccessKeySecret: tea.String(accessKeySecret),
+	}
+
+	client, err := aliopen.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
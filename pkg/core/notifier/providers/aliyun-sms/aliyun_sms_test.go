@@ -0,0 +1,80 @@
+package aliyunsms_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	provider "github.com/certimate-go/certimate/pkg/core/notifier/providers/aliyun-sms"
+)
+
+const (
+	mockSubject = "test_subject"
+	mockMessage = "test_message"
+)
+
+var (
+	fAccessKeyId     string
+	fAccessKeySecret string
+	fSignName        string
+	fTemplateCode    string
+	fPhoneNumber     string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_NOTIFIER_ALIYUNSMS_"
+
+	flag.StringVar(&fAccessKeyId, argsPrefix+"ACCESSKEYID", "", "")
+	flag.StringVar(&fAccessKeySecret, argsPrefix+"ACCESSKEYSECRET", "", "")
+	flag.StringVar(&fSignName, argsPrefix+"SIGNNAME", "", "")
+	flag.StringVar(&fTemplateCode, argsPrefix+"TEMPLATECODE", "", "")
+	flag.StringVar(&fPhoneNumber, argsPrefix+"PHONENUMBER", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./aliyun_sms_test.go -args \
+	--CERTIMATE_NOTIFIER_ALIYUNSMS_ACCESSKEYID="your-access-key-id" \
+	--CERTIMATE_NOTIFIER_ALIYUNSMS_ACCESSKEYSECRET="your-access-key-secret" \
+	--CERTIMATE_NOTIFIER_ALIYUNSMS_SIGNNAME="your-sign-name" \
+	--CERTIMATE_NOTIFIER_ALIYUNSMS_TEMPLATECODE="SMS_123456789" \
+	--CERTIMATE_NOTIFIER_ALIYUNSMS_PHONENUMBER="13800000000"
+*/
+func TestNotify(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Notify", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("ACCESSKEYID: %v", fAccessKeyId),
+			fmt.Sprintf("ACCESSKEYSECRET: %v", fAccessKeySecret),
+			fmt.Sprintf("SIGNNAME: %v", fSignName),
+			fmt.Sprintf("TEMPLATECODE: %v", fTemplateCode),
+			fmt.Sprintf("PHONENUMBER: %v", fPhoneNumber),
+		}, "\n"))
+
+		notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+			AccessKeyId:     fAccessKeyId,
+			AccessKeySecret: fAccessKeySecret,
+			SignName:        fSignName,
+			TemplateCode:    fTemplateCode,
+			TemplateParams:  map[string]string{"subject": "${SUBJECT}"},
+			PhoneNumbers:    []string{fPhoneNumber},
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		res, err := notifier.Notify(context.Background(), mockSubject, mockMessage)
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
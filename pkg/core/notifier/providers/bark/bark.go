@@ -25,7 +25,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -74,3 +74,38 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	const defaultServerURL = "https://api.day.app/"
+	serverUrl := defaultServerURL
+	if n.config.ServerUrl != "" {
+		serverUrl = n.config.ServerUrl
+	}
+
+	// Bark 仅支持纯文本，但可附带一个点击跳转链接，并可按严重程度设置中断级别
+	payload := map[string]any{
+		"title":      message.Subject,
+		"body":       message.PlainText(),
+		"device_key": n.config.DeviceKey,
+	}
+	if len(message.Links) > 0 {
+		payload["url"] = message.Links[0].Url
+	}
+	if message.GetSeverity() == core.NotifySeverityError {
+		payload["level"] = "timeSensitive"
+	}
+
+	// REF: https://bark.day.app/#/tutorial
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(payload)
+	resp, err := req.Post(serverUrl)
+	if err != nil {
+		return nil, fmt.Errorf("bark api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("bark api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
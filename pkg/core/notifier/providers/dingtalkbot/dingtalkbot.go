@@ -24,7 +24,7 @@ type NotifierProvider struct {
 	logger *slog.Logger
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -46,6 +46,35 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	bot, err := n.createBot()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bot.SendTextMessage(subject + "\n" + message); err != nil {
+		return nil, fmt.Errorf("dingtalk api error: %w", err)
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	bot, err := n.createBot()
+	if err != nil {
+		return nil, err
+	}
+
+	// 钉钉 Markdown 消息支持通过 font 标签设置文字颜色
+	// REF: https://open.dingtalk.com/document/orgapp/message-types-and-data-format
+	text := fmt.Sprintf("### <font color=\"%s\">%s</font>\n\n%s", message.GetSeverity().Color(), message.Subject, message.MarkdownText())
+	if err := bot.SendMarkDownMessageWithCtx(ctx, message.Subject, text); err != nil {
+		return nil, fmt.Errorf("dingtalk api error: %w", err)
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+func (n *NotifierProvider) createBot() (*dingtalk.DingTalk, error) {
 	webhookUrl, err := url.Parse(n.config.WebhookUrl)
 	if err != nil {
 		return nil, fmt.Errorf("dingtalk api error: invalid webhook url: %w", err)
@@ -58,9 +87,5 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 		bot = dingtalk.InitDingTalkWithSecret(webhookUrl.Query().Get("access_token"), n.config.Secret)
 	}
 
-	if err := bot.SendTextMessage(subject + "\n" + message); err != nil {
-		return nil, fmt.Errorf("dingtalk api error: %w", err)
-	}
-
-	return &core.NotifyResult{}, nil
+	return bot, nil
 }
@@ -1,10 +1,14 @@
 package discordbot
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 
@@ -24,7 +28,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -49,15 +53,37 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	payload := map[string]any{
+		"embeds": []map[string]any{buildEmbed(message)},
+	}
+
 	// REF: https://discord.com/developers/docs/resources/message#create-message
 	req := n.httpClient.R().
 		SetContext(ctx).
 		SetHeader("Authorization", "Bot "+n.config.BotToken).
-		SetHeader("Content-Type", "application/json").
-		SetHeader("User-Agent", "certimate").
-		SetBody(map[string]any{
-			"content": subject + "\n" + message,
-		})
+		SetHeader("User-Agent", "certimate")
+	if len(message.Attachments) == 0 {
+		req.SetHeader("Content-Type", "application/json").SetBody(payload)
+	} else {
+		// 携带附件时需使用 multipart/form-data 格式上传
+		// REF: https://discord.com/developers/docs/reference#uploading-files
+		attachments := make([]map[string]any, 0, len(message.Attachments))
+		for i, attachment := range message.Attachments {
+			attachments = append(attachments, map[string]any{"id": i, "filename": attachment.Name})
+			req.SetMultipartField(fmt.Sprintf("files[%d]", i), attachment.Name, attachment.ContentType, bytes.NewReader(attachment.Data))
+		}
+		payload["attachments"] = attachments
+
+		payloadJson, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("discord api error: failed to marshal payload: %w", err)
+		}
+		req.SetMultipartField("payload_json", "", "application/json", bytes.NewReader(payloadJson))
+	}
 	resp, err := req.Post(fmt.Sprintf("https://discord.com/api/v9/channels/%s/messages", n.config.ChannelId))
 	if err != nil {
 		return nil, fmt.Errorf("discord api error: failed to send request: %w", err)
@@ -67,3 +93,50 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+// 将结构化消息渲染为 Discord Embed 对象。
+// REF: https://discord.com/developers/docs/resources/message#embed-object
+func buildEmbed(message *core.NotifyMessage) map[string]any {
+	color, _ := strconv.ParseInt(strings.TrimPrefix(message.GetSeverity().Color(), "#"), 16, 64)
+
+	description := message.MarkdownBody()
+	if len(message.Links) > 0 {
+		links := make([]string, 0, len(message.Links))
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			links = append(links, fmt.Sprintf("[%s](%s)", title, link.Url))
+		}
+		if description != "" {
+			description += "\n\n"
+		}
+		description += strings.Join(links, " | ")
+	}
+
+	embed := map[string]any{
+		"title":       message.Subject,
+		"description": description,
+		"color":       color,
+	}
+
+	// 每个 Embed 最多包含 25 个字段
+	if len(message.Fields) > 0 {
+		fields := make([]map[string]any, 0, len(message.Fields))
+		for _, field := range message.Fields[:min(25, len(message.Fields))] {
+			fields = append(fields, map[string]any{
+				"name":   field.Name,
+				"value":  field.Value,
+				"inline": field.Inline,
+			})
+		}
+		embed["fields"] = fields
+	}
+
+	if len(message.Links) > 0 {
+		embed["url"] = message.Links[0].Url
+	}
+
+	return embed
+}
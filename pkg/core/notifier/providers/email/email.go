@@ -1,13 +1,17 @@
 package email
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
+	"html"
 	"log/slog"
 	"net"
 	"net/smtp"
 	"strconv"
+	"strings"
 
 	"github.com/domodwyer/mailyak/v3"
 
@@ -39,7 +43,7 @@ type NotifierProvider struct {
 	logger *slog.Logger
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -61,6 +65,47 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	yak, err := n.createMailer()
+	if err != nil {
+		return nil, err
+	}
+
+	yak.Subject(subject)
+	yak.Plain().Set(message)
+
+	if err := yak.Send(); err != nil {
+		return nil, err
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	yak, err := n.createMailer()
+	if err != nil {
+		return nil, err
+	}
+
+	yak.Subject(message.Subject)
+	yak.Plain().Set(message.PlainText())
+	yak.HTML().Set(renderHTML(message))
+
+	for _, attachment := range message.Attachments {
+		if attachment.ContentType == "" {
+			yak.Attach(attachment.Name, bytes.NewReader(attachment.Data))
+		} else {
+			yak.AttachWithMimeType(attachment.Name, bytes.NewReader(attachment.Data), attachment.ContentType)
+		}
+	}
+
+	if err := yak.Send(); err != nil {
+		return nil, err
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+func (n *NotifierProvider) createMailer() (*mailyak.MailYak, error) {
 	var smtpAuth smtp.Auth
 	if n.config.Username != "" || n.config.Password != "" {
 		smtpAuth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.SmtpHost)
@@ -91,14 +136,18 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 	yak.From(n.config.SenderAddress)
 	yak.FromName(n.config.SenderName)
 	yak.To(n.config.ReceiverAddress)
-	yak.Subject(subject)
-	yak.Plain().Set(message)
 
-	if err := yak.Send(); err != nil {
-		return nil, err
-	}
+	return yak, nil
+}
 
-	return &core.NotifyResult{}, nil
+// 将结构化消息渲染为完整的 HTML 邮件正文，并以严重程度颜色作为标题栏的强调色。
+func renderHTML(message *core.NotifyMessage) string {
+	var sb strings.Builder
+	sb.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8"></head><body style="font-family:sans-serif">`)
+	sb.WriteString(fmt.Sprintf(`<h2 style="border-left:4px solid %s;padding-left:8px">%s</h2>`, message.GetSeverity().Color(), html.EscapeString(message.Subject)))
+	sb.WriteString(message.HTMLText())
+	sb.WriteString(`</body></html>`)
+	return sb.String()
 }
 
 func newTlsConfig() *tls.Config {
@@ -27,7 +27,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -74,3 +74,36 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	serverUrl := strings.TrimRight(n.config.ServerUrl, "/")
+
+	// REF: https://gotify.net/docs/msgextras
+	extras := map[string]any{
+		"client::display": map[string]any{"contentType": "text/markdown"},
+	}
+	if len(message.Links) > 0 {
+		extras["client::notification"] = map[string]any{"click": map[string]any{"url": message.Links[0].Url}}
+	}
+
+	// REF: https://gotify.net/api-docs#/message/createMessage
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Authorization", "Bearer "+n.config.Token).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(map[string]any{
+			"title":    message.Subject,
+			"message":  message.MarkdownText(),
+			"priority": n.config.Priority,
+			"extras":   extras,
+		})
+	resp, err := req.Post(fmt.Sprintf("%s/message", serverUrl))
+	if err != nil {
+		return nil, fmt.Errorf("gotify api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("gotify api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
@@ -2,9 +2,11 @@ package larkbot
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/go-lark/lark"
 
@@ -21,7 +23,7 @@ type NotifierProvider struct {
 	logger *slog.Logger
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -58,3 +60,105 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	card, err := json.Marshal(buildCard(message))
+	if err != nil {
+		return nil, fmt.Errorf("lark api error: failed to marshal card: %w", err)
+	}
+
+	bot := lark.NewNotificationBot(n.config.WebhookUrl)
+	msg := lark.NewMsgBuffer(lark.MsgInteractive).Card(string(card))
+	resp, err := bot.PostNotificationV2(msg.Build())
+	if err != nil {
+		return nil, fmt.Errorf("lark api error: %w", err)
+	} else if resp.Code != 0 {
+		return nil, fmt.Errorf("lark api error: code='%d', message='%s'", resp.Code, resp.Msg)
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+// 将结构化消息渲染为飞书消息卡片。
+// REF: https://open.feishu.cn/document/uAjLw4CM/ukzMukzMukzM/feishu-cards/card-components/component-list
+func buildCard(message *core.NotifyMessage) map[string]any {
+	var template string
+	switch message.GetSeverity() {
+	case core.NotifySeveritySuccess:
+		template = "green"
+	case core.NotifySeverityWarning:
+		template = "orange"
+	case core.NotifySeverityError:
+		template = "red"
+	default:
+		template = "blue"
+	}
+
+	elements := make([]map[string]any, 0)
+
+	if body := message.MarkdownBody(); body != "" {
+		elements = append(elements, map[string]any{
+			"tag":     "markdown",
+			"content": body,
+		})
+	}
+
+	if len(message.Fields) > 0 {
+		fields := make([]map[string]any, 0, len(message.Fields))
+		for _, field := range message.Fields {
+			fields = append(fields, map[string]any{
+				"is_short": field.Inline,
+				"text": map[string]any{
+					"tag":     "lark_md",
+					"content": fmt.Sprintf("**%s**\n%s", field.Name, field.Value),
+				},
+			})
+		}
+		elements = append(elements, map[string]any{
+			"tag":    "div",
+			"fields": fields,
+		})
+	}
+
+	if len(message.Links) > 0 {
+		actions := make([]map[string]any, 0, len(message.Links))
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			actions = append(actions, map[string]any{
+				"tag":  "button",
+				"text": map[string]any{"tag": "plain_text", "content": title},
+				"url":  link.Url,
+				"type": "default",
+			})
+		}
+		elements = append(elements, map[string]any{
+			"tag":     "action",
+			"actions": actions,
+		})
+	}
+
+	if len(message.Attachments) > 0 {
+		names := make([]string, 0, len(message.Attachments))
+		for _, attachment := range message.Attachments {
+			names = append(names, attachment.Name)
+		}
+		elements = append(elements, map[string]any{
+			"tag": "note",
+			"elements": []map[string]any{
+				{"tag": "plain_text", "content": "Attachments: " + strings.Join(names, ", ")},
+			},
+		})
+	}
+
+	return map[string]any{
+		"config": map[string]any{"wide_screen_mode": true},
+		"header": map[string]any{
+			"template": template,
+			"title":    map[string]any{"tag": "plain_text", "content": message.Subject},
+		},
+		"elements": elements,
+	}
+}
@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type NotifierProviderConfig struct {
+	// Matrix Homeserver 地址。
+	HomeserverUrl string `json:"homeserverUrl"`
+	// Matrix 访问令牌。
+	AccessToken string `json:"accessToken"`
+	// Matrix 房间 ID，形如 "!abcdefg:example.com"。
+	RoomId string `json:"roomId"`
+}
+
+type NotifierProvider struct {
+	config     *NotifierProviderConfig
+	logger     *slog.Logger
+	httpClient *resty.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client := resty.New()
+
+	return &NotifierProvider{
+		config:     config,
+		logger:     slog.Default(),
+		httpClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if n.config.RoomId == "" {
+		return nil, errors.New("config `roomId` is required")
+	}
+
+	serverUrl := strings.TrimRight(n.config.HomeserverUrl, "/")
+	txnId := fmt.Sprintf("certimate_%d", time.Now().UnixNano())
+
+	// Matrix 客户端支持 HTML 子集，可通过 data-mx-color 属性设置文字颜色
+	// REF: https://spec.matrix.org/latest/client-server-api/#mroommessage-msgtypes
+	formattedBody := fmt.Sprintf("<h4><font data-mx-color=\"%s\">%s</font></h4>%s", message.GetSeverity().Color(), html.EscapeString(message.Subject), message.HTMLText())
+
+	// REF: https://spec.matrix.org/latest/client-server-api/#put_matrixclientv3roomsroomidsendeventtypetxnid
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Authorization", "Bearer "+n.config.AccessToken).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(map[string]any{
+			"msgtype":        "m.text",
+			"body":           message.Subject + "\n" + message.PlainText(),
+			"format":         "org.matrix.custom.html",
+			"formatted_body": formattedBody,
+		})
+	resp, err := req.Put(fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s", serverUrl, url.PathEscape(n.config.RoomId), url.PathEscape(txnId)))
+	if err != nil {
+		return nil, fmt.Errorf("matrix api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("matrix api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
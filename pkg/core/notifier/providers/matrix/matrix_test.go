@@ -0,0 +1,72 @@
+package matrix_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	provider "github.com/certimate-go/certimate/pkg/core/notifier/providers/matrix"
+)
+
+const (
+	mockSubject = "test_subject"
+	mockMessage = "test_message"
+)
+
+func TestNotify(t *testing.T) {
+	var (
+		reqMethod string
+		reqPath   string
+		reqAuth   string
+		reqBody   map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqMethod = r.Method
+		reqPath = r.URL.EscapedPath()
+		reqAuth = r.Header.Get("Authorization")
+		reqBody = make(map[string]any)
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		w.Write([]byte(`{"event_id":"$mock"}`))
+	}))
+	defer server.Close()
+
+	notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+		HomeserverUrl: server.URL + "/",
+		AccessToken:   "syt_mock",
+		RoomId:        "!room:example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := notifier.NotifyMessage(context.Background(), &core.NotifyMessage{
+		Subject: mockSubject,
+		Body:    mockMessage,
+		Fields:  []core.NotifyMessageField{{Name: "Domain", Value: "example.com"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if reqMethod != http.MethodPut {
+		t.Errorf("unexpected method: %s", reqMethod)
+	}
+	if !strings.HasPrefix(reqPath, "/_matrix/client/v3/rooms/%21room:example.com/send/m.room.message/certimate_") {
+		t.Errorf("unexpected path: %s", reqPath)
+	}
+	if reqAuth != "Bearer syt_mock" {
+		t.Errorf("unexpected authorization: %s", reqAuth)
+	}
+	if reqBody["msgtype"] != "m.text" || reqBody["format"] != "org.matrix.custom.html" {
+		t.Errorf("unexpected body: %v", reqBody)
+	}
+	if body, _ := reqBody["body"].(string); !strings.Contains(body, "Domain: example.com") {
+		t.Errorf("unexpected plain body: %s", body)
+	}
+	if formattedBody, _ := reqBody["formatted_body"].(string); !strings.Contains(formattedBody, "<th align=\"left\">Domain</th>") {
+		t.Errorf("unexpected formatted body: %s", formattedBody)
+	}
+}
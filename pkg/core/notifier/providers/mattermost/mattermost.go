@@ -29,7 +29,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -54,6 +54,54 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.createPost(ctx, map[string]any{
+		"attachments": []map[string]any{
+			{
+				"title": subject,
+				"text":  message,
+			},
+		},
+	})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	// REF: https://developers.mattermost.com/integrate/reference/message-attachments/
+	attachment := map[string]any{
+		"color": message.GetSeverity().Color(),
+		"title": message.Subject,
+		"text":  message.MarkdownBody(),
+	}
+	if len(message.Fields) > 0 {
+		fields := make([]map[string]any, 0, len(message.Fields))
+		for _, field := range message.Fields {
+			fields = append(fields, map[string]any{
+				"title": field.Name,
+				"value": field.Value,
+				"short": field.Inline,
+			})
+		}
+		attachment["fields"] = fields
+	}
+	if len(message.Links) > 0 {
+		attachment["title_link"] = message.Links[0].Url
+
+		links := make([]string, 0, len(message.Links))
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			links = append(links, fmt.Sprintf("[%s](%s)", title, link.Url))
+		}
+		attachment["footer"] = strings.Join(links, " | ")
+	}
+
+	return n.createPost(ctx, map[string]any{
+		"attachments": []map[string]any{attachment},
+	})
+}
+
+func (n *NotifierProvider) createPost(ctx context.Context, props map[string]any) (*core.NotifyResult, error) {
 	serverUrl := strings.TrimRight(n.config.ServerUrl, "/")
 
 	// REF: https://developers.mattermost.com/api-documentation/#/operations/Login
@@ -82,14 +130,7 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 		SetHeader("User-Agent", "certimate").
 		SetBody(map[string]any{
 			"channel_id": n.config.ChannelId,
-			"props": map[string]interface{}{
-				"attachments": []map[string]interface{}{
-					{
-						"title": subject,
-						"text":  message,
-					},
-				},
-			},
+			"props":      props,
 		})
 	postResp, err := postReq.Post(fmt.Sprintf("%s/api/v4/posts", serverUrl))
 	if err != nil {
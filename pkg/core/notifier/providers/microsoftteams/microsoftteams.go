@@ -0,0 +1,150 @@
+package microsoftteams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type NotifierProviderConfig struct {
+	// Microsoft Teams Workflows（Power Automate）Webhook 地址。
+	WebhookUrl string `json:"webhookUrl"`
+}
+
+type NotifierProvider struct {
+	config     *NotifierProviderConfig
+	logger     *slog.Logger
+	httpClient *resty.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client := resty.New()
+
+	return &NotifierProvider{
+		config:     config,
+		logger:     slog.Default(),
+		httpClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	// REF: https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using#send-adaptive-cards-using-an-incoming-webhook
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(map[string]any{
+			"type": "message",
+			"attachments": []map[string]any{
+				{
+					"contentType": "application/vnd.microsoft.card.adaptive",
+					"content":     buildAdaptiveCard(message),
+				},
+			},
+		})
+	resp, err := req.Post(n.config.WebhookUrl)
+	if err != nil {
+		return nil, fmt.Errorf("microsoft teams api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("microsoft teams api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+// 将结构化消息渲染为 Adaptive Card。
+// REF: https://adaptivecards.io/explorer/
+func buildAdaptiveCard(message *core.NotifyMessage) map[string]any {
+	var color string
+	switch message.GetSeverity() {
+	case core.NotifySeveritySuccess:
+		color = "Good"
+	case core.NotifySeverityWarning:
+		color = "Warning"
+	case core.NotifySeverityError:
+		color = "Attention"
+	default:
+		color = "Accent"
+	}
+
+	body := []map[string]any{
+		{
+			"type":   "TextBlock",
+			"text":   message.Subject,
+			"size":   "Medium",
+			"weight": "Bolder",
+			"color":  color,
+			"wrap":   true,
+		},
+	}
+
+	if text := message.MarkdownBody(); text != "" {
+		body = append(body, map[string]any{
+			"type": "TextBlock",
+			"text": text,
+			"wrap": true,
+		})
+	}
+
+	if len(message.Fields) > 0 {
+		facts := make([]map[string]any, 0, len(message.Fields))
+		for _, field := range message.Fields {
+			facts = append(facts, map[string]any{
+				"title": field.Name,
+				"value": field.Value,
+			})
+		}
+		body = append(body, map[string]any{
+			"type":  "FactSet",
+			"facts": facts,
+		})
+	}
+
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+
+	if len(message.Links) > 0 {
+		actions := make([]map[string]any, 0, len(message.Links))
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			actions = append(actions, map[string]any{
+				"type":  "Action.OpenUrl",
+				"title": title,
+				"url":   link.Url,
+			})
+		}
+		card["actions"] = actions
+	}
+
+	return card
+}
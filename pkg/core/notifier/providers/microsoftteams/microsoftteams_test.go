@@ -0,0 +1,88 @@
+package microsoftteams_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	provider "github.com/certimate-go/certimate/pkg/core/notifier/providers/microsoftteams"
+)
+
+const (
+	mockSubject = "test_subject"
+	mockMessage = "test_message"
+)
+
+func TestNotify(t *testing.T) {
+	var reqBody struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type string           `json:"type"`
+				Body []map[string]any `json:"body"`
+				Actions []map[string]any `json:"actions"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+		WebhookUrl: server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := notifier.NotifyMessage(context.Background(), &core.NotifyMessage{
+		Subject:  mockSubject,
+		Body:     mockMessage,
+		Severity: core.NotifySeverityWarning,
+		Fields:   []core.NotifyMessageField{{Name: "Domain", Value: "example.com"}},
+		Links:    []core.NotifyMessageLink{{Title: "View", Url: "https://example.com"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if reqBody.Type != "message" || len(reqBody.Attachments) != 1 {
+		t.Fatalf("unexpected body: %+v", reqBody)
+	}
+
+	card := reqBody.Attachments[0]
+	if card.ContentType != "application/vnd.microsoft.card.adaptive" || card.Content.Type != "AdaptiveCard" {
+		t.Errorf("unexpected attachment: %+v", card)
+	}
+	if len(card.Content.Body) != 3 {
+		t.Fatalf("expected 3 card elements, got %d", len(card.Content.Body))
+	}
+	if card.Content.Body[0]["text"] != mockSubject || card.Content.Body[0]["color"] != "Warning" {
+		t.Errorf("unexpected title block: %v", card.Content.Body[0])
+	}
+	if card.Content.Body[2]["type"] != "FactSet" {
+		t.Errorf("unexpected fact set: %v", card.Content.Body[2])
+	}
+	if len(card.Content.Actions) != 1 || card.Content.Actions[0]["url"] != "https://example.com" {
+		t.Errorf("unexpected actions: %v", card.Content.Actions)
+	}
+}
+
+func TestNotifyFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	notifier, _ := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+		WebhookUrl: server.URL,
+	})
+	if _, err := notifier.Notify(context.Background(), mockSubject, mockMessage); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,159 @@
+package ntfy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type NotifierProviderConfig struct {
+	// ntfy 服务地址。
+	// 零值时默认值 "https://ntfy.sh"。
+	ServerUrl string `json:"serverUrl,omitempty"`
+	// ntfy 访问令牌。
+	// 与 Username、Password 二选一。
+	AccessToken string `json:"accessToken,omitempty"`
+	// ntfy 用户名。
+	Username string `json:"username,omitempty"`
+	// ntfy 密码。
+	Password string `json:"password,omitempty"`
+	// 主题。
+	Topic string `json:"topic"`
+	// 消息优先级，取值范围 1~5。
+	// 零值时使用服务端默认优先级。
+	Priority int32 `json:"priority,omitempty"`
+	// 消息标签。
+	Tags []string `json:"tags,omitempty"`
+}
+
+type NotifierProvider struct {
+	config     *NotifierProviderConfig
+	logger     *slog.Logger
+	httpClient *resty.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client := resty.New()
+	if config.AccessToken != "" {
+		client.SetAuthToken(config.AccessToken)
+	} else if config.Username != "" || config.Password != "" {
+		client.SetBasicAuth(config.Username, config.Password)
+	}
+
+	return &NotifierProvider{
+		config:     config,
+		logger:     slog.Default(),
+		httpClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.publish(ctx, map[string]any{
+		"title":   subject,
+		"message": message,
+	})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	payload := map[string]any{
+		"title":    message.Subject,
+		"message":  message.MarkdownText(),
+		"markdown": true,
+	}
+
+	// 根据严重程度追加表情标签，未指定优先级时错误消息提升为高优先级
+	// REF: https://docs.ntfy.sh/publish/#tags-emojis
+	switch message.GetSeverity() {
+	case core.NotifySeveritySuccess:
+		payload["tags"] = []string{"white_check_mark"}
+	case core.NotifySeverityWarning:
+		payload["tags"] = []string{"warning"}
+	case core.NotifySeverityError:
+		payload["tags"] = []string{"rotating_light"}
+		if n.config.Priority == 0 {
+			payload["priority"] = 4
+		}
+	}
+
+	// 每条消息最多支持 3 个操作按钮
+	// REF: https://docs.ntfy.sh/publish/#action-buttons
+	if len(message.Links) > 0 {
+		payload["click"] = message.Links[0].Url
+
+		actions := make([]map[string]any, 0)
+		for _, link := range message.Links[:min(3, len(message.Links))] {
+			label := link.Title
+			if label == "" {
+				label = link.Url
+			}
+			actions = append(actions, map[string]any{
+				"action": "view",
+				"label":  label,
+				"url":    link.Url,
+			})
+		}
+		payload["actions"] = actions
+	}
+
+	return n.publish(ctx, payload)
+}
+
+func (n *NotifierProvider) publish(ctx context.Context, payload map[string]any) (*core.NotifyResult, error) {
+	if n.config.Topic == "" {
+		return nil, errors.New("config `topic` is required")
+	}
+
+	const defaultServerURL = "https://ntfy.sh"
+	serverUrl := defaultServerURL
+	if n.config.ServerUrl != "" {
+		serverUrl = strings.TrimRight(n.config.ServerUrl, "/")
+	}
+
+	payload["topic"] = n.config.Topic
+	if n.config.Priority != 0 {
+		payload["priority"] = n.config.Priority
+	}
+	if len(n.config.Tags) > 0 {
+		tags := make([]string, 0)
+		tags = append(tags, n.config.Tags...)
+		if severityTags, ok := payload["tags"].([]string); ok {
+			tags = append(tags, severityTags...)
+		}
+		payload["tags"] = tags
+	}
+
+	// REF: https://docs.ntfy.sh/publish/#publish-as-json
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(payload)
+	resp, err := req.Post(serverUrl)
+	if err != nil {
+		return nil, fmt.Errorf("ntfy api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("ntfy api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
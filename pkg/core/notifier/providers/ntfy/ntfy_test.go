@@ -0,0 +1,92 @@
+package ntfy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	provider "github.com/certimate-go/certimate/pkg/core/notifier/providers/ntfy"
+)
+
+const (
+	mockSubject = "test_subject"
+	mockMessage = "test_message"
+)
+
+func TestNotify(t *testing.T) {
+	var (
+		reqAuth string
+		reqBody map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqAuth = r.Header.Get("Authorization")
+		reqBody = make(map[string]any)
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		w.Write([]byte(`{"id":"mock","event":"message"}`))
+	}))
+	defer server.Close()
+
+	t.Run("Notify", func(t *testing.T) {
+		notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+			ServerUrl:   server.URL,
+			AccessToken: "tk_mock",
+			Topic:       "certimate",
+			Priority:    5,
+			Tags:        []string{"lock"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := notifier.Notify(context.Background(), mockSubject, mockMessage); err != nil {
+			t.Fatal(err)
+		}
+
+		if reqAuth != "Bearer tk_mock" {
+			t.Errorf("unexpected authorization: %s", reqAuth)
+		}
+		if reqBody["topic"] != "certimate" || reqBody["title"] != mockSubject || reqBody["message"] != mockMessage {
+			t.Errorf("unexpected body: %v", reqBody)
+		}
+		if reqBody["priority"] != float64(5) {
+			t.Errorf("unexpected priority: %v", reqBody["priority"])
+		}
+		if tags, _ := reqBody["tags"].([]any); len(tags) != 1 || tags[0] != "lock" {
+			t.Errorf("unexpected tags: %v", reqBody["tags"])
+		}
+	})
+
+	t.Run("NotifyMessage", func(t *testing.T) {
+		notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+			ServerUrl: server.URL,
+			Username:  "user",
+			Password:  "pass",
+			Topic:     "certimate",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := notifier.NotifyMessage(context.Background(), &core.NotifyMessage{
+			Subject:  mockSubject,
+			Body:     mockMessage,
+			Severity: core.NotifySeverityError,
+			Links:    []core.NotifyMessageLink{{Title: "View", Url: "https://example.com"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if reqAuth != "Basic dXNlcjpwYXNz" {
+			t.Errorf("unexpected authorization: %s", reqAuth)
+		}
+		if reqBody["markdown"] != true || reqBody["priority"] != float64(4) || reqBody["click"] != "https://example.com" {
+			t.Errorf("unexpected body: %v", reqBody)
+		}
+		if actions, _ := reqBody["actions"].([]any); len(actions) != 1 {
+			t.Errorf("unexpected actions: %v", reqBody["actions"])
+		}
+	})
+}
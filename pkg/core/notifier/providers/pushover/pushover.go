@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"log/slog"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 
@@ -24,7 +26,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -69,3 +71,43 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	// Pushover 支持 HTML 子集，并可附带一个补充链接
+	// REF: https://pushover.net/api#html
+	var sb strings.Builder
+	sb.WriteString(html.EscapeString(message.PlainBody()))
+	for _, field := range message.Fields {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("<b>%s</b>: %s", html.EscapeString(field.Name), html.EscapeString(field.Value)))
+	}
+
+	payload := map[string]any{
+		"title":   message.Subject,
+		"message": sb.String(),
+		"html":    1,
+		"token":   n.config.Token,
+		"user":    n.config.User,
+	}
+	if len(message.Links) > 0 {
+		payload["url"] = message.Links[0].Url
+		payload["url_title"] = message.Links[0].Title
+	}
+
+	// REF: https://pushover.net/api
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(payload)
+	resp, err := req.Post("https://api.pushover.net/1/messages.json")
+	if err != nil {
+		return nil, fmt.Errorf("pushover api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("pushover api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
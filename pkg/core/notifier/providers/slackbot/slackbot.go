@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 
@@ -24,7 +25,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -49,6 +50,10 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
 	// REF: https://docs.slack.dev/messaging/sending-and-scheduling-messages#publishing
 	req := n.httpClient.R().
 		SetContext(ctx).
@@ -58,7 +63,13 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 		SetBody(map[string]any{
 			"token":   n.config.BotToken,
 			"channel": n.config.ChannelId,
-			"text":    subject + "\n" + message,
+			"text":    message.Subject + "\n" + message.PlainText(),
+			"attachments": []map[string]any{
+				{
+					"color":  message.GetSeverity().Color(),
+					"blocks": buildBlocks(message),
+				},
+			},
 		})
 	resp, err := req.Post("https://slack.com/api/chat.postMessage")
 	if err != nil {
@@ -69,3 +80,61 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+// 将结构化消息渲染为 Slack Block Kit 块。
+// REF: https://docs.slack.dev/reference/block-kit/blocks
+func buildBlocks(message *core.NotifyMessage) []map[string]any {
+	blocks := make([]map[string]any, 0)
+
+	if message.Subject != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "header",
+			"text": map[string]any{"type": "plain_text", "text": message.Subject},
+		})
+	}
+
+	if body := message.MarkdownBody(); body != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": body},
+		})
+	}
+
+	// 每个 section 块最多包含 10 个字段
+	for i := 0; i < len(message.Fields); i += 10 {
+		fields := make([]map[string]any, 0)
+		for _, field := range message.Fields[i:min(i+10, len(message.Fields))] {
+			fields = append(fields, map[string]any{"type": "mrkdwn", "text": fmt.Sprintf("*%s*\n%s", field.Name, field.Value)})
+		}
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	}
+
+	if len(message.Links) > 0 {
+		elements := make([]map[string]any, 0)
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			elements = append(elements, map[string]any{
+				"type": "button",
+				"text": map[string]any{"type": "plain_text", "text": title},
+				"url":  link.Url,
+			})
+		}
+		blocks = append(blocks, map[string]any{"type": "actions", "elements": elements})
+	}
+
+	if len(message.Attachments) > 0 {
+		names := make([]string, 0, len(message.Attachments))
+		for _, attachment := range message.Attachments {
+			names = append(names, "`"+attachment.Name+"`")
+		}
+		blocks = append(blocks, map[string]any{
+			"type":     "context",
+			"elements": []map[string]any{{"type": "mrkdwn", "text": "Attachments: " + strings.Join(names, ", ")}},
+		})
+	}
+
+	return blocks
+}
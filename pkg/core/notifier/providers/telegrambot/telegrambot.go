@@ -1,10 +1,13 @@
 package telegrambot
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"log/slog"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 
@@ -24,7 +27,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -67,3 +70,72 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	// REF: https://core.telegram.org/bots/api#sendmessage
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(map[string]any{
+			"chat_id":    n.config.ChatId,
+			"text":       renderHTML(message),
+			"parse_mode": "HTML",
+		})
+	resp, err := req.Post(fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", n.config.BotToken))
+	if err != nil {
+		return nil, fmt.Errorf("telegram api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("telegram api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	// REF: https://core.telegram.org/bots/api#senddocument
+	for _, attachment := range message.Attachments {
+		req := n.httpClient.R().
+			SetContext(ctx).
+			SetHeader("User-Agent", "certimate").
+			SetFormData(map[string]string{"chat_id": fmt.Sprintf("%d", n.config.ChatId)}).
+			SetMultipartField("document", attachment.Name, attachment.ContentType, bytes.NewReader(attachment.Data))
+		resp, err := req.Post(fmt.Sprintf("https://api.telegram.org/bot%s/sendDocument", n.config.BotToken))
+		if err != nil {
+			return nil, fmt.Errorf("telegram api error: failed to send request: %w", err)
+		} else if resp.IsError() {
+			return nil, fmt.Errorf("telegram api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+		}
+	}
+
+	return &core.NotifyResult{}, nil
+}
+
+// 将结构化消息渲染为 Telegram 支持的 HTML 子集。
+// Telegram 仅支持少量内联标签，不支持表格与换行标签，因此需单独渲染。
+// REF: https://core.telegram.org/bots/api#html-style
+func renderHTML(message *core.NotifyMessage) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<b>%s</b>", html.EscapeString(message.Subject)))
+
+	if body := message.PlainBody(); body != "" {
+		sb.WriteString("\n\n")
+		sb.WriteString(html.EscapeString(body))
+	}
+
+	if len(message.Fields) > 0 {
+		sb.WriteString("\n")
+		for _, field := range message.Fields {
+			sb.WriteString(fmt.Sprintf("\n<b>%s</b>: %s", html.EscapeString(field.Name), html.EscapeString(field.Value)))
+		}
+	}
+
+	if len(message.Links) > 0 {
+		sb.WriteString("\n")
+		for _, link := range message.Links {
+			title := link.Title
+			if title == "" {
+				title = link.Url
+			}
+			sb.WriteString(fmt.Sprintf("\n<a href=\"%s\">%s</a>", html.EscapeString(link.Url), html.EscapeString(title)))
+		}
+	}
+
+	return sb.String()
+}
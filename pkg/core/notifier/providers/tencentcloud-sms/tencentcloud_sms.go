@@ -0,0 +1,159 @@
+package tencentcloudsms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+type NotifierProviderConfig struct {
+	// 腾讯云 SecretId。
+	SecretId string `json:"secretId"`
+	// 腾讯云 SecretKey。
+	SecretKey string `json:"secretKey"`
+	// 腾讯云地域。
+	// 零值时默认值 "ap-guangzhou"。
+	Region string `json:"region,omitempty"`
+	// 短信 SdkAppId。
+	SmsSdkAppId string `json:"smsSdkAppId"`
+	// 短信签名内容。
+	SignName string `json:"signName"`
+	// 短信模板 ID。
+	TemplateId string `json:"templateId"`
+	// 短信模板参数列表，按模板中变量的顺序排列。
+	// 每个值均为支持变量替换的字符串，参见 [core.NotifyMessage.TemplateVariables]。
+	TemplateParams []string `json:"templateParams,omitempty"`
+	// 接收短信的手机号码列表，采用 E.164 标准格式，形如 "+8613711112222"。
+	PhoneNumbers []string `json:"phoneNumbers"`
+}
+
+type NotifierProvider struct {
+	config    *NotifierProviderConfig
+	logger    *slog.Logger
+	sdkClient *common.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client, err := createSDKClient(config.SecretId, config.SecretKey, config.Region)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &NotifierProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if n.config.SmsSdkAppId == "" {
+		return nil, errors.New("config `smsSdkAppId` is required")
+	}
+	if n.config.TemplateId == "" {
+		return nil, errors.New("config `templateId` is required")
+	}
+	if len(n.config.PhoneNumbers) == 0 {
+		return nil, errors.New("config `phoneNumbers` is required")
+	}
+
+	templateParams := make([]string, 0, len(n.config.TemplateParams))
+	for _, param := range n.config.TemplateParams {
+		templateParams = append(templateParams, message.RenderTemplate(param))
+	}
+
+	// 发送短信
+	// REF: https://cloud.tencent.com/document/api/382/55981
+	sendSmsReq := tchttp.NewCommonRequest("sms", "2021-01-11", "SendSms")
+	sendSmsReq.SetContext(ctx)
+	if err := sendSmsReq.SetActionParameters(map[string]any{
+		"SmsSdkAppId":      n.config.SmsSdkAppId,
+		"SignName":         n.config.SignName,
+		"TemplateId":       n.config.TemplateId,
+		"TemplateParamSet": templateParams,
+		"PhoneNumberSet":   n.config.PhoneNumbers,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to build sdk request 'sms.SendSms': %w", err)
+	}
+	sendSmsResp := tchttp.NewCommonResponse()
+	err := n.sdkClient.Send(sendSmsReq, sendSmsResp)
+	n.logger.Debug("sdk request 'sms.SendSms'", slog.Any("request", sendSmsReq), slog.Any("response", sendSmsResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'sms.SendSms': %w", err)
+	}
+
+	var sendSmsRespBody struct {
+		Response struct {
+			SendStatusSet []struct {
+				SerialNo    string `json:"SerialNo"`
+				PhoneNumber string `json:"PhoneNumber"`
+				Code        string `json:"Code"`
+				Message     string `json:"Message"`
+			} `json:"SendStatusSet"`
+		} `json:"Response"`
+	}
+	if err := json.Unmarshal(sendSmsResp.GetBody(), &sendSmsRespBody); err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'sms.SendSms': %w", err)
+	}
+
+	// 部分号码可能发送失败，需逐个检查发送状态
+	serialNos := make([]string, 0)
+	failures := make([]string, 0)
+	for _, status := range sendSmsRespBody.Response.SendStatusSet {
+		if strings.EqualFold(status.Code, "Ok") {
+			serialNos = append(serialNos, status.SerialNo)
+		} else {
+			failures = append(failures, fmt.Sprintf("%s: code='%s', message='%s'", status.PhoneNumber, status.Code, status.Message))
+		}
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("failed to execute sdk request 'sms.SendSms': %s", strings.Join(failures, "; "))
+	}
+
+	return &core.NotifyResult{
+		ExtendedData: map[string]any{
+			"serialNos": serialNos,
+		},
+	}, nil
+}
+
+func createSDKClient(secretId, secretKey, region string) (*common.Client, error) {
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+
+	credential := common.NewCredential(secretId, secretKey)
+
+	cpf := profile.NewClientProfile()
+	cpf.HttpProfile.Endpoint = "sms.tencentcloudapi.com"
+
+	client := common.NewCommonClient(credential, region, cpf)
+	return client, nil
+}
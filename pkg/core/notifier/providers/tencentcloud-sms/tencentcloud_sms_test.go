@@ -0,0 +1,85 @@
+package tencentcloudsms_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	provider "github.com/certimate-go/certimate/pkg/core/notifier/providers/tencentcloud-sms"
+)
+
+const (
+	mockSubject = "test_subject"
+	mockMessage = "test_message"
+)
+
+var (
+	fSecretId    string
+	fSecretKey   string
+	fSmsSdkAppId string
+	fSignName    string
+	fTemplateId  string
+	fPhoneNumber string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_"
+
+	flag.StringVar(&fSecretId, argsPrefix+"SECRETID", "", "")
+	flag.StringVar(&fSecretKey, argsPrefix+"SECRETKEY", "", "")
+	flag.StringVar(&fSmsSdkAppId, argsPrefix+"SMSSDKAPPID", "", "")
+	flag.StringVar(&fSignName, argsPrefix+"SIGNNAME", "", "")
+	flag.StringVar(&fTemplateId, argsPrefix+"TEMPLATEID", "", "")
+	flag.StringVar(&fPhoneNumber, argsPrefix+"PHONENUMBER", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./tencentcloud_sms_test.go -args \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_SECRETID="your-secret-id" \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_SECRETKEY="your-secret-key" \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_SMSSDKAPPID="1400000000" \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_SIGNNAME="your-sign-name" \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_TEMPLATEID="123456" \
+	--CERTIMATE_NOTIFIER_TENCENTCLOUDSMS_PHONENUMBER="+8613800000000"
+*/
+func TestNotify(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Notify", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("SECRETID: %v", fSecretId),
+			fmt.Sprintf("SECRETKEY: %v", fSecretKey),
+			fmt.Sprintf("SMSSDKAPPID: %v", fSmsSdkAppId),
+			fmt.Sprintf("SIGNNAME: %v", fSignName),
+			fmt.Sprintf("TEMPLATEID: %v", fTemplateId),
+			fmt.Sprintf("PHONENUMBER: %v", fPhoneNumber),
+		}, "\n"))
+
+		notifier, err := provider.NewNotifierProvider(&provider.NotifierProviderConfig{
+			SecretId:       fSecretId,
+			SecretKey:      fSecretKey,
+			SmsSdkAppId:    fSmsSdkAppId,
+			SignName:       fSignName,
+			TemplateId:     fTemplateId,
+			TemplateParams: []string{"${SUBJECT}"},
+			PhoneNumbers:   []string{fPhoneNumber},
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		res, err := notifier.Notify(context.Background(), mockSubject, mockMessage)
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
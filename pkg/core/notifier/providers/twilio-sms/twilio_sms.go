@@ -0,0 +1,134 @@
+package twiliosms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	twiliosdk "github.com/certimate-go/certimate/pkg/sdk3rd/twilio"
+)
+
+type NotifierProviderConfig struct {
+	// Twilio Account SID。
+	AccountSid string `json:"accountSid"`
+	// Twilio Auth Token。
+	AuthToken string `json:"authToken"`
+	// 发送方号码，采用 E.164 标准格式。
+	// 与 MessagingServiceSid 二选一。
+	FromNumber string `json:"fromNumber,omitempty"`
+	// Messaging Service SID。
+	// 与 FromNumber 二选一。
+	MessagingServiceSid string `json:"messagingServiceSid,omitempty"`
+	// 接收短信的手机号码列表，采用 E.164 标准格式。
+	ToNumbers []string `json:"toNumbers"`
+	// 内容模板 SID。
+	// 零值时将直接发送消息正文。
+	ContentSid string `json:"contentSid,omitempty"`
+	// 内容模板变量映射。
+	// 键为模板中的变量序号，值为支持变量替换的字符串，参见 [core.NotifyMessage.TemplateVariables]。
+	ContentVariables map[string]string `json:"contentVariables,omitempty"`
+	// 消息正文模板，仅在未指定内容模板 SID 时有效。
+	// 零值时默认值 "${SUBJECT}\n${MESSAGE}"。
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+}
+
+type NotifierProvider struct {
+	config    *NotifierProviderConfig
+	logger    *slog.Logger
+	sdkClient *twiliosdk.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client, err := twiliosdk.NewClient(config.AccountSid, config.AuthToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &NotifierProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if n.config.FromNumber == "" && n.config.MessagingServiceSid == "" {
+		return nil, errors.New("config `fromNumber` or `messagingServiceSid` is required")
+	}
+	if len(n.config.ToNumbers) == 0 {
+		return nil, errors.New("config `toNumbers` is required")
+	}
+
+	var body, contentVariables string
+	if n.config.ContentSid == "" {
+		bodyTemplate := n.config.BodyTemplate
+		if bodyTemplate == "" {
+			bodyTemplate = "${SUBJECT}\n${MESSAGE}"
+		}
+		body = message.RenderTemplate(bodyTemplate)
+	} else if len(n.config.ContentVariables) > 0 {
+		variables := make(map[string]string, len(n.config.ContentVariables))
+		for k, v := range n.config.ContentVariables {
+			variables[k] = message.RenderTemplate(v)
+		}
+
+		variablesJson, err := json.Marshal(variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal content variables: %w", err)
+		}
+		contentVariables = string(variablesJson)
+	}
+
+	// 逐个号码发送短信，任一号码失败不影响其他号码
+	messageSids := make([]string, 0)
+	failures := make([]string, 0)
+	for _, toNumber := range n.config.ToNumbers {
+		createMessageReq := &twiliosdk.CreateMessageRequest{
+			To:                  toNumber,
+			From:                n.config.FromNumber,
+			MessagingServiceSid: n.config.MessagingServiceSid,
+			Body:                body,
+			ContentSid:          n.config.ContentSid,
+			ContentVariables:    contentVariables,
+		}
+		createMessageResp, err := n.sdkClient.CreateMessageWithContext(ctx, createMessageReq)
+		n.logger.Debug("sdk request 'twilio.CreateMessage'", slog.Any("request", createMessageReq), slog.Any("response", createMessageResp))
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", toNumber, err.Error()))
+			continue
+		}
+
+		messageSids = append(messageSids, createMessageResp.Sid)
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("failed to execute sdk request 'twilio.CreateMessage': %s", strings.Join(failures, "; "))
+	}
+
+	return &core.NotifyResult{
+		ExtendedData: map[string]any{
+			"messageSids": messageSids,
+		},
+	}, nil
+}
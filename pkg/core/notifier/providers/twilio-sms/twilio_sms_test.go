@@ -0,0 +1,125 @@
+package twiliosms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+func TestNotifyMessage(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		forms = make([]map[string]string, 0)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Messages.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if username, password, _ := r.BasicAuth(); username != "AC_mock" || password != "token_mock" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_ = r.ParseForm()
+		form := make(map[string]string)
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		mu.Lock()
+		forms = append(forms, form)
+		mu.Unlock()
+
+		if form["To"] == "+15550000000" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":21211,"message":"Invalid 'To' Phone Number","status":400}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"sid":"SM_mock","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	newNotifier := func(config *NotifierProviderConfig) *NotifierProvider {
+		config.AccountSid = "AC_mock"
+		config.AuthToken = "token_mock"
+		notifier, err := NewNotifierProvider(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		notifier.sdkClient.SetBaseURL(server.URL)
+		return notifier
+	}
+
+	message := &core.NotifyMessage{
+		Subject: "Certificate expiring",
+		Body:    "renewal failed",
+		Fields:  []core.NotifyMessageField{{Name: "Domain", Value: "example.com"}},
+	}
+
+	t.Run("Body", func(t *testing.T) {
+		forms = forms[:0]
+		notifier := newNotifier(&NotifierProviderConfig{
+			FromNumber: "+15551111111",
+			ToNumbers:  []string{"+15552222222", "+15553333333"},
+		})
+		res, err := notifier.NotifyMessage(context.Background(), message)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(forms) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(forms))
+		}
+		if forms[0]["From"] != "+15551111111" || forms[0]["Body"] != "Certificate expiring\nrenewal failed" {
+			t.Errorf("unexpected form: %v", forms[0])
+		}
+		if sids := res.ExtendedData["messageSids"].([]string); len(sids) != 2 {
+			t.Errorf("unexpected message sids: %v", sids)
+		}
+	})
+
+	t.Run("ContentTemplate", func(t *testing.T) {
+		forms = forms[:0]
+		notifier := newNotifier(&NotifierProviderConfig{
+			MessagingServiceSid: "MG_mock",
+			ToNumbers:           []string{"+15552222222"},
+			ContentSid:          "HX_mock",
+			ContentVariables:    map[string]string{"1": "${FIELD:Domain}", "2": "${SUBJECT}"},
+		})
+		if _, err := notifier.NotifyMessage(context.Background(), message); err != nil {
+			t.Fatal(err)
+		}
+
+		if forms[0]["ContentSid"] != "HX_mock" || forms[0]["MessagingServiceSid"] != "MG_mock" || forms[0]["Body"] != "" {
+			t.Errorf("unexpected form: %v", forms[0])
+		}
+
+		variables := make(map[string]string)
+		_ = json.Unmarshal([]byte(forms[0]["ContentVariables"]), &variables)
+		if variables["1"] != "example.com" || variables["2"] != "Certificate expiring" {
+			t.Errorf("unexpected content variables: %v", variables)
+		}
+	})
+
+	t.Run("PartialFailure", func(t *testing.T) {
+		forms = forms[:0]
+		notifier := newNotifier(&NotifierProviderConfig{
+			FromNumber: "+15551111111",
+			ToNumbers:  []string{"+15550000000", "+15552222222"},
+		})
+		if _, err := notifier.NotifyMessage(context.Background(), message); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if len(forms) != 2 {
+			t.Errorf("expected remaining numbers to be notified, got %d requests", len(forms))
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package twiliovoice
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	twiliosdk "github.com/certimate-go/certimate/pkg/sdk3rd/twilio"
+)
+
+type NotifierProviderConfig struct {
+	// Twilio Account SID。
+	AccountSid string `json:"accountSid"`
+	// Twilio Auth Token。
+	AuthToken string `json:"authToken"`
+	// 主叫号码，采用 E.164 标准格式。
+	FromNumber string `json:"fromNumber"`
+	// 被叫号码列表，采用 E.164 标准格式。
+	ToNumbers []string `json:"toNumbers"`
+	// 语音播报内容模板，支持变量替换，参见 [core.NotifyMessage.TemplateVariables]。
+	// 零值时默认值 "${SUBJECT}. ${MESSAGE}"。
+	SpeechTemplate string `json:"speechTemplate,omitempty"`
+	// 语音播报语言，形如 "en-US"、"zh-CN"。
+	// 零值时使用 Twilio 默认语言。
+	Language string `json:"language,omitempty"`
+	// 语音播报重复次数。
+	// 零值时默认值 2。
+	Loop int32 `json:"loop,omitempty"`
+}
+
+type NotifierProvider struct {
+	config    *NotifierProviderConfig
+	logger    *slog.Logger
+	sdkClient *twiliosdk.Client
+}
+
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
+
+func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the notifier provider is nil")
+	}
+
+	client, err := twiliosdk.NewClient(config.AccountSid, config.AuthToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &NotifierProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		n.logger = slog.New(slog.DiscardHandler)
+	} else {
+		n.logger = logger
+	}
+}
+
+func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	return n.NotifyMessage(ctx, &core.NotifyMessage{Subject: subject, Body: message})
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	if n.config.FromNumber == "" {
+		return nil, errors.New("config `fromNumber` is required")
+	}
+	if len(n.config.ToNumbers) == 0 {
+		return nil, errors.New("config `toNumbers` is required")
+	}
+
+	speechTemplate := n.config.SpeechTemplate
+	if speechTemplate == "" {
+		speechTemplate = "${SUBJECT}. ${MESSAGE}"
+	}
+
+	twiml, err := buildTwiml(message.RenderTemplate(speechTemplate), n.config.Language, n.config.Loop)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build twiml: %w", err)
+	}
+
+	// 逐个号码发起呼叫，任一号码失败不影响其他号码
+	callSids := make([]string, 0)
+	failures := make([]string, 0)
+	for _, toNumber := range n.config.ToNumbers {
+		createCallReq := &twiliosdk.CreateCallRequest{
+			To:    toNumber,
+			From:  n.config.FromNumber,
+			Twiml: twiml,
+		}
+		createCallResp, err := n.sdkClient.CreateCallWithContext(ctx, createCallReq)
+		n.logger.Debug("sdk request 'twilio.CreateCall'", slog.Any("request", createCallReq), slog.Any("response", createCallResp))
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", toNumber, err.Error()))
+			continue
+		}
+
+		callSids = append(callSids, createCallResp.Sid)
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("failed to execute sdk request 'twilio.CreateCall': %s", strings.Join(failures, "; "))
+	}
+
+	return &core.NotifyResult{
+		ExtendedData: map[string]any{
+			"callSids": callSids,
+		},
+	}, nil
+}
+
+// 生成语音播报的 TwiML 文档。
+// REF: https://www.twilio.com/docs/voice/twiml/say
+func buildTwiml(speech string, language string, loop int32) (string, error) {
+	if loop <= 0 {
+		loop = 2
+	}
+
+	type sayElement struct {
+		Language string `xml:"language,attr,omitempty"`
+		Loop     int32  `xml:"loop,attr"`
+		Text     string `xml:",chardata"`
+	}
+	type responseElement struct {
+		XMLName xml.Name   `xml:"Response"`
+		Say     sayElement `xml:"Say"`
+	}
+
+	data, err := xml.Marshal(&responseElement{
+		Say: sayElement{
+			Language: language,
+			Loop:     loop,
+			Text:     speech,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,73 @@
+package twiliovoice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/certimate-go/certimate/pkg/core"
+)
+
+func TestBuildTwiml(t *testing.T) {
+	twiml, err := buildTwiml("Certificate <example.com> expiring", "en-US", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<Response><Say language="en-US" loop="2">Certificate &lt;example.com&gt; expiring</Say></Response>`
+	if twiml != expected {
+		t.Errorf("unexpected twiml: %s", twiml)
+	}
+}
+
+func TestNotifyMessage(t *testing.T) {
+	var form map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Calls.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_ = r.ParseForm()
+		form = make(map[string]string)
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"sid":"CA_mock","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	notifier, err := NewNotifierProvider(&NotifierProviderConfig{
+		AccountSid:     "AC_mock",
+		AuthToken:      "token_mock",
+		FromNumber:     "+15551111111",
+		ToNumbers:      []string{"+15552222222"},
+		SpeechTemplate: "${SUBJECT} for ${FIELD:Domain}",
+		Loop:           3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier.sdkClient.SetBaseURL(server.URL)
+
+	res, err := notifier.NotifyMessage(context.Background(), &core.NotifyMessage{
+		Subject: "Certificate expiring",
+		Fields:  []core.NotifyMessageField{{Name: "Domain", Value: "example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if form["To"] != "+15552222222" || form["From"] != "+15551111111" {
+		t.Errorf("unexpected form: %v", form)
+	}
+	if form["Twiml"] != `<Response><Say loop="3">Certificate expiring for example.com</Say></Response>` {
+		t.Errorf("unexpected twiml: %s", form["Twiml"])
+	}
+	if sids := res.ExtendedData["callSids"].([]string); len(sids) != 1 || sids[0] != "CA_mock" {
+		t.Errorf("unexpected call sids: %v", sids)
+	}
+}
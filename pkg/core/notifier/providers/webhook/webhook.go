@@ -37,7 +37,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -68,6 +68,57 @@ func (n *NotifierProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (n *NotifierProvider) Notify(ctx context.Context, subject string, message string) (*core.NotifyResult, error) {
+	variables := map[string]string{
+		"${SUBJECT}": subject,
+		"${MESSAGE}": message,
+	}
+	defaultData := map[string]string{
+		"subject": subject,
+		"message": message,
+	}
+
+	return n.sendWebhook(ctx, variables, defaultData, nil)
+}
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	fieldsJson, _ := json.Marshal(message.Fields)
+	linksJson, _ := json.Marshal(message.Links)
+
+	variables := map[string]string{
+		"${SUBJECT}":          message.Subject,
+		"${MESSAGE}":          message.PlainText(),
+		"${MESSAGE_MARKDOWN}": message.MarkdownText(),
+		"${MESSAGE_HTML}":     message.HTMLText(),
+		"${SEVERITY}":         string(message.GetSeverity()),
+		"${FIELDS}":           string(fieldsJson),
+		"${LINKS}":            string(linksJson),
+	}
+	defaultData := map[string]string{
+		"subject":  message.Subject,
+		"message":  message.PlainText(),
+		"severity": string(message.GetSeverity()),
+	}
+	defaultJsonData := map[string]any{
+		"subject":  message.Subject,
+		"message":  message.PlainText(),
+		"severity": message.GetSeverity(),
+		"markdown": message.MarkdownText(),
+		"html":     message.HTMLText(),
+		"fields":   message.Fields,
+		"links":    message.Links,
+	}
+
+	return n.sendWebhook(ctx, variables, defaultData, defaultJsonData)
+}
+
+// 发送 Webhook 请求。
+//
+// 入参：
+//   - ctx：上下文。
+//   - variables：回调数据中可替换的变量。
+//   - defaultData：未配置回调数据时的默认数据。
+//   - defaultJsonData：未配置回调数据且以 JSON 格式提交时的默认数据，为空时使用 defaultData。
+func (n *NotifierProvider) sendWebhook(ctx context.Context, variables map[string]string, defaultData map[string]string, defaultJsonData map[string]any) (*core.NotifyResult, error) {
 	// 处理 Webhook URL
 	webhookUrl, err := url.Parse(n.config.WebhookUrl)
 	if err != nil {
@@ -111,9 +162,10 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 	// 处理 Webhook 请求数据
 	var webhookData interface{}
 	if n.config.WebhookData == "" {
-		webhookData = map[string]string{
-			"subject": subject,
-			"message": message,
+		if defaultJsonData != nil && webhookMethod != http.MethodGet && strings.HasPrefix(webhookContentType, CONTENT_TYPE_JSON) {
+			webhookData = defaultJsonData
+		} else {
+			webhookData = defaultData
 		}
 	} else {
 		err = json.Unmarshal([]byte(n.config.WebhookData), &webhookData)
@@ -121,8 +173,9 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 			return nil, fmt.Errorf("failed to unmarshal webhook data: %w", err)
 		}
 
-		replaceJsonValueRecursively(webhookData, "${SUBJECT}", subject)
-		replaceJsonValueRecursively(webhookData, "${MESSAGE}", message)
+		for k, v := range variables {
+			replaceJsonValueRecursively(webhookData, k, v)
+		}
 
 		if webhookMethod == http.MethodGet || webhookContentType == CONTENT_TYPE_FORM || webhookContentType == CONTENT_TYPE_MULTIPART {
 			temp := make(map[string]string)
@@ -22,7 +22,7 @@ type NotifierProvider struct {
 	httpClient *resty.Client
 }
 
-var _ core.Notifier = (*NotifierProvider)(nil)
+var _ core.MessageNotifier = (*NotifierProvider)(nil)
 
 func NewNotifierProvider(config *NotifierProviderConfig) (*NotifierProvider, error) {
 	if config == nil {
@@ -67,3 +67,36 @@ func (n *NotifierProvider) Notify(ctx context.Context, subject string, message s
 
 	return &core.NotifyResult{}, nil
 }
+
+func (n *NotifierProvider) NotifyMessage(ctx context.Context, message *core.NotifyMessage) (*core.NotifyResult, error) {
+	// 企业微信 Markdown 消息仅支持 info、comment、warning 三种字体颜色
+	// REF: https://developer.work.weixin.qq.com/document/path/91770#markdown%E7%B1%BB%E5%9E%8B
+	var color string
+	switch message.GetSeverity() {
+	case core.NotifySeveritySuccess:
+		color = "info"
+	case core.NotifySeverityWarning, core.NotifySeverityError:
+		color = "warning"
+	default:
+		color = "comment"
+	}
+
+	req := n.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBody(map[string]any{
+			"msgtype": "markdown",
+			"markdown": map[string]string{
+				"content": fmt.Sprintf("## <font color=\"%s\">%s</font>\n%s", color, message.Subject, message.MarkdownText()),
+			},
+		})
+	resp, err := req.Post(n.config.WebhookUrl)
+	if err != nil {
+		return nil, fmt.Errorf("wecom api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("wecom api error: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &core.NotifyResult{}, nil
+}
@@ -0,0 +1,287 @@
+package core
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// 表示通知消息严重程度的类型。
+type NotifySeverity string
+
+const (
+	NotifySeverityInfo    = NotifySeverity("info")
+	NotifySeveritySuccess = NotifySeverity("success")
+	NotifySeverityWarning = NotifySeverity("warning")
+	NotifySeverityError   = NotifySeverity("error")
+)
+
+// 获取严重程度对应的十六进制颜色值，形如 "#1677ff"。
+func (s NotifySeverity) Color() string {
+	switch s {
+	case NotifySeveritySuccess:
+		return "#52c41a"
+	case NotifySeverityWarning:
+		return "#faad14"
+	case NotifySeverityError:
+		return "#ff4d4f"
+	default:
+		return "#1677ff"
+	}
+}
+
+// 表示结构化通知消息的数据结构。
+//
+// 其中 Body、Markdown、HTML 三者均为可选，通知器将根据自身能力选择最合适的格式；
+// 若所需格式未提供，则由其他格式降级生成。
+type NotifyMessage struct {
+	// 通知主题。
+	Subject string `json:"subject"`
+	// 严重程度。
+	// 零值时视为 [NotifySeverityInfo]。
+	Severity NotifySeverity `json:"severity,omitempty"`
+	// 纯文本正文。
+	Body string `json:"body,omitempty"`
+	// Markdown 格式正文。
+	Markdown string `json:"markdown,omitempty"`
+	// HTML 格式正文。
+	HTML string `json:"html,omitempty"`
+	// 键值对字段列表。
+	Fields []NotifyMessageField `json:"fields,omitempty"`
+	// 链接列表。
+	Links []NotifyMessageLink `json:"links,omitempty"`
+	// 附件列表。
+	Attachments []NotifyMessageAttachment `json:"attachments,omitempty"`
+}
+
+// 表示结构化通知消息中键值对字段的数据结构。
+type NotifyMessageField struct {
+	// 字段名。
+	Name string `json:"name"`
+	// 字段值。
+	Value string `json:"value"`
+	// 是否与相邻字段并排显示。
+	Inline bool `json:"inline,omitempty"`
+}
+
+// 表示结构化通知消息中链接的数据结构。
+type NotifyMessageLink struct {
+	// 链接标题。
+	Title string `json:"title"`
+	// 链接地址。
+	Url string `json:"url"`
+}
+
+// 表示结构化通知消息中附件的数据结构。
+type NotifyMessageAttachment struct {
+	// 文件名。
+	Name string `json:"name"`
+	// 文件 MIME 类型。
+	ContentType string `json:"contentType,omitempty"`
+	// 文件内容。
+	Data []byte `json:"-"`
+}
+
+// 获取严重程度。零值时返回 [NotifySeverityInfo]。
+func (m *NotifyMessage) GetSeverity() NotifySeverity {
+	if m.Severity == "" {
+		return NotifySeverityInfo
+	}
+	return m.Severity
+}
+
+// 获取纯文本格式的正文（不含字段、链接等）。
+func (m *NotifyMessage) PlainBody() string {
+	switch {
+	case m.Body != "":
+		return m.Body
+	case m.Markdown != "":
+		return m.Markdown
+	case m.HTML != "":
+		return htmlToText(m.HTML)
+	}
+	return ""
+}
+
+// 获取 Markdown 格式的正文（不含字段、链接等）。
+func (m *NotifyMessage) MarkdownBody() string {
+	switch {
+	case m.Markdown != "":
+		return m.Markdown
+	case m.Body != "":
+		return m.Body
+	case m.HTML != "":
+		return htmlToText(m.HTML)
+	}
+	return ""
+}
+
+// 获取 HTML 格式的正文（不含字段、链接等）。
+func (m *NotifyMessage) HTMLBody() string {
+	switch {
+	case m.HTML != "":
+		return m.HTML
+	case m.Body != "":
+		return strings.ReplaceAll(html.EscapeString(m.Body), "\n", "<br>")
+	case m.Markdown != "":
+		return strings.ReplaceAll(html.EscapeString(m.Markdown), "\n", "<br>")
+	}
+	return ""
+}
+
+// 将消息渲染为纯文本（不含主题），供不支持富文本的通知器降级使用。
+func (m *NotifyMessage) PlainText() string {
+	var sb strings.Builder
+	sb.WriteString(m.PlainBody())
+
+	if len(m.Fields) > 0 {
+		writeParagraphBreak(&sb)
+		for i, field := range m.Fields {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(fmt.Sprintf("%s: %s", field.Name, field.Value))
+		}
+	}
+
+	if len(m.Links) > 0 {
+		writeParagraphBreak(&sb)
+		for i, link := range m.Links {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			if link.Title == "" {
+				sb.WriteString(link.Url)
+			} else {
+				sb.WriteString(fmt.Sprintf("%s: %s", link.Title, link.Url))
+			}
+		}
+	}
+
+	if len(m.Attachments) > 0 {
+		writeParagraphBreak(&sb)
+		names := make([]string, 0, len(m.Attachments))
+		for _, attachment := range m.Attachments {
+			names = append(names, attachment.Name)
+		}
+		sb.WriteString(fmt.Sprintf("Attachments: %s", strings.Join(names, ", ")))
+	}
+
+	return sb.String()
+}
+
+// 将消息渲染为 Markdown（不含主题）。
+func (m *NotifyMessage) MarkdownText() string {
+	var sb strings.Builder
+	sb.WriteString(m.MarkdownBody())
+
+	if len(m.Fields) > 0 {
+		writeParagraphBreak(&sb)
+		for i, field := range m.Fields {
+			if i > 0 {
+				sb.WriteString("  \n")
+			}
+			sb.WriteString(fmt.Sprintf("**%s**: %s", field.Name, field.Value))
+		}
+	}
+
+	if len(m.Links) > 0 {
+		writeParagraphBreak(&sb)
+		for i, link := range m.Links {
+			if i > 0 {
+				sb.WriteString("  \n")
+			}
+			sb.WriteString(fmt.Sprintf("[%s](%s)", linkTitle(link), link.Url))
+		}
+	}
+
+	if len(m.Attachments) > 0 {
+		writeParagraphBreak(&sb)
+		names := make([]string, 0, len(m.Attachments))
+		for _, attachment := range m.Attachments {
+			names = append(names, "`"+attachment.Name+"`")
+		}
+		sb.WriteString(fmt.Sprintf("Attachments: %s", strings.Join(names, ", ")))
+	}
+
+	return sb.String()
+}
+
+// 将消息渲染为 HTML 片段（不含主题）。
+func (m *NotifyMessage) HTMLText() string {
+	var sb strings.Builder
+	if body := m.HTMLBody(); body != "" {
+		sb.WriteString("<div>")
+		sb.WriteString(body)
+		sb.WriteString("</div>")
+	}
+
+	if len(m.Fields) > 0 {
+		sb.WriteString(`<table cellpadding="4" style="border-collapse:collapse">`)
+		for _, field := range m.Fields {
+			sb.WriteString(fmt.Sprintf(`<tr><th align="left">%s</th><td>%s</td></tr>`, html.EscapeString(field.Name), html.EscapeString(field.Value)))
+		}
+		sb.WriteString("</table>")
+	}
+
+	if len(m.Links) > 0 {
+		sb.WriteString("<p>")
+		for i, link := range m.Links {
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+			sb.WriteString(fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(link.Url), html.EscapeString(linkTitle(link))))
+		}
+		sb.WriteString("</p>")
+	}
+
+	return sb.String()
+}
+
+// 获取可用于模板替换的变量。
+// 包括 "${SUBJECT}"、"${MESSAGE}"、"${SEVERITY}"，以及每个字段对应的 "${FIELD:<字段名>}"。
+func (m *NotifyMessage) TemplateVariables() map[string]string {
+	variables := map[string]string{
+		"${SUBJECT}":  m.Subject,
+		"${MESSAGE}":  m.PlainBody(),
+		"${SEVERITY}": string(m.GetSeverity()),
+	}
+	for _, field := range m.Fields {
+		variables[fmt.Sprintf("${FIELD:%s}", field.Name)] = field.Value
+	}
+	return variables
+}
+
+// 将模板字符串中的变量替换为实际值。
+// 支持的变量参见 [NotifyMessage.TemplateVariables]。
+func (m *NotifyMessage) RenderTemplate(tmpl string) string {
+	for k, v := range m.TemplateVariables() {
+		tmpl = strings.ReplaceAll(tmpl, k, v)
+	}
+	return tmpl
+}
+
+func writeParagraphBreak(sb *strings.Builder) {
+	if sb.Len() > 0 {
+		sb.WriteString("\n\n")
+	}
+}
+
+func linkTitle(link NotifyMessageLink) string {
+	if link.Title == "" {
+		return link.Url
+	}
+	return link.Title
+}
+
+var (
+	htmlBreakRegexp = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</tr>|</li>`)
+	htmlTagRegexp   = regexp.MustCompile(`<[^>]*>`)
+)
+
+func htmlToText(s string) string {
+	s = htmlBreakRegexp.ReplaceAllString(s, "\n")
+	s = htmlTagRegexp.ReplaceAllString(s, "")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestNotifyMessage_Render(t *testing.T) {
+	message := &NotifyMessage{
+		Subject:  "Deployment failed",
+		Severity: NotifySeverityError,
+		Body:     "Failed to deploy <cert>",
+		Fields: []NotifyMessageField{
+			{Name: "Workflow", Value: "example.com"},
+			{Name: "Target", Value: "nginx"},
+		},
+		Links:       []NotifyMessageLink{{Title: "View run", Url: "https://example.com/runs/1"}},
+		Attachments: []NotifyMessageAttachment{{Name: "run.log"}},
+	}
+
+	t.Run("PlainText", func(t *testing.T) {
+		expected := "Failed to deploy <cert>\n\nWorkflow: example.com\nTarget: nginx\n\nView run: https://example.com/runs/1\n\nAttachments: run.log"
+		if actual := message.PlainText(); actual != expected {
+			t.Errorf("unexpected plain text: %q", actual)
+		}
+	})
+
+	t.Run("MarkdownText", func(t *testing.T) {
+		actual := message.MarkdownText()
+		if !strings.Contains(actual, "**Workflow**: example.com") || !strings.Contains(actual, "[View run](https://example.com/runs/1)") {
+			t.Errorf("unexpected markdown text: %q", actual)
+		}
+	})
+
+	t.Run("HTMLText", func(t *testing.T) {
+		actual := message.HTMLText()
+		if !strings.Contains(actual, "Failed to deploy &lt;cert&gt;") || !strings.Contains(actual, `<a href="https://example.com/runs/1">View run</a>`) {
+			t.Errorf("unexpected html text: %q", actual)
+		}
+	})
+
+	t.Run("PlainBodyFromHTML", func(t *testing.T) {
+		message := &NotifyMessage{HTML: "<p>Hello &amp; welcome</p><p>Bye</p>"}
+		if actual := message.PlainBody(); actual != "Hello & welcome\nBye" {
+			t.Errorf("unexpected plain body: %q", actual)
+		}
+	})
+
+	t.Run("RenderTemplate", func(t *testing.T) {
+		actual := message.RenderTemplate("[${SEVERITY}] ${SUBJECT}: ${FIELD:Workflow}, ${FIELD:Unknown}")
+		if actual != "[error] Deployment failed: example.com, ${FIELD:Unknown}" {
+			t.Errorf("unexpected rendered template: %q", actual)
+		}
+	})
+
+	t.Run("Severity", func(t *testing.T) {
+		if (&NotifyMessage{}).GetSeverity() != NotifySeverityInfo {
+			t.Errorf("expected default severity to be info")
+		}
+		if message.GetSeverity().Color() != "#ff4d4f" {
+			t.Errorf("unexpected severity color")
+		}
+	})
+}
+
+type mockPlainNotifier struct {
+	subject string
+	message string
+}
+
+func (n *mockPlainNotifier) SetLogger(logger *slog.Logger) {}
+
+func (n *mockPlainNotifier) Notify(ctx context.Context, subject string, message string) (*NotifyResult, error) {
+	n.subject = subject
+	n.message = message
+	return &NotifyResult{}, nil
+}
+
+func TestNotifyWithMessage_Fallback(t *testing.T) {
+	notifier := &mockPlainNotifier{}
+	message := &NotifyMessage{
+		Subject: "subject",
+		Body:    "body",
+		Fields:  []NotifyMessageField{{Name: "k", Value: "v"}},
+	}
+
+	if _, err := NotifyWithMessage(context.Background(), notifier, message); err != nil {
+		t.Fatal(err)
+	}
+	if notifier.subject != "subject" || notifier.message != "body\n\nk: v" {
+		t.Errorf("unexpected fallback: %q, %q", notifier.subject, notifier.message)
+	}
+}
@@ -0,0 +1,249 @@
+package caddy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	caddysdk "github.com/certimate-go/certimate/pkg/sdk3rd/caddy"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+type SSLDeployerProviderConfig struct {
+	// Caddy 管理 API 地址。
+	// 零值时默认值 "http://localhost:2019"。
+	ServerUrl string `json:"serverUrl,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 加载方式。
+	// 零值时默认值 [LOAD_MODE_LOAD_PEM]。
+	LoadMode LoadModeType `json:"loadMode,omitempty"`
+	// 证书标签，用于识别由本部署器管理的证书条目。
+	// 零值时默认值 "certimate"。
+	CertificateTag string `json:"certificateTag,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *caddysdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := createSDKClient(config.ServerUrl, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	certX509, err := xcert.ParseCertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := d.config.CertificateTag
+	if tag == "" {
+		tag = defaultCertificateTag
+	}
+
+	newEntry := map[string]any{
+		"certificate": certPEM,
+		"key":         privkeyPEM,
+		"tags":        []string{tag},
+	}
+
+	var replaced int
+	switch d.config.LoadMode {
+	case "", LOAD_MODE_LOAD_PEM:
+		replaced, err = d.deployViaLoadPEM(ctx, newEntry, tag, certX509.DNSNames)
+		if err != nil {
+			return nil, err
+		}
+
+	case LOAD_MODE_LOAD:
+		replaced, err = d.deployViaLoad(ctx, newEntry, tag, certX509.DNSNames)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported load mode '%s'", d.config.LoadMode)
+	}
+
+	d.logger.Info("ssl certificate loaded into caddy", slog.Int("replaced", replaced))
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"replaced": replaced,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) deployViaLoadPEM(ctx context.Context, newEntry map[string]any, tag string, dnsNames []string) (int, error) {
+	const path = "apps/tls/certificates/load_pem"
+
+	// 读取现有证书列表
+	// 若上级配置节点尚不存在，Caddy 会返回错误，此时回退到整体重新加载的方式
+	raw, err := d.sdkClient.GetConfigWithContext(ctx, path)
+	d.logger.Debug("sdk request 'caddy.GetConfig'", slog.String("path", path), slog.Any("error", err))
+	if err != nil {
+		d.logger.Info("could not read the certificate list, fallback to load the whole config")
+		return d.deployViaLoad(ctx, newEntry, tag, dnsNames)
+	}
+
+	exists := len(raw) > 0 && strings.TrimSpace(string(raw)) != "null"
+	entries := make([]map[string]any, 0)
+	if exists {
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return 0, fmt.Errorf("failed to parse caddy config '%s': %w", path, err)
+		}
+	}
+
+	// 合并证书列表，保留与本证书无关的条目
+	entries, replaced := mergeLoadPEMEntries(entries, newEntry, tag, dnsNames)
+
+	if exists {
+		err = d.sdkClient.PatchConfigWithContext(ctx, path, entries)
+		d.logger.Debug("sdk request 'caddy.PatchConfig'", slog.String("path", path), slog.Any("error", err))
+		if err != nil {
+			return 0, fmt.Errorf("failed to execute sdk request 'caddy.PatchConfig': %w", err)
+		}
+	} else {
+		err = d.sdkClient.PutConfigWithContext(ctx, path, entries)
+		d.logger.Debug("sdk request 'caddy.PutConfig'", slog.String("path", path), slog.Any("error", err))
+		if err != nil {
+			return 0, fmt.Errorf("failed to execute sdk request 'caddy.PutConfig': %w", err)
+		}
+	}
+
+	return replaced, nil
+}
+
+func (d *SSLDeployerProvider) deployViaLoad(ctx context.Context, newEntry map[string]any, tag string, dnsNames []string) (int, error) {
+	// 读取完整配置
+	raw, err := d.sdkClient.GetConfigWithContext(ctx, "")
+	d.logger.Debug("sdk request 'caddy.GetConfig'", slog.String("path", "/"), slog.Any("error", err))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute sdk request 'caddy.GetConfig': %w", err)
+	}
+
+	config := make(map[string]any)
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &config); err != nil {
+			return 0, fmt.Errorf("failed to parse caddy config: %w", err)
+		} else if config == nil {
+			config = make(map[string]any)
+		}
+	}
+
+	// 合并证书列表，保留其余配置不变
+	certificates := ensureMap(ensureMap(ensureMap(config, "apps"), "tls"), "certificates")
+	entries := make([]map[string]any, 0)
+	if list, ok := certificates["load_pem"].([]any); ok {
+		for _, item := range list {
+			if entry, ok := item.(map[string]any); ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	entries, replaced := mergeLoadPEMEntries(entries, newEntry, tag, dnsNames)
+	certificates["load_pem"] = entries
+
+	err = d.sdkClient.LoadWithContext(ctx, config)
+	d.logger.Debug("sdk request 'caddy.Load'", slog.Any("error", err))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute sdk request 'caddy.Load': %w", err)
+	}
+
+	return replaced, nil
+}
+
+// 合并 `load_pem` 证书列表。
+// 带有指定标签、或与新证书具有相同域名的旧条目会被移除，其余条目保持原样。
+func mergeLoadPEMEntries(entries []map[string]any, newEntry map[string]any, tag string, dnsNames []string) ([]map[string]any, int) {
+	sortedDNSNames := slices.Clone(dnsNames)
+	slices.Sort(sortedDNSNames)
+
+	merged := make([]map[string]any, 0, len(entries)+1)
+	replaced := 0
+	for _, entry := range entries {
+		if tags, ok := entry["tags"].([]any); ok {
+			if slices.ContainsFunc(tags, func(t any) bool { return t == tag }) {
+				replaced++
+				continue
+			}
+		}
+
+		if certPEM, ok := entry["certificate"].(string); ok && len(sortedDNSNames) > 0 {
+			if certX509, err := xcert.ParseCertificateFromPEM(certPEM); err == nil {
+				oldDNSNames := slices.Clone(certX509.DNSNames)
+				slices.Sort(oldDNSNames)
+				if slices.Equal(oldDNSNames, sortedDNSNames) {
+					replaced++
+					continue
+				}
+			}
+		}
+
+		merged = append(merged, entry)
+	}
+
+	merged = append(merged, newEntry)
+	return merged, replaced
+}
+
+func ensureMap(parent map[string]any, key string) map[string]any {
+	if child, ok := parent[key].(map[string]any); ok {
+		return child
+	}
+
+	child := make(map[string]any)
+	parent[key] = child
+	return child
+}
+
+func createSDKClient(serverUrl string, skipTlsVerify bool) (*caddysdk.Client, error) {
+	if serverUrl == "" {
+		serverUrl = "http://localhost:2019"
+	} else if !strings.Contains(serverUrl, "://") {
+		serverUrl = "http://" + serverUrl
+	}
+
+	client, err := caddysdk.NewClient(serverUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipTlsVerify {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,215 @@
+package caddy_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/caddy"
+)
+
+// 模拟 Caddy 管理 API 中与配置读写相关的最小子集。
+type mockAdminApi struct {
+	mu     sync.Mutex
+	config map[string]any
+}
+
+func (m *mockAdminApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r.URL.Path == "/load" && r.Method == http.MethodPost {
+		body, _ := io.ReadAll(r.Body)
+		m.config = make(map[string]any)
+		json.Unmarshal(body, &m.config)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/config"), "/"), "/")
+	if len(parts) == 1 && parts[0] == "" {
+		parts = nil
+	}
+
+	var parent map[string]any
+	var current any = m.config
+	for i, part := range parts {
+		node, ok := current.(map[string]any)
+		if !ok {
+			http.Error(w, `{"error":"invalid traversal path"}`, http.StatusBadRequest)
+			return
+		}
+		parent = node
+		current = node[part]
+		if current == nil && i < len(parts)-1 {
+			http.Error(w, `{"error":"invalid traversal path"}`, http.StatusBadRequest)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(current)
+
+	case http.MethodPut, http.MethodPatch:
+		if (r.Method == http.MethodPut) != (current == nil) {
+			http.Error(w, `{"error":"conflict"}`, http.StatusConflict)
+			return
+		}
+		var value any
+		json.NewDecoder(r.Body).Decode(&value)
+		parent[parts[len(parts)-1]] = value
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	certPEM, privkeyPEM := mockCertificate(t, "example.com")
+	otherCertPEM, otherPrivkeyPEM := mockCertificate(t, "other.example.com")
+	staleCertPEM, stalePrivkeyPEM := mockCertificate(t, "example.com")
+
+	t.Run("LoadPEM", func(t *testing.T) {
+		api := &mockAdminApi{config: map[string]any{
+			"apps": map[string]any{
+				"http": map[string]any{"servers": map[string]any{}},
+				"tls": map[string]any{
+					"certificates": map[string]any{
+						"load_pem": []any{
+							map[string]any{"certificate": otherCertPEM, "key": otherPrivkeyPEM},
+							map[string]any{"certificate": staleCertPEM, "key": stalePrivkeyPEM},
+						},
+					},
+				},
+			},
+		}}
+		server := httptest.NewServer(api)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl: server.URL,
+			LoadMode:  provider.LOAD_MODE_LOAD_PEM,
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		entries := api.config["apps"].(map[string]any)["tls"].(map[string]any)["certificates"].(map[string]any)["load_pem"].([]any)
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(entries))
+		}
+		if entries[0].(map[string]any)["certificate"] != otherCertPEM || entries[1].(map[string]any)["certificate"] != certPEM {
+			t.Errorf("unexpected entries: %v", entries)
+		}
+		if res.ExtendedData["replaced"] != 1 {
+			t.Errorf("unexpected extended data: %v", res.ExtendedData)
+		}
+		if _, ok := api.config["apps"].(map[string]any)["http"]; !ok {
+			t.Errorf("unrelated config was removed")
+		}
+	})
+
+	t.Run("LoadPEMFallback", func(t *testing.T) {
+		api := &mockAdminApi{config: map[string]any{
+			"apps": map[string]any{
+				"http": map[string]any{"servers": map[string]any{}},
+			},
+		}}
+		server := httptest.NewServer(api)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl: server.URL,
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		entries := api.config["apps"].(map[string]any)["tls"].(map[string]any)["certificates"].(map[string]any)["load_pem"].([]any)
+		if len(entries) != 1 || entries[0].(map[string]any)["certificate"] != certPEM {
+			t.Errorf("unexpected entries: %v", entries)
+		}
+		if _, ok := api.config["apps"].(map[string]any)["http"]; !ok {
+			t.Errorf("unrelated config was removed")
+		}
+	})
+
+	t.Run("LoadWithTag", func(t *testing.T) {
+		api := &mockAdminApi{config: map[string]any{
+			"apps": map[string]any{
+				"tls": map[string]any{
+					"certificates": map[string]any{
+						"load_pem": []any{
+							map[string]any{"certificate": otherCertPEM, "key": otherPrivkeyPEM, "tags": []any{"managed"}},
+							map[string]any{"certificate": otherCertPEM, "key": otherPrivkeyPEM, "tags": []any{"manual"}},
+						},
+					},
+				},
+			},
+		}}
+		server := httptest.NewServer(api)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:      server.URL,
+			LoadMode:       provider.LOAD_MODE_LOAD,
+			CertificateTag: "managed",
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		entries := api.config["apps"].(map[string]any)["tls"].(map[string]any)["certificates"].(map[string]any)["load_pem"].([]any)
+		if len(entries) != 2 || entries[0].(map[string]any)["tags"].([]any)[0] != "manual" || entries[1].(map[string]any)["certificate"] != certPEM {
+			t.Errorf("unexpected entries: %v", entries)
+		}
+	})
+}
+
+func mockCertificate(t *testing.T, domain string) (string, string) {
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	privkeyDER, _ := x509.MarshalECPrivateKey(privkey)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	privkeyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER})
+	return string(certPEM), string(privkeyPEM)
+}
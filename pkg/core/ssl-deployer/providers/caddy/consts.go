@@ -0,0 +1,12 @@
+package caddy
+
+type LoadModeType string
+
+const (
+	// 加载方式：通过 `/config/apps/tls/certificates/load_pem` 端点仅更新证书列表。
+	LOAD_MODE_LOAD_PEM = LoadModeType("load_pem")
+	// 加载方式：读取完整配置，合并证书后通过 `/load` 端点整体重新加载。
+	LOAD_MODE_LOAD = LoadModeType("load")
+)
+
+const defaultCertificateTag = "certimate"
@@ -0,0 +1,28 @@
+package docker
+
+type WriteModeType string
+
+const (
+	// 写入方式：通过 Docker Engine API 将文件上传到容器内（可写入挂载的数据卷）。
+	WRITE_MODE_ARCHIVE = WriteModeType("archive")
+	// 写入方式：写入宿主机文件系统（适用于绑定挂载）。
+	WRITE_MODE_HOST = WriteModeType("host")
+)
+
+type ReloadModeType string
+
+const (
+	// 重载方式：不重载。
+	RELOAD_MODE_NONE = ReloadModeType("none")
+	// 重载方式：向容器发送信号。
+	RELOAD_MODE_SIGNAL = ReloadModeType("signal")
+	// 重载方式：重启容器。
+	RELOAD_MODE_RESTART = ReloadModeType("restart")
+	// 重载方式：在容器内执行命令。
+	RELOAD_MODE_EXEC = ReloadModeType("exec")
+)
+
+const (
+	defaultDockerHost   = "unix:///var/run/docker.sock"
+	defaultReloadSignal = "SIGHUP"
+)
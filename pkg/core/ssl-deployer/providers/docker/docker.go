@@ -0,0 +1,354 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	dockersdk "github.com/certimate-go/certimate/pkg/sdk3rd/docker"
+	xfile "github.com/certimate-go/certimate/pkg/utils/file"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+type JumpServerConfig = xssh.JumpServerConfig
+
+type SSLDeployerProviderConfig struct {
+	// Docker Engine API 地址。
+	// 支持 "unix://"、"tcp://"、"http://"、"https://" 形式。通过 SSH 连接时仅支持 "unix://" 形式。
+	// 零值时默认值 "unix:///var/run/docker.sock"。Podman 可使用 "unix:///run/podman/podman.sock"。
+	DockerHost string `json:"dockerHost,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 是否通过 SSH 连接到远程主机。
+	UseSSH bool `json:"useSSH,omitempty"`
+	// SSH 主机。
+	// 零值时默认值 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认值 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 认证方式。
+	// 可取值 "none"、"password" 或 "key"。
+	// 零值时根据有无密码或私钥字段决定。
+	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机配置数组。
+	JumpServers []JumpServerConfig `json:"jumpServers,omitempty"`
+	// 容器标签选择器数组。
+	// 形如 "key" 或 "key=value"，多个选择器之间为“与”关系。
+	ContainerLabels []string `json:"containerLabels"`
+	// 文件写入方式。
+	WriteMode WriteModeType `json:"writeMode,omitempty"`
+	// 输出证书文件路径。
+	// 写入方式为 [WRITE_MODE_ARCHIVE] 时为容器内路径；为 [WRITE_MODE_HOST] 时为宿主机路径。
+	OutputCertPath string `json:"outputCertPath"`
+	// 输出私钥文件路径。
+	// 写入方式同上。
+	OutputKeyPath string `json:"outputKeyPath"`
+	// 重载方式。
+	ReloadMode ReloadModeType `json:"reloadMode,omitempty"`
+	// 重载时发送的信号。
+	// 重载方式为 [RELOAD_MODE_SIGNAL] 时选填。零值时默认值 "SIGHUP"。
+	ReloadSignal string `json:"reloadSignal,omitempty"`
+	// 重载时在容器内执行的命令。
+	// 重载方式为 [RELOAD_MODE_EXEC] 时必填。
+	ReloadCommand string `json:"reloadCommand,omitempty"`
+	// 重启容器时的超时秒数。
+	// 重载方式为 [RELOAD_MODE_RESTART] 时选填。
+	RestartTimeout int32 `json:"restartTimeout,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		config: config,
+		logger: slog.Default(),
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if len(d.config.ContainerLabels) == 0 {
+		return nil, errors.New("config `containerLabels` is required")
+	}
+	if d.config.OutputCertPath == "" {
+		return nil, errors.New("config `outputCertPath` is required")
+	}
+	if d.config.OutputKeyPath == "" {
+		return nil, errors.New("config `outputKeyPath` is required")
+	}
+
+	// 连接
+	var sshClient *xssh.Client
+	if d.config.UseSSH {
+		client, err := d.dialSSH(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		d.logger.Info("ssh connected")
+		sshClient = client
+	}
+
+	client, err := d.createSDKClient(sshClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sdk client: %w", err)
+	}
+
+	// 按标签查找目标容器
+	labels := make([]string, 0, len(d.config.ContainerLabels))
+	for _, label := range d.config.ContainerLabels {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) == 0 {
+		return nil, errors.New("config `containerLabels` is required")
+	}
+
+	containers, err := client.ListContainersWithContext(ctx, false, map[string][]string{"label": labels})
+	d.logger.Debug("sdk request 'docker.ListContainers'", slog.Any("labels", labels), slog.Any("response", containers))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'docker.ListContainers': %w", err)
+	} else if len(containers) == 0 {
+		return nil, errors.New("could not find any running containers matching the labels")
+	}
+
+	// 写入证书和私钥文件
+	switch d.config.WriteMode {
+	case "", WRITE_MODE_ARCHIVE:
+		for _, container := range containers {
+			if err := d.putFile(ctx, client, container.Id, d.config.OutputCertPath, []byte(certPEM), 0o644); err != nil {
+				return nil, fmt.Errorf("failed to upload certificate file to container '%s': %w", containerName(container), err)
+			}
+
+			if err := d.putFile(ctx, client, container.Id, d.config.OutputKeyPath, []byte(privkeyPEM), 0o600); err != nil {
+				return nil, fmt.Errorf("failed to upload private key file to container '%s': %w", containerName(container), err)
+			}
+
+			d.logger.Info("ssl certificate files uploaded to container", slog.String("container", containerName(container)))
+		}
+
+	case WRITE_MODE_HOST:
+		if sshClient != nil {
+			if err := xssh.WriteFileString(sshClient.Client, false, d.config.OutputCertPath, certPEM); err != nil {
+				return nil, fmt.Errorf("failed to upload certificate file: %w", err)
+			}
+
+			if err := xssh.WriteFileString(sshClient.Client, false, d.config.OutputKeyPath, privkeyPEM); err != nil {
+				return nil, fmt.Errorf("failed to upload private key file: %w", err)
+			}
+		} else {
+			if err := xfile.WriteString(d.config.OutputCertPath, certPEM); err != nil {
+				return nil, fmt.Errorf("failed to save certificate file: %w", err)
+			}
+
+			if err := xfile.WriteString(d.config.OutputKeyPath, privkeyPEM); err != nil {
+				return nil, fmt.Errorf("failed to save private key file: %w", err)
+			}
+		}
+
+		d.logger.Info("ssl certificate files saved on host")
+
+	default:
+		return nil, fmt.Errorf("unsupported write mode '%s'", d.config.WriteMode)
+	}
+
+	// 重载容器
+	reloaded := make([]string, 0, len(containers))
+	for _, container := range containers {
+		if err := d.reloadContainer(ctx, client, container); err != nil {
+			return nil, fmt.Errorf("failed to reload container '%s': %w", containerName(container), err)
+		}
+
+		reloaded = append(reloaded, containerName(container))
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"containers": reloaded,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) dialSSH(ctx context.Context) (*xssh.Client, error) {
+
+	return xssh.DialWithJumpServers(ctx, xssh.ServerConfig{
+		Host:          d.config.SshHost,
+		Port:          d.config.SshPort,
+		AuthMethod:    d.config.SshAuthMethod,
+		Username:      d.config.SshUsername,
+		Password:      d.config.SshPassword,
+		Key:           d.config.SshKey,
+		KeyPassphrase: d.config.SshKeyPassphrase,
+	}, d.config.JumpServers)
+}
+
+func (d *SSLDeployerProvider) createSDKClient(sshClient *xssh.Client) (*dockersdk.Client, error) {
+	dockerHost := d.config.DockerHost
+	if dockerHost == "" {
+		dockerHost = defaultDockerHost
+	}
+
+	if sshClient != nil {
+		hostUrl, err := url.Parse(dockerHost)
+		if err != nil {
+			return nil, err
+		} else if hostUrl.Scheme != "unix" {
+			return nil, fmt.Errorf("only unix socket is supported over ssh, but got '%s'", dockerHost)
+		}
+
+		// 经由 SSH 隧道连接远程主机上的 Unix 套接字
+		socketPath := hostUrl.Path
+		return dockersdk.NewClientWithDialer(func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return sshClient.DialContext(ctx, "unix", socketPath)
+		})
+	}
+
+	client, err := dockersdk.NewClient(dockerHost)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.config.AllowInsecureConnections {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
+
+func (d *SSLDeployerProvider) putFile(ctx context.Context, client *dockersdk.Client, containerId string, filePath string, data []byte, mode int64) error {
+	// 将单个文件打包为 tar 归档，解压到其所在目录
+	// 私钥文件应以 0600 权限写入，避免容器内的其他用户读取
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    path.Base(filePath),
+		Mode:    mode,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	err := client.PutContainerArchiveWithContext(ctx, containerId, path.Dir(filePath), buf.Bytes())
+	d.logger.Debug("sdk request 'docker.PutContainerArchive'", slog.String("containerId", containerId), slog.String("path", filePath))
+	return err
+}
+
+func (d *SSLDeployerProvider) reloadContainer(ctx context.Context, client *dockersdk.Client, container *dockersdk.ContainerSummary) error {
+	switch d.config.ReloadMode {
+	case "", RELOAD_MODE_NONE:
+		return nil
+
+	case RELOAD_MODE_SIGNAL:
+		signal := d.config.ReloadSignal
+		if signal == "" {
+			signal = defaultReloadSignal
+		}
+
+		err := client.KillContainerWithContext(ctx, container.Id, signal)
+		d.logger.Debug("sdk request 'docker.KillContainer'", slog.String("containerId", container.Id), slog.String("signal", signal))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'docker.KillContainer': %w", err)
+		}
+
+		d.logger.Info("container signaled", slog.String("container", containerName(container)), slog.String("signal", signal))
+		return nil
+
+	case RELOAD_MODE_RESTART:
+		err := client.RestartContainerWithContext(ctx, container.Id, d.config.RestartTimeout)
+		d.logger.Debug("sdk request 'docker.RestartContainer'", slog.String("containerId", container.Id))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'docker.RestartContainer': %w", err)
+		}
+
+		d.logger.Info("container restarted", slog.String("container", containerName(container)))
+		return nil
+
+	case RELOAD_MODE_EXEC:
+		if d.config.ReloadCommand == "" {
+			return errors.New("config `reloadCommand` is required")
+		}
+
+		execId, err := client.CreateExecWithContext(ctx, container.Id, []string{"sh", "-c", d.config.ReloadCommand})
+		d.logger.Debug("sdk request 'docker.CreateExec'", slog.String("containerId", container.Id), slog.String("execId", execId))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'docker.CreateExec': %w", err)
+		}
+
+		stdout, stderr, err := client.StartExecWithContext(ctx, execId)
+		d.logger.Debug("sdk request 'docker.StartExec'", slog.String("execId", execId), slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'docker.StartExec': %w", err)
+		}
+
+		inspectResp, err := client.InspectExecWithContext(ctx, execId)
+		d.logger.Debug("sdk request 'docker.InspectExec'", slog.String("execId", execId), slog.Any("response", inspectResp))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'docker.InspectExec': %w", err)
+		} else if inspectResp.ExitCode != 0 {
+			return fmt.Errorf("reload command exited with code %d, stderr: %s", inspectResp.ExitCode, stderr)
+		}
+
+		d.logger.Info("container reload command executed", slog.String("container", containerName(container)), slog.String("stdout", stdout))
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported reload mode '%s'", d.config.ReloadMode)
+	}
+}
+
+func containerName(container *dockersdk.ContainerSummary) string {
+	if len(container.Names) > 0 {
+		return strings.TrimPrefix(container.Names[0], "/")
+	}
+
+	if len(container.Id) > 12 {
+		return container.Id[:12]
+	}
+
+	return container.Id
+}
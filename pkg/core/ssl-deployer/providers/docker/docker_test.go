@@ -0,0 +1,184 @@
+package docker_test
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/docker"
+)
+
+type mockDockerEngine struct {
+	mtx      sync.Mutex
+	files    map[string]string
+	modes    map[string]int64
+	signals  []string
+	restarts []string
+	execs    []string
+	exitCode int
+}
+
+func (m *mockDockerEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/containers/json":
+		filters := make(map[string][]string)
+		json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+		if len(filters["label"]) != 1 || filters["label"][0] != "app=nginx" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"Id":"c1","Names":["/nginx-1"]},{"Id":"c2","Names":["/nginx-2"]}]`))
+
+	case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/archive"):
+		containerId := strings.Split(r.URL.Path, "/")[2]
+		tr := tar.NewReader(r.Body)
+		for {
+			hdr, err := tr.Next()
+			if err != nil {
+				break
+			}
+			data, _ := io.ReadAll(tr)
+			m.files[containerId+":"+r.URL.Query().Get("path")+"/"+hdr.Name] = string(data)
+			m.modes[containerId+":"+r.URL.Query().Get("path")+"/"+hdr.Name] = hdr.Mode
+		}
+
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/kill"):
+		m.signals = append(m.signals, strings.Split(r.URL.Path, "/")[2]+":"+r.URL.Query().Get("signal"))
+
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/restart"):
+		m.restarts = append(m.restarts, strings.Split(r.URL.Path, "/")[2])
+
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/exec"):
+		body := struct {
+			Cmd []string `json:"Cmd"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		m.execs = append(m.execs, strings.Join(body.Cmd, " "))
+		w.Write([]byte(`{"Id":"e1"}`))
+
+	case r.Method == http.MethodPost && r.URL.Path == "/exec/e1/start":
+		payload := []byte("reloaded\n")
+		header := make([]byte, 8)
+		header[0] = 1
+		binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+		w.Header().Set("Content-Type", "application/vnd.docker.raw-stream")
+		w.Write(append(header, payload...))
+
+	case r.Method == http.MethodGet && r.URL.Path == "/exec/e1/json":
+		w.Write([]byte(`{"ID":"e1","Running":false,"ExitCode":` + strconv.Itoa(m.exitCode) + `}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"page not found"}`))
+	}
+}
+
+func startMockDockerEngine(t *testing.T) (*mockDockerEngine, string) {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock := &mockDockerEngine{files: make(map[string]string), modes: make(map[string]int64)}
+	server := httptest.NewUnstartedServer(mock)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return mock, "unix://" + socketPath
+}
+
+func TestDeploy(t *testing.T) {
+	t.Run("ArchiveAndSignal", func(t *testing.T) {
+		mock, dockerHost := startMockDockerEngine(t)
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			DockerHost:      dockerHost,
+			ContainerLabels: []string{"app=nginx"},
+			OutputCertPath:  "/etc/nginx/certs/cert.pem",
+			OutputKeyPath:   "/etc/nginx/certs/key.pem",
+			ReloadMode:      provider.RELOAD_MODE_SIGNAL,
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), "CERT", "KEY")
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if got := mock.files["c2:/etc/nginx/certs/key.pem"]; got != "KEY" {
+			t.Errorf("unexpected uploaded private key: %q", got)
+		}
+		if got := mock.files["c1:/etc/nginx/certs/cert.pem"]; got != "CERT" {
+			t.Errorf("unexpected uploaded certificate: %q", got)
+		}
+		if got := mock.modes["c2:/etc/nginx/certs/key.pem"]; got != 0o600 {
+			t.Errorf("unexpected private key file mode: %o", got)
+		}
+		if got := mock.modes["c1:/etc/nginx/certs/cert.pem"]; got != 0o644 {
+			t.Errorf("unexpected certificate file mode: %o", got)
+		}
+		if got := strings.Join(mock.signals, ","); got != "c1:SIGHUP,c2:SIGHUP" {
+			t.Errorf("unexpected signals: %s", got)
+		}
+		if got := res.ExtendedData["containers"].([]string); len(got) != 2 || got[0] != "nginx-1" {
+			t.Errorf("unexpected containers: %v", got)
+		}
+	})
+
+	t.Run("Exec", func(t *testing.T) {
+		mock, dockerHost := startMockDockerEngine(t)
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			DockerHost:      dockerHost,
+			ContainerLabels: []string{"app=nginx"},
+			OutputCertPath:  "/certs/cert.pem",
+			OutputKeyPath:   "/certs/key.pem",
+			ReloadMode:      provider.RELOAD_MODE_EXEC,
+			ReloadCommand:   "nginx -s reload",
+		})
+		if _, err := deployer.Deploy(context.Background(), "CERT", "KEY"); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if len(mock.execs) != 2 || mock.execs[0] != "sh -c nginx -s reload" {
+			t.Errorf("unexpected execs: %v", mock.execs)
+		}
+
+		mock.exitCode = 1
+		if _, err := deployer.Deploy(context.Background(), "CERT", "KEY"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		_, dockerHost := startMockDockerEngine(t)
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			DockerHost:      dockerHost,
+			ContainerLabels: []string{"app=unknown"},
+			OutputCertPath:  "/certs/cert.pem",
+			OutputKeyPath:   "/certs/key.pem",
+		})
+		if _, err := deployer.Deploy(context.Background(), "CERT", "KEY"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
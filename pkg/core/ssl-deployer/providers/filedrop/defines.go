@@ -0,0 +1,12 @@
+package filedrop
+
+type TransportType string
+
+const (
+	// 传输方式：SFTP。
+	TRANSPORT_SFTP = TransportType("sftp")
+	// 传输方式：FTP/FTPS。
+	TRANSPORT_FTP = TransportType("ftp")
+	// 传输方式：兼容 S3 协议的对象存储。
+	TRANSPORT_S3 = TransportType("s3")
+)
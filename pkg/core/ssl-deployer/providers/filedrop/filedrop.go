@@ -0,0 +1,279 @@
+package filedrop
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	s3sdk "github.com/certimate-go/certimate/pkg/sdk3rd/s3"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+	xftp "github.com/certimate-go/certimate/pkg/utils/ftp"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+type JumpServerConfig = xssh.JumpServerConfig
+
+type SSLDeployerProviderConfig struct {
+	// 传输方式。
+	Transport TransportType `json:"transport"`
+	// SSH 主机。
+	// 传输方式为 [TRANSPORT_SFTP] 时必填。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认值 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 认证方式。
+	// 可取值 "none"、"password" 或 "key"。
+	// 零值时根据有无密码或私钥字段决定。
+	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机配置数组。
+	JumpServers []JumpServerConfig `json:"jumpServers,omitempty"`
+	// FTP 主机。
+	// 传输方式为 [TRANSPORT_FTP] 时必填。
+	FtpHost string `json:"ftpHost,omitempty"`
+	// FTP 端口。
+	// 零值时隐式 TLS 默认值 990，否则默认值 21。
+	FtpPort int32 `json:"ftpPort,omitempty"`
+	// FTP 登录用户名。
+	FtpUsername string `json:"ftpUsername,omitempty"`
+	// FTP 登录密码。
+	FtpPassword string `json:"ftpPassword,omitempty"`
+	// FTP TLS 模式。
+	// 可取值 "none"、"explicit"、"implicit"。
+	// 零值时默认值 "none"。
+	FtpTLSMode string `json:"ftpTLSMode,omitempty"`
+	// S3 服务端点。
+	// 传输方式为 [TRANSPORT_S3] 时必填。
+	S3Endpoint string `json:"s3Endpoint,omitempty"`
+	// S3 AccessKey。
+	S3AccessKey string `json:"s3AccessKey,omitempty"`
+	// S3 SecretKey。
+	S3SecretKey string `json:"s3SecretKey,omitempty"`
+	// S3 区域。
+	// 零值时默认值 "us-east-1"。
+	S3Region string `json:"s3Region,omitempty"`
+	// S3 存储桶名。
+	// 传输方式为 [TRANSPORT_S3] 时必填。
+	S3Bucket string `json:"s3Bucket,omitempty"`
+	// 是否使用虚拟主机风格访问存储桶。
+	// 零值时使用路径风格。
+	S3VirtualHostedStyle bool `json:"s3VirtualHostedStyle,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 输出证书格式。
+	OutputFormat xcert.OutputFormatType `json:"outputFormat,omitempty"`
+	// 输出证书文件路径。
+	// 传输方式为 [TRANSPORT_S3] 时为对象键。
+	OutputCertPath string `json:"outputCertPath,omitempty"`
+	// 输出服务器证书文件路径。
+	// 选填。
+	OutputServerCertPath string `json:"outputServerCertPath,omitempty"`
+	// 输出中间证书文件路径。
+	// 选填。
+	OutputIntermediaCertPath string `json:"outputIntermediaCertPath,omitempty"`
+	// 输出私钥文件路径。
+	OutputKeyPath string `json:"outputKeyPath,omitempty"`
+	// PFX 导出密码。
+	// 证书格式为 PFX 时必填。
+	PfxPassword string `json:"pfxPassword,omitempty"`
+	// JKS 别名。
+	// 证书格式为 JKS 时必填。
+	JksAlias string `json:"jksAlias,omitempty"`
+	// JKS 密钥密码。
+	// 证书格式为 JKS 时必填。
+	JksKeypass string `json:"jksKeypass,omitempty"`
+	// JKS 存储密码。
+	// 证书格式为 JKS 时必填。
+	JksStorepass string `json:"jksStorepass,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		config: config,
+		logger: slog.Default(),
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	// 按输出格式生成待上传的文件
+	files, err := xcert.BuildOutputFiles(certPEM, privkeyPEM, xcert.OutputOptions{
+		Format:             d.config.OutputFormat,
+		CertPath:           d.config.OutputCertPath,
+		ServerCertPath:     d.config.OutputServerCertPath,
+		IntermediaCertPath: d.config.OutputIntermediaCertPath,
+		KeyPath:            d.config.OutputKeyPath,
+		PfxPassword:        d.config.PfxPassword,
+		JksAlias:           d.config.JksAlias,
+		JksKeypass:         d.config.JksKeypass,
+		JksStorepass:       d.config.JksStorepass,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 连接
+	uploader, err := d.createUploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uploader.Close()
+
+	// 上传文件
+	uploaded := make([]string, 0, len(files))
+	for _, file := range files {
+		if err := uploader.Upload(ctx, file.Path, file.Data); err != nil {
+			return nil, fmt.Errorf("failed to upload %s file: %w", file.Name, err)
+		}
+
+		d.logger.Info(fmt.Sprintf("%s file uploaded", file.Name), slog.String("transport", string(d.config.Transport)), slog.String("path", file.Path))
+		uploaded = append(uploaded, file.Path)
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"files": uploaded,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) createUploader(ctx context.Context) (uploader, error) {
+	switch d.config.Transport {
+	case TRANSPORT_SFTP:
+
+		client, err := xssh.DialWithJumpServers(ctx, xssh.ServerConfig{
+			Host:          d.config.SshHost,
+			Port:          d.config.SshPort,
+			AuthMethod:    d.config.SshAuthMethod,
+			Username:      d.config.SshUsername,
+			Password:      d.config.SshPassword,
+			Key:           d.config.SshKey,
+			KeyPassphrase: d.config.SshKeyPassphrase,
+		}, d.config.JumpServers)
+		if err != nil {
+			return nil, err
+		}
+
+		d.logger.Info("ssh connected")
+		return &sftpUploader{client: client}, nil
+
+	case TRANSPORT_FTP:
+		if d.config.FtpHost == "" {
+			return nil, errors.New("config `ftpHost` is required")
+		}
+
+		client, err := xftp.Dial(ctx, xftp.ServerConfig{
+			Host:      d.config.FtpHost,
+			Port:      d.config.FtpPort,
+			Username:  d.config.FtpUsername,
+			Password:  d.config.FtpPassword,
+			TLSMode:   xftp.TLSMode(d.config.FtpTLSMode),
+			TLSConfig: &tls.Config{InsecureSkipVerify: d.config.AllowInsecureConnections},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		d.logger.Info("ftp connected")
+		return &ftpUploader{client: client}, nil
+
+	case TRANSPORT_S3:
+		if d.config.S3Bucket == "" {
+			return nil, errors.New("config `s3Bucket` is required")
+		}
+
+		client, err := s3sdk.NewClient(d.config.S3Endpoint, d.config.S3AccessKey, d.config.S3SecretKey, d.config.S3Region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sdk client: %w", err)
+		}
+
+		client.SetVirtualHostedStyle(d.config.S3VirtualHostedStyle)
+		if d.config.AllowInsecureConnections {
+			client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+		}
+
+		return &s3Uploader{client: client, bucket: d.config.S3Bucket}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported transport '%s'", d.config.Transport)
+	}
+}
+
+type uploader interface {
+	Upload(ctx context.Context, path string, data []byte) error
+	Close() error
+}
+
+type sftpUploader struct {
+	client *xssh.Client
+}
+
+func (u *sftpUploader) Upload(ctx context.Context, path string, data []byte) error {
+	// 仅使用 SFTP 子系统，不依赖远程 Shell
+	return xssh.WriteFile(u.client.Client, false, path, data)
+}
+
+func (u *sftpUploader) Close() error {
+	return u.client.Close()
+}
+
+type ftpUploader struct {
+	client *xftp.Client
+}
+
+func (u *ftpUploader) Upload(ctx context.Context, path string, data []byte) error {
+	return u.client.Store(path, data)
+}
+
+func (u *ftpUploader) Close() error {
+	return u.client.Close()
+}
+
+type s3Uploader struct {
+	client *s3sdk.Client
+	bucket string
+}
+
+func (u *s3Uploader) Upload(ctx context.Context, path string, data []byte) error {
+	contentType := "application/octet-stream"
+	if strings.HasPrefix(string(data), "-----BEGIN ") {
+		contentType = "application/x-pem-file"
+	}
+
+	return u.client.PutObjectWithContext(ctx, u.bucket, path, data, contentType)
+}
+
+func (u *s3Uploader) Close() error {
+	return nil
+}
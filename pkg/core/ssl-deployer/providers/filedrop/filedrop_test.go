@@ -0,0 +1,318 @@
+package filedrop_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/filedrop"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+func generateTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privkeyDER, err := x509.MarshalECPrivateKey(privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	privkeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER}))
+	return certPEM, privkeyPEM
+}
+
+// 启动一个仅提供 SFTP 子系统（不允许执行命令）的 SSH 服务器。
+func startSFTPServer(t *testing.T) (string, int32) {
+	t.Helper()
+
+	_, hostKey, _ := ed25519.GenerateKey(rand.Reader)
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == "user" && string(password) == "pass" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("access denied")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	handlers := sftp.InMemHandler()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				_, chans, reqs, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+
+				for newChannel := range chans {
+					if newChannel.ChannelType() != "session" {
+						newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+						continue
+					}
+
+					channel, requests, err := newChannel.Accept()
+					if err != nil {
+						continue
+					}
+
+					go func(in <-chan *ssh.Request) {
+						for req := range in {
+							ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+							req.Reply(ok, nil)
+							if ok {
+								server := sftp.NewRequestServer(channel, handlers)
+								server.Serve()
+								server.Close()
+							}
+						}
+					}(requests)
+				}
+			}(conn)
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), int32(addr.Port)
+}
+
+// 启动一个仅支持上传所需命令的明文 FTP 服务器。
+func startFTPServer(t *testing.T) (string, int32, map[string]string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var mtx sync.Mutex
+	files := make(map[string]string)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+
+				reader := bufio.NewReader(conn)
+				reply := func(s string) { fmt.Fprintf(conn, "%s\r\n", s) }
+				reply("220 ready")
+
+				var dataListener net.Listener
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+
+					cmd, arg, _ := strings.Cut(strings.TrimSpace(line), " ")
+					switch strings.ToUpper(cmd) {
+					case "USER":
+						reply("331 password required")
+					case "PASS":
+						if arg == "pass" {
+							reply("230 logged in")
+						} else {
+							reply("530 login incorrect")
+						}
+					case "TYPE":
+						reply("200 ok")
+					case "MKD":
+						reply("257 created")
+					case "EPSV":
+						dataListener, _ = net.Listen("tcp", "127.0.0.1:0")
+						reply("229 Entering Extended Passive Mode (|||" + strconv.Itoa(dataListener.Addr().(*net.TCPAddr).Port) + "|)")
+					case "STOR":
+						reply("150 opening data connection")
+						dataConn, err := dataListener.Accept()
+						if err != nil {
+							reply("425 cannot open data connection")
+							continue
+						}
+						data, _ := io.ReadAll(dataConn)
+						dataConn.Close()
+						dataListener.Close()
+
+						mtx.Lock()
+						files[arg] = string(data)
+						mtx.Unlock()
+						reply("226 transfer complete")
+					case "QUIT":
+						reply("221 bye")
+						return
+					default:
+						reply("502 not implemented")
+					}
+				}
+			}(conn)
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), int32(addr.Port), files
+}
+
+func TestDeploy(t *testing.T) {
+	certPEM, privkeyPEM := generateTestCertificate(t)
+
+	t.Run("SFTP", func(t *testing.T) {
+		host, port := startSFTPServer(t)
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			Transport:      provider.TRANSPORT_SFTP,
+			SshHost:        host,
+			SshPort:        port,
+			SshAuthMethod:  "password",
+			SshUsername:    "user",
+			SshPassword:    "pass",
+			OutputFormat:   xcert.OUTPUT_FORMAT_PEM,
+			OutputCertPath: "/certs/fullchain.pem",
+			OutputKeyPath:  "/certs/privkey.pem",
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		client, err := xssh.Dial(context.Background(), xssh.ServerConfig{Host: host, Port: port, AuthMethod: "password", Username: "user", Password: "pass"}, nil)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		defer client.Close()
+
+		data, err := xssh.ReadFile(client.Client, "/certs/privkey.pem")
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if string(data) != privkeyPEM {
+			t.Errorf("unexpected private key file content")
+		}
+	})
+
+	t.Run("FTP", func(t *testing.T) {
+		host, port, files := startFTPServer(t)
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			Transport:      provider.TRANSPORT_FTP,
+			FtpHost:        host,
+			FtpPort:        port,
+			FtpUsername:    "user",
+			FtpPassword:    "pass",
+			OutputFormat:   xcert.OUTPUT_FORMAT_PFX,
+			OutputCertPath: "/certs/cert.pfx",
+			PfxPassword:    "secret",
+		})
+		res, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if len(files["/certs/cert.pfx"]) == 0 {
+			t.Errorf("pfx file is not uploaded")
+		}
+		if got := res.ExtendedData["files"].([]string); len(got) != 1 {
+			t.Errorf("unexpected uploaded files: %v", got)
+		}
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		var mtx sync.Mutex
+		objects := make(map[string]string)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AK/") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			data, _ := io.ReadAll(r.Body)
+			mtx.Lock()
+			objects[r.URL.Path] = string(data)
+			mtx.Unlock()
+		}))
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			Transport:      provider.TRANSPORT_S3,
+			S3Endpoint:     server.URL,
+			S3AccessKey:    "AK",
+			S3SecretKey:    "SK",
+			S3Bucket:       "certs",
+			OutputCertPath: "example.com/fullchain.pem",
+			OutputKeyPath:  "example.com/privkey.pem",
+		})
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if objects["/certs/example.com/fullchain.pem"] != certPEM {
+			t.Errorf("certificate object is not uploaded with path-style addressing: %v", objects)
+		}
+		if objects["/certs/example.com/privkey.pem"] != privkeyPEM {
+			t.Errorf("private key object is not uploaded")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package haproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	xfile "github.com/certimate-go/certimate/pkg/utils/file"
+)
+
+type SSLDeployerProviderConfig struct {
+	// HAProxy Runtime API 地址。
+	// 支持 Unix 套接字（如 "/var/run/haproxy.sock"、"unix:///var/run/haproxy.sock"）或 TCP（如 "127.0.0.1:9999"、"tcp://127.0.0.1:9999"）。
+	RuntimeApiAddress string `json:"runtimeApiAddress"`
+	// 证书名称，即 HAProxy 配置中 `crt` 指令引用的证书文件路径。
+	CertificateName string `json:"certificateName"`
+	// 输出证书文件路径。
+	// 选填。非空时会同时将包含证书链和私钥的 PEM 文件写入本地磁盘，以便 HAProxy 重启后仍能加载新证书。
+	OutputCertPath string `json:"outputCertPath,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		config: config,
+		logger: slog.Default(),
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if d.config.RuntimeApiAddress == "" {
+		return nil, errors.New("config `runtimeApiAddress` is required")
+	}
+	if d.config.CertificateName == "" {
+		return nil, errors.New("config `certificateName` is required")
+	}
+
+	// HAProxy 要求证书链和私钥位于同一个 PEM 文件中
+	combinedPEM := buildCombinedPEM(certPEM, privkeyPEM)
+
+	// 开启证书更新事务
+	// REF: https://docs.haproxy.org/3.0/management.html#9.3-set%20ssl%20cert
+	setResp, err := d.execCommand(ctx, fmt.Sprintf("set ssl cert %s <<\n%s", d.config.CertificateName, combinedPEM))
+	d.logger.Debug("runtime api command 'set ssl cert'", slog.String("certificateName", d.config.CertificateName), slog.String("response", setResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute runtime api command 'set ssl cert': %w", err)
+	} else if !strings.Contains(setResp, "Transaction created") && !strings.Contains(setResp, "Transaction updated") {
+		d.abortTransaction(ctx)
+		return nil, fmt.Errorf("failed to execute runtime api command 'set ssl cert': %s", strings.TrimSpace(setResp))
+	}
+
+	// 提交证书更新事务
+	// REF: https://docs.haproxy.org/3.0/management.html#9.3-commit%20ssl%20cert
+	commitResp, err := d.execCommand(ctx, fmt.Sprintf("commit ssl cert %s", d.config.CertificateName))
+	d.logger.Debug("runtime api command 'commit ssl cert'", slog.String("certificateName", d.config.CertificateName), slog.String("response", commitResp))
+	if err != nil {
+		d.abortTransaction(ctx)
+		return nil, fmt.Errorf("failed to execute runtime api command 'commit ssl cert': %w", err)
+	} else if !strings.Contains(commitResp, "Success!") {
+		d.abortTransaction(ctx)
+		return nil, fmt.Errorf("failed to execute runtime api command 'commit ssl cert': %s", strings.TrimSpace(commitResp))
+	}
+
+	d.logger.Info("ssl certificate updated in memory via runtime api", slog.String("certificateName", d.config.CertificateName))
+
+	// 写入本地磁盘
+	if d.config.OutputCertPath != "" {
+		// 文件中包含私钥，仅允许所有者读写
+		if err := xfile.WriteAtomic(d.config.OutputCertPath, []byte(combinedPEM), 0o600); err != nil {
+			return nil, fmt.Errorf("failed to save certificate file: %w", err)
+		}
+
+		d.logger.Info("ssl certificate file saved", slog.String("path", d.config.OutputCertPath))
+	}
+
+	return &core.SSLDeployResult{}, nil
+}
+
+func (d *SSLDeployerProvider) abortTransaction(ctx context.Context) {
+	abortResp, err := d.execCommand(ctx, fmt.Sprintf("abort ssl cert %s", d.config.CertificateName))
+	d.logger.Debug("runtime api command 'abort ssl cert'", slog.String("certificateName", d.config.CertificateName), slog.String("response", abortResp), slog.Any("error", err))
+}
+
+func (d *SSLDeployerProvider) execCommand(ctx context.Context, command string) (string, error) {
+	network, address := parseRuntimeApiAddress(d.config.RuntimeApiAddress)
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to runtime api: %w", err)
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(30 * time.Second)
+	}
+	conn.SetDeadline(deadline)
+
+	// 非交互模式下，HAProxy 在执行完一条命令并返回结果后会主动关闭连接；
+	// 多行负载需以一个空行结束。
+	if !strings.HasSuffix(command, "\n") {
+		command += "\n"
+	}
+	if strings.Contains(command, "<<\n") {
+		command += "\n"
+	}
+	if _, err := conn.Write([]byte(command)); err != nil {
+		return "", fmt.Errorf("failed to write to runtime api: %w", err)
+	}
+
+	resp, err := io.ReadAll(conn)
+	if err != nil {
+		return string(resp), fmt.Errorf("failed to read from runtime api: %w", err)
+	}
+
+	return string(resp), nil
+}
+
+// 将证书链和私钥合并为一个 PEM 文本。
+// Runtime API 的多行负载以第一个空行结束，因此需要移除其中的所有空行。
+func buildCombinedPEM(certPEM string, privkeyPEM string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(certPEM+"\n"+privkeyPEM, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			continue
+		}
+
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func parseRuntimeApiAddress(address string) (_network string, _address string) {
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		return "unix", strings.TrimPrefix(address, "unix://")
+	case strings.HasPrefix(address, "tcp://"):
+		return "tcp", strings.TrimPrefix(address, "tcp://")
+	case strings.HasPrefix(address, "/"), strings.HasPrefix(address, "./"):
+		return "unix", address
+	default:
+		return "tcp", address
+	}
+}
@@ -0,0 +1,15 @@
+package k8singress
+
+type ResourceType string
+
+const (
+	// 资源类型：Ingress。
+	RESOURCE_TYPE_INGRESS = ResourceType("ingress")
+	// 资源类型：Gateway API 网关。
+	RESOURCE_TYPE_GATEWAY = ResourceType("gateway")
+)
+
+const (
+	defaultSecretDataKeyForCrt = "tls.crt"
+	defaultSecretDataKeyForKey = "tls.key"
+)
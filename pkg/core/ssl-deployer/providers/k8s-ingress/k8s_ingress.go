@@ -0,0 +1,488 @@
+package k8singress
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	k8score "k8s.io/api/core/v1"
+	k8snetworking "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+type SSLDeployerProviderConfig struct {
+	// kubeconfig 文件内容。
+	KubeConfig string `json:"kubeConfig,omitempty"`
+	// Kubernetes 命名空间数组。
+	// 零值时查找所有命名空间。
+	Namespaces []string `json:"namespaces,omitempty"`
+	// 资源类型数组。
+	// 零值时同时查找 Ingress 和 Gateway。
+	ResourceTypes []ResourceType `json:"resourceTypes,omitempty"`
+	// 是否修改 Ingress 的 `tls` 配置块。
+	// 启用后，对于主机名匹配但尚未配置 TLS 的 Ingress，将自动添加 TLS 配置。
+	PatchIngressTLS bool `json:"patchIngressTLS,omitempty"`
+	// 修改 Ingress 时使用的 Kubernetes Secret 名称。
+	// 零值时根据证书主题自动生成。
+	SecretName string `json:"secretName,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+
+	// 仅供测试时注入
+	client        kubernetes.Interface
+	dynamicClient dynamic.Interface
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+var gatewayGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"}
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		logger: slog.Default(),
+		config: config,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	certX509, err := xcert.ParseCertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	// 连接
+	if d.client == nil || d.dynamicClient == nil {
+		client, dynamicClient, err := createK8sClients(d.config.KubeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create k8s client: %w", err)
+		}
+
+		d.client = client
+		d.dynamicClient = dynamicClient
+	}
+
+	plan := newDeployPlan()
+	touchedIngresses := make([]string, 0)
+	touchedGateways := make([]string, 0)
+
+	// 查找匹配的 Ingress
+	if d.isResourceTypeEnabled(RESOURCE_TYPE_INGRESS) {
+		ingresses, err := d.findMatchedIngresses(ctx, certX509, plan)
+		if err != nil {
+			return nil, err
+		}
+
+		touchedIngresses = append(touchedIngresses, ingresses...)
+	}
+
+	// 查找匹配的 Gateway
+	if d.isResourceTypeEnabled(RESOURCE_TYPE_GATEWAY) {
+		gateways, err := d.findMatchedGateways(ctx, certX509, plan)
+		if err != nil {
+			return nil, err
+		}
+
+		touchedGateways = append(touchedGateways, gateways...)
+	}
+
+	if len(plan.secrets) == 0 {
+		return nil, errors.New("could not find any ingresses or gateways matching the certificate")
+	}
+
+	// 在各命名空间中创建或更新 Secret
+	touchedSecrets := make([]string, 0, len(plan.secrets))
+	for _, target := range plan.secrets {
+		if err := d.upsertSecret(ctx, target.Namespace, target.Name, certX509, certPEM, privkeyPEM); err != nil {
+			return nil, err
+		}
+
+		touchedSecrets = append(touchedSecrets, target.String())
+	}
+
+	// 最后修改 Ingress，确保其引用的 Secret 已就绪
+	patchedIngresses := make([]string, 0)
+	for _, ingress := range plan.pendingIngresses {
+		resp, err := d.client.NetworkingV1().Ingresses(ingress.Namespace).Update(ctx, ingress, k8smeta.UpdateOptions{})
+		d.logger.Debug("k8s operate 'Ingresses.Update'", slog.String("namespace", ingress.Namespace), slog.Any("ingress", resp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update k8s ingress '%s/%s': %w", ingress.Namespace, ingress.Name, err)
+		}
+
+		patchedIngresses = append(patchedIngresses, ingress.Namespace+"/"+ingress.Name)
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"secrets":          touchedSecrets,
+			"ingresses":        touchedIngresses,
+			"patchedIngresses": patchedIngresses,
+			"gateways":         touchedGateways,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) isResourceTypeEnabled(resourceType ResourceType) bool {
+	return len(d.config.ResourceTypes) == 0 || slices.Contains(d.config.ResourceTypes, resourceType)
+}
+
+func (d *SSLDeployerProvider) namespaces() []string {
+	namespaces := make([]string, 0, len(d.config.Namespaces))
+	for _, namespace := range d.config.Namespaces {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	// 空字符串表示所有命名空间
+	if len(namespaces) == 0 {
+		return []string{k8smeta.NamespaceAll}
+	}
+
+	return namespaces
+}
+
+func (d *SSLDeployerProvider) findMatchedIngresses(ctx context.Context, certX509 *x509.Certificate, plan *deployPlan) ([]string, error) {
+	matched := make([]string, 0)
+
+	for _, namespace := range d.namespaces() {
+		listResp, err := d.client.NetworkingV1().Ingresses(namespace).List(ctx, k8smeta.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list k8s ingresses: %w", err)
+		}
+
+		for _, ingress := range listResp.Items {
+			touched := false
+
+			// 已配置 TLS 的主机：当证书能覆盖该 TLS 配置块中的全部主机时，更新其引用的 Secret
+			coveredHosts := make(map[string]struct{})
+			for _, tls := range ingress.Spec.TLS {
+				if len(tls.Hosts) == 0 || tls.SecretName == "" {
+					continue
+				}
+
+				if !isAllHostnamesCovered(certX509, tls.Hosts) {
+					continue
+				}
+
+				for _, host := range tls.Hosts {
+					coveredHosts[strings.ToLower(host)] = struct{}{}
+				}
+
+				plan.AddSecret(ingress.Namespace, tls.SecretName)
+				touched = true
+			}
+
+			// 未配置 TLS 的主机：按需添加 TLS 配置块
+			if d.config.PatchIngressTLS {
+				pendingHosts := make([]string, 0)
+				for _, tls := range ingress.Spec.TLS {
+					for _, host := range tls.Hosts {
+						coveredHosts[strings.ToLower(host)] = struct{}{}
+					}
+				}
+				for _, rule := range ingress.Spec.Rules {
+					if rule.Host == "" || !isHostnameCovered(certX509, rule.Host) {
+						continue
+					}
+					if _, ok := coveredHosts[strings.ToLower(rule.Host)]; ok {
+						continue
+					}
+					if slices.Contains(pendingHosts, rule.Host) {
+						continue
+					}
+
+					pendingHosts = append(pendingHosts, rule.Host)
+				}
+
+				if len(pendingHosts) > 0 {
+					secretName := d.config.SecretName
+					if secretName == "" {
+						secretName = generateSecretName(certX509)
+					}
+
+					patched := ingress.DeepCopy()
+					patched.Spec.TLS = append(patched.Spec.TLS, k8snetworking.IngressTLS{
+						Hosts:      pendingHosts,
+						SecretName: secretName,
+					})
+
+					plan.AddSecret(ingress.Namespace, secretName)
+					plan.pendingIngresses = append(plan.pendingIngresses, patched)
+					touched = true
+				}
+			}
+
+			if touched {
+				matched = append(matched, ingress.Namespace+"/"+ingress.Name)
+				d.logger.Info("k8s ingress matched", slog.String("namespace", ingress.Namespace), slog.String("name", ingress.Name))
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+func (d *SSLDeployerProvider) findMatchedGateways(ctx context.Context, certX509 *x509.Certificate, plan *deployPlan) ([]string, error) {
+	matched := make([]string, 0)
+
+	for _, namespace := range d.namespaces() {
+		listResp, err := d.dynamicClient.Resource(gatewayGVR).Namespace(namespace).List(ctx, k8smeta.ListOptions{})
+		if err != nil {
+			// 集群未安装 Gateway API 的 CRD 时跳过
+			if k8serrors.IsNotFound(err) {
+				d.logger.Info("k8s gateway api is not installed, skipped")
+				return matched, nil
+			}
+
+			return nil, fmt.Errorf("failed to list k8s gateways: %w", err)
+		}
+
+		for _, gateway := range listResp.Items {
+			touched := false
+
+			listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+			for _, listener := range listeners {
+				listenerObj, ok := listener.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				// 未指定主机名的监听器可匹配任意主机，无法判断应使用哪张证书，故跳过
+				hostname, _, _ := unstructured.NestedString(listenerObj, "hostname")
+				if hostname == "" || !isHostnameCovered(certX509, hostname) {
+					continue
+				}
+
+				certRefs, _, _ := unstructured.NestedSlice(listenerObj, "tls", "certificateRefs")
+				for _, certRef := range certRefs {
+					certRefObj, ok := certRef.(map[string]any)
+					if !ok {
+						continue
+					}
+
+					group, _, _ := unstructured.NestedString(certRefObj, "group")
+					kind, _, _ := unstructured.NestedString(certRefObj, "kind")
+					name, _, _ := unstructured.NestedString(certRefObj, "name")
+					refNamespace, _, _ := unstructured.NestedString(certRefObj, "namespace")
+					if group != "" && group != "core" {
+						continue
+					}
+					if kind != "" && kind != "Secret" {
+						continue
+					}
+					if name == "" {
+						continue
+					}
+					if refNamespace == "" {
+						refNamespace = gateway.GetNamespace()
+					}
+
+					plan.AddSecret(refNamespace, name)
+					touched = true
+				}
+			}
+
+			if touched {
+				matched = append(matched, gateway.GetNamespace()+"/"+gateway.GetName())
+				d.logger.Info("k8s gateway matched", slog.String("namespace", gateway.GetNamespace()), slog.String("name", gateway.GetName()))
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+func (d *SSLDeployerProvider) upsertSecret(ctx context.Context, namespace, name string, certX509 *x509.Certificate, certPEM, privkeyPEM string) error {
+	secretAnnotations := map[string]string{
+		"certimate/common-name":       certX509.Subject.CommonName,
+		"certimate/subject-sn":        certX509.Subject.SerialNumber,
+		"certimate/subject-alt-names": strings.Join(certX509.DNSNames, ","),
+		"certimate/issuer-sn":         certX509.Issuer.SerialNumber,
+		"certimate/issuer-org":        strings.Join(certX509.Issuer.Organization, ","),
+	}
+
+	// 获取 Secret 实例，如果不存在则创建
+	secretPayload, err := d.client.CoreV1().Secrets(namespace).Get(ctx, name, k8smeta.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get k8s secret '%s/%s': %w", namespace, name, err)
+		}
+
+		secretPayload = &k8score.Secret{
+			TypeMeta: k8smeta.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: k8smeta.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: secretAnnotations,
+			},
+			Type: k8score.SecretTypeTLS,
+			Data: map[string][]byte{
+				defaultSecretDataKeyForCrt: []byte(certPEM),
+				defaultSecretDataKeyForKey: []byte(privkeyPEM),
+			},
+		}
+
+		secretPayload, err = d.client.CoreV1().Secrets(namespace).Create(ctx, secretPayload, k8smeta.CreateOptions{})
+		d.logger.Debug("k8s operate 'Secrets.Create'", slog.String("namespace", namespace), slog.Any("secret", secretPayload))
+		if err != nil {
+			return fmt.Errorf("failed to create k8s secret '%s/%s': %w", namespace, name, err)
+		}
+
+		return nil
+	}
+
+	// 更新 Secret 实例
+	if secretPayload.ObjectMeta.Annotations == nil {
+		secretPayload.ObjectMeta.Annotations = secretAnnotations
+	} else {
+		for k, v := range secretAnnotations {
+			secretPayload.ObjectMeta.Annotations[k] = v
+		}
+	}
+	if secretPayload.Data == nil {
+		secretPayload.Data = make(map[string][]byte)
+	}
+	secretPayload.Data[defaultSecretDataKeyForCrt] = []byte(certPEM)
+	secretPayload.Data[defaultSecretDataKeyForKey] = []byte(privkeyPEM)
+	secretPayload, err = d.client.CoreV1().Secrets(namespace).Update(ctx, secretPayload, k8smeta.UpdateOptions{})
+	d.logger.Debug("k8s operate 'Secrets.Update'", slog.String("namespace", namespace), slog.Any("secret", secretPayload))
+	if err != nil {
+		return fmt.Errorf("failed to update k8s secret '%s/%s': %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+type secretTarget struct {
+	Namespace string
+	Name      string
+}
+
+func (t secretTarget) String() string {
+	return t.Namespace + "/" + t.Name
+}
+
+type deployPlan struct {
+	secrets          []secretTarget
+	pendingIngresses []*k8snetworking.Ingress
+}
+
+func newDeployPlan() *deployPlan {
+	return &deployPlan{
+		secrets:          make([]secretTarget, 0),
+		pendingIngresses: make([]*k8snetworking.Ingress, 0),
+	}
+}
+
+func (s *deployPlan) AddSecret(namespace, name string) {
+	target := secretTarget{Namespace: namespace, Name: name}
+	if !slices.Contains(s.secrets, target) {
+		s.secrets = append(s.secrets, target)
+	}
+}
+
+func isHostnameCovered(certX509 *x509.Certificate, hostname string) bool {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+
+	// 通配符主机名仅能由同样的通配符证书覆盖
+	if strings.HasPrefix(hostname, "*.") {
+		return slices.ContainsFunc(certX509.DNSNames, func(san string) bool {
+			return strings.EqualFold(san, hostname)
+		})
+	}
+
+	return certX509.VerifyHostname(hostname) == nil
+}
+
+func isAllHostnamesCovered(certX509 *x509.Certificate, hostnames []string) bool {
+	for _, hostname := range hostnames {
+		if !isHostnameCovered(certX509, hostname) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var secretNameInvalidCharsRegexp = regexp.MustCompile(`[^a-z0-9-]+`)
+
+func generateSecretName(certX509 *x509.Certificate) string {
+	name := certX509.Subject.CommonName
+	if name == "" && len(certX509.DNSNames) > 0 {
+		name = certX509.DNSNames[0]
+	}
+
+	name = strings.ToLower(name)
+	name = strings.ReplaceAll(name, "*", "wildcard")
+	name = secretNameInvalidCharsRegexp.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	name = "certimate-" + name + "-tls"
+	if len(name) > 253 {
+		name = strings.TrimRight(name[:253], "-")
+	}
+
+	return name
+}
+
+func createK8sClients(kubeConfig string) (*kubernetes.Clientset, *dynamic.DynamicClient, error) {
+	var config *rest.Config
+	var err error
+	if kubeConfig == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		var clientConfig clientcmd.ClientConfig
+		clientConfig, err = clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
+		if err != nil {
+			return nil, nil, err
+		}
+		config, err = clientConfig.ClientConfig()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, dynamicClient, nil
+}
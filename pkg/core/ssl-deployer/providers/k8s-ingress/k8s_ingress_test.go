@@ -0,0 +1,206 @@
+package k8singress
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	k8snetworking "k8s.io/api/networking/v1"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func generateTestCertificate(t *testing.T, dnsNames ...string) (string, string) {
+	t.Helper()
+
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privkeyDER, err := x509.MarshalECPrivateKey(privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	privkeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER}))
+	return certPEM, privkeyPEM
+}
+
+func newTestGateway(namespace, name, hostname string, certRefs ...map[string]any) *unstructured.Unstructured {
+	refs := make([]any, len(certRefs))
+	for i, ref := range certRefs {
+		refs[i] = ref
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "Gateway",
+			"metadata": map[string]any{
+				"namespace": namespace,
+				"name":      name,
+			},
+			"spec": map[string]any{
+				"listeners": []any{
+					map[string]any{
+						"name":     "https",
+						"hostname": hostname,
+						"protocol": "HTTPS",
+						"tls": map[string]any{
+							"certificateRefs": refs,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	certPEM, privkeyPEM := generateTestCertificate(t, "example.com", "*.example.com")
+
+	client := fake.NewClientset(
+		// TLS 主机被证书完全覆盖，应更新其 Secret
+		&k8snetworking.Ingress{
+			ObjectMeta: k8smeta.ObjectMeta{Namespace: "web", Name: "covered"},
+			Spec: k8snetworking.IngressSpec{
+				TLS:   []k8snetworking.IngressTLS{{Hosts: []string{"www.example.com"}, SecretName: "www-tls"}},
+				Rules: []k8snetworking.IngressRule{{Host: "www.example.com"}},
+			},
+		},
+		// 未配置 TLS，应被修改
+		&k8snetworking.Ingress{
+			ObjectMeta: k8smeta.ObjectMeta{Namespace: "api", Name: "plain"},
+			Spec: k8snetworking.IngressSpec{
+				Rules: []k8snetworking.IngressRule{{Host: "api.example.com"}, {Host: "api.example.org"}},
+			},
+		},
+		// TLS 主机未被证书完全覆盖，不应处理
+		&k8snetworking.Ingress{
+			ObjectMeta: k8smeta.ObjectMeta{Namespace: "web", Name: "partial"},
+			Spec: k8snetworking.IngressSpec{
+				TLS: []k8snetworking.IngressTLS{{Hosts: []string{"example.com", "example.org"}, SecretName: "mixed-tls"}},
+			},
+		},
+		&k8score.Secret{
+			ObjectMeta: k8smeta.ObjectMeta{Namespace: "web", Name: "www-tls"},
+			Type:       k8score.SecretTypeTLS,
+			Data:       map[string][]byte{"tls.crt": []byte("old"), "tls.key": []byte("old")},
+		},
+	)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gatewayGVR: "GatewayList"},
+	)
+	for _, gateway := range []*unstructured.Unstructured{
+		newTestGateway("infra", "edge", "*.example.com", map[string]any{"name": "wildcard-tls"}, map[string]any{"name": "shared-tls", "namespace": "certs"}),
+		newTestGateway("infra", "other", "foo.example.net", map[string]any{"name": "other-tls"}),
+	} {
+		if _, err := dynamicClient.Resource(gatewayGVR).Namespace(gateway.GetNamespace()).Create(context.Background(), gateway, k8smeta.CreateOptions{}); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+	}
+
+	deployer, err := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+		PatchIngressTLS: true,
+		SecretName:      "example-tls",
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	deployer.client = client
+	deployer.dynamicClient = dynamicClient
+
+	res, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	secrets := res.ExtendedData["secrets"].([]string)
+	slices.Sort(secrets)
+	if want := []string{"api/example-tls", "certs/shared-tls", "infra/wildcard-tls", "web/www-tls"}; !slices.Equal(secrets, want) {
+		t.Errorf("unexpected secrets: got %v, want %v", secrets, want)
+	}
+
+	if patched := res.ExtendedData["patchedIngresses"].([]string); !slices.Equal(patched, []string{"api/plain"}) {
+		t.Errorf("unexpected patched ingresses: %v", patched)
+	}
+
+	if gateways := res.ExtendedData["gateways"].([]string); !slices.Equal(gateways, []string{"infra/edge"}) {
+		t.Errorf("unexpected gateways: %v", gateways)
+	}
+
+	for _, target := range secrets {
+		namespace, name, _ := strings.Cut(target, "/")
+		secret, err := client.CoreV1().Secrets(namespace).Get(context.Background(), name, k8smeta.GetOptions{})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if string(secret.Data["tls.crt"]) != certPEM || string(secret.Data["tls.key"]) != privkeyPEM {
+			t.Errorf("secret '%s' is not updated", target)
+		}
+	}
+
+	ingress, err := client.NetworkingV1().Ingresses("api").Get(context.Background(), "plain", k8smeta.GetOptions{})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if len(ingress.Spec.TLS) != 1 || ingress.Spec.TLS[0].SecretName != "example-tls" || !slices.Equal(ingress.Spec.TLS[0].Hosts, []string{"api.example.com"}) {
+		t.Errorf("unexpected ingress tls: %+v", ingress.Spec.TLS)
+	}
+
+	if _, err := client.CoreV1().Secrets("web").Get(context.Background(), "mixed-tls", k8smeta.GetOptions{}); err == nil {
+		t.Errorf("secret 'web/mixed-tls' should not be created")
+	}
+}
+
+func TestDeployNoMatch(t *testing.T) {
+	certPEM, privkeyPEM := generateTestCertificate(t, "example.com")
+
+	deployer, _ := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+		ResourceTypes: []ResourceType{RESOURCE_TYPE_INGRESS},
+	})
+	deployer.client = fake.NewClientset()
+	deployer.dynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestCreateK8sClientsInvalidKubeConfig(t *testing.T) {
+	// 可以解析，但缺少集群信息，生成客户端配置时会失败
+	kubeConfig := "apiVersion: v1\nkind: Config\nclusters: []\ncontexts: []\n"
+	if _, _, err := createK8sClients(kubeConfig); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
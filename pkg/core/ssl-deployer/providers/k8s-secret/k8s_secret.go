@@ -151,11 +151,12 @@ func createK8sClient(kubeConfig string) (*kubernetes.Clientset, error) {
 	if kubeConfig == "" {
 		config, err = rest.InClusterConfig()
 	} else {
-		kubeConfig, err := clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
+		var clientConfig clientcmd.ClientConfig
+		clientConfig, err = clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
 		if err != nil {
 			return nil, err
 		}
-		config, err = kubeConfig.ClientConfig()
+		config, err = clientConfig.ClientConfig()
 	}
 	if err != nil {
 		return nil, err
@@ -1,17 +1,14 @@
 package local
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
-	"os/exec"
-	"runtime"
 
 	"github.com/certimate-go/certimate/pkg/core"
 	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
-	xfile "github.com/certimate-go/certimate/pkg/utils/file"
+	xshell "github.com/certimate-go/certimate/pkg/utils/shell"
 )
 
 type SSLDeployerProviderConfig struct {
@@ -46,6 +43,23 @@ type SSLDeployerProviderConfig struct {
 	// JKS 存储密码。
 	// 证书格式为 JKS 时必填。
 	JksStorepass string `json:"jksStorepass,omitempty"`
+	// 是否以原子方式写入文件（先写入临时文件，再重命名覆盖目标文件）。
+	AtomicWrite bool `json:"atomicWrite,omitempty"`
+	// 是否在覆盖前备份原有文件。
+	// 启用后，后置命令执行失败时将自动还原原有文件。
+	BackupEnabled bool `json:"backupEnabled,omitempty"`
+	// 备份文件目录。
+	// 零值时备份到原有文件所在目录。
+	BackupDir string `json:"backupDir,omitempty"`
+	// 输出文件权限，八进制字符串，例如 "0600"。
+	// 零值时不修改。
+	FileMode string `json:"fileMode,omitempty"`
+	// 输出文件所有者，格式为 "user:group"，可使用用户名或数字 ID。
+	// 零值时不修改。
+	FileOwner string `json:"fileOwner,omitempty"`
+	// 回滚命令。
+	// 后置命令执行失败时，在还原文件后执行。
+	RollbackCommand string `json:"rollbackCommand,omitempty"`
 }
 
 type SSLDeployerProvider struct {
@@ -75,15 +89,14 @@ func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
-	// 提取服务器证书和中间证书
-	serverCertPEM, intermediaCertPEM, err := xcert.ExtractCertificatesFromPEM(certPEM)
+	writer, err := newFileWriter(d.config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract certs: %w", err)
+		return nil, err
 	}
 
 	// 执行前置命令
 	if d.config.PreCommand != "" {
-		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PreCommand)
+		stdout, stderr, err := xshell.ExecCommand(ctx, string(d.config.ShellEnv), d.config.PreCommand, "", nil)
 		d.logger.Debug("run pre-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
 		if err != nil {
 			return nil, fmt.Errorf("failed to execute pre-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
@@ -91,104 +104,81 @@ func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privke
 	}
 
 	// 写入证书和私钥文件
-	switch d.config.OutputFormat {
-	case OUTPUT_FORMAT_PEM:
-		if err := xfile.WriteString(d.config.OutputCertPath, certPEM); err != nil {
-			return nil, fmt.Errorf("failed to save certificate file: %w", err)
-		}
-		d.logger.Info("ssl certificate file saved", slog.String("path", d.config.OutputCertPath))
-
-		if d.config.OutputServerCertPath != "" {
-			if err := xfile.WriteString(d.config.OutputServerCertPath, serverCertPEM); err != nil {
-				return nil, fmt.Errorf("failed to save server certificate file: %w", err)
-			}
-			d.logger.Info("ssl server certificate file saved", slog.String("path", d.config.OutputServerCertPath))
-		}
-
-		if d.config.OutputIntermediaCertPath != "" {
-			if err := xfile.WriteString(d.config.OutputIntermediaCertPath, intermediaCertPEM); err != nil {
-				return nil, fmt.Errorf("failed to save intermedia certificate file: %w", err)
+	if err := d.writeFiles(writer, certPEM, privkeyPEM); err != nil {
+		if d.config.BackupEnabled {
+			if rerr := writer.Restore(); rerr != nil {
+				d.logger.Warn("failed to restore files", slog.Any("error", rerr))
+			} else {
+				d.logger.Info("files restored")
 			}
-			d.logger.Info("ssl intermedia certificate file saved", slog.String("path", d.config.OutputIntermediaCertPath))
-		}
-
-		if err := xfile.WriteString(d.config.OutputKeyPath, privkeyPEM); err != nil {
-			return nil, fmt.Errorf("failed to save private key file: %w", err)
-		}
-		d.logger.Info("ssl private key file saved", slog.String("path", d.config.OutputKeyPath))
-
-	case OUTPUT_FORMAT_PFX:
-		pfxData, err := xcert.TransformCertificateFromPEMToPFX(certPEM, privkeyPEM, d.config.PfxPassword)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transform certificate to PFX: %w", err)
-		}
-		d.logger.Info("ssl certificate transformed to pfx")
-
-		if err := xfile.Write(d.config.OutputCertPath, pfxData); err != nil {
-			return nil, fmt.Errorf("failed to save certificate file: %w", err)
-		}
-		d.logger.Info("ssl certificate file saved", slog.String("path", d.config.OutputCertPath))
-
-	case OUTPUT_FORMAT_JKS:
-		jksData, err := xcert.TransformCertificateFromPEMToJKS(certPEM, privkeyPEM, d.config.JksAlias, d.config.JksKeypass, d.config.JksStorepass)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transform certificate to JKS: %w", err)
 		}
-		d.logger.Info("ssl certificate transformed to jks")
-
-		if err := xfile.Write(d.config.OutputCertPath, jksData); err != nil {
-			return nil, fmt.Errorf("failed to save certificate file: %w", err)
-		}
-		d.logger.Info("ssl certificate file saved", slog.String("path", d.config.OutputCertPath))
-
-	default:
-		return nil, fmt.Errorf("unsupported output format '%s'", d.config.OutputFormat)
+		return nil, err
 	}
 
 	// 执行后置命令
 	if d.config.PostCommand != "" {
-		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PostCommand)
+		stdout, stderr, err := xshell.ExecCommand(ctx, string(d.config.ShellEnv), d.config.PostCommand, "", nil)
 		d.logger.Debug("run post-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
 		if err != nil {
-			return nil, fmt.Errorf("failed to execute post-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
+			err = fmt.Errorf("failed to execute post-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
+			return nil, errors.Join(err, d.rollback(ctx, writer))
+		}
+	}
+
+	result := &core.SSLDeployResult{}
+	if backups := writer.BackupPaths(); len(backups) > 0 {
+		result.ExtendedData = map[string]any{
+			"backups": backups,
 		}
 	}
 
-	return &core.SSLDeployResult{}, nil
+	return result, nil
 }
 
-func execCommand(shellEnv ShellEnvType, command string) (string, string, error) {
-	var cmd *exec.Cmd
+func (d *SSLDeployerProvider) writeFiles(writer *fileWriter, certPEM, privkeyPEM string) error {
+	files, err := xcert.BuildOutputFiles(certPEM, privkeyPEM, xcert.OutputOptions{
+		Format:             d.config.OutputFormat,
+		CertPath:           d.config.OutputCertPath,
+		ServerCertPath:     d.config.OutputServerCertPath,
+		IntermediaCertPath: d.config.OutputIntermediaCertPath,
+		KeyPath:            d.config.OutputKeyPath,
+		PfxPassword:        d.config.PfxPassword,
+		JksAlias:           d.config.JksAlias,
+		JksKeypass:         d.config.JksKeypass,
+		JksStorepass:       d.config.JksStorepass,
+	})
+	if err != nil {
+		return err
+	}
 
-	switch shellEnv {
-	case SHELL_ENV_SH:
-		cmd = exec.Command("sh", "-c", command)
+	for _, file := range files {
+		if err := writer.Write(file.Path, file.Data); err != nil {
+			return fmt.Errorf("failed to save %s file: %w", file.Name, err)
+		}
+		d.logger.Info(fmt.Sprintf("%s file saved", file.Name), slog.String("path", file.Path))
+	}
 
-	case SHELL_ENV_CMD:
-		cmd = exec.Command("cmd", "/C", command)
+	return nil
+}
 
-	case SHELL_ENV_POWERSHELL:
-		cmd = exec.Command("powershell", "-Command", command)
+func (d *SSLDeployerProvider) rollback(ctx context.Context, writer *fileWriter) error {
+	var errs []error
 
-	case ShellEnvType(""):
-		if runtime.GOOS == "windows" {
-			cmd = exec.Command("cmd", "/C", command)
+	if d.config.BackupEnabled {
+		if err := writer.Restore(); err != nil {
+			errs = append(errs, err)
 		} else {
-			cmd = exec.Command("sh", "-c", command)
+			d.logger.Info("files restored")
 		}
-
-	default:
-		return "", "", fmt.Errorf("unsupported shell env '%s'", shellEnv)
 	}
 
-	stdoutBuf := bytes.NewBuffer(nil)
-	cmd.Stdout = stdoutBuf
-	stderrBuf := bytes.NewBuffer(nil)
-	cmd.Stderr = stderrBuf
-	err := cmd.Run()
-	if err != nil {
-		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("failed to execute command: %w", err)
+	if d.config.RollbackCommand != "" {
+		stdout, stderr, err := xshell.ExecCommand(ctx, string(d.config.ShellEnv), d.config.RollbackCommand, "", nil)
+		d.logger.Debug("run rollback-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to execute rollback-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err))
+		}
 	}
 
-	return stdoutBuf.String(), stderrBuf.String(), nil
+	return errors.Join(errs...)
 }
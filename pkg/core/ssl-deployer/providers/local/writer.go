@@ -0,0 +1,183 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	xfile "github.com/certimate-go/certimate/pkg/utils/file"
+)
+
+type writtenFile struct {
+	Path       string
+	Existed    bool
+	Previous   []byte
+	PrevMode   os.FileMode
+	BackupPath string
+}
+
+// fileWriter 负责写入输出文件，并记录被覆盖的原有文件以便在部署失败时还原。
+type fileWriter struct {
+	atomic    bool
+	backup    bool
+	backupDir string
+	mode      os.FileMode
+	uid       int
+	gid       int
+	timestamp string
+
+	written []writtenFile
+}
+
+func newFileWriter(config *SSLDeployerProviderConfig) (*fileWriter, error) {
+	w := &fileWriter{
+		atomic:    config.AtomicWrite,
+		backup:    config.BackupEnabled,
+		backupDir: config.BackupDir,
+		uid:       -1,
+		gid:       -1,
+		timestamp: time.Now().Format("20060102150405"),
+		written:   make([]writtenFile, 0),
+	}
+
+	if config.FileMode != "" {
+		mode, err := strconv.ParseUint(config.FileMode, 8, 32)
+		if err != nil || mode > 0o777 {
+			return nil, fmt.Errorf("invalid file mode '%s'", config.FileMode)
+		}
+		w.mode = os.FileMode(mode)
+	}
+
+	if config.FileOwner != "" {
+		uid, gid, err := lookupFileOwner(config.FileOwner)
+		if err != nil {
+			return nil, err
+		}
+		w.uid = uid
+		w.gid = gid
+	}
+
+	return w, nil
+}
+
+func (w *fileWriter) Write(path string, data []byte) error {
+	record := writtenFile{Path: path}
+
+	// 记录原有文件内容
+	if info, err := os.Stat(path); err == nil {
+		prev, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read existing file: %w", err)
+		}
+
+		record.Existed = true
+		record.Previous = prev
+		record.PrevMode = info.Mode().Perm()
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to stat existing file: %w", err)
+	}
+
+	// 备份原有文件
+	if w.backup && record.Existed {
+		backupPath := path + "." + w.timestamp + ".bak"
+		if w.backupDir != "" {
+			backupPath = filepath.Join(w.backupDir, filepath.Base(backupPath))
+		}
+
+		if err := xfile.Copy(path, backupPath); err != nil {
+			return fmt.Errorf("failed to backup existing file: %w", err)
+		}
+		record.BackupPath = backupPath
+	}
+
+	if w.atomic {
+		if err := xfile.WriteAtomic(path, data, w.mode); err != nil {
+			return err
+		}
+	} else {
+		if err := xfile.Write(path, data); err != nil {
+			return err
+		}
+		if w.mode != 0 {
+			if err := os.Chmod(path, w.mode); err != nil {
+				return fmt.Errorf("failed to change file mode: %w", err)
+			}
+		}
+	}
+
+	if w.uid >= 0 && w.gid >= 0 {
+		if err := os.Chown(path, w.uid, w.gid); err != nil {
+			return fmt.Errorf("failed to change file owner: %w", err)
+		}
+	}
+
+	w.written = append(w.written, record)
+	return nil
+}
+
+func (w *fileWriter) BackupPaths() []string {
+	paths := make([]string, 0, len(w.written))
+	for _, record := range w.written {
+		if record.BackupPath != "" {
+			paths = append(paths, record.BackupPath)
+		}
+	}
+	return paths
+}
+
+// Restore 按写入的逆序还原原有文件；原本不存在的文件将被删除。
+func (w *fileWriter) Restore() error {
+	var errs []error
+
+	for i := len(w.written) - 1; i >= 0; i-- {
+		record := w.written[i]
+		if record.Existed {
+			if err := xfile.WriteAtomic(record.Path, record.Previous, record.PrevMode); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore file '%s': %w", record.Path, err))
+			}
+		} else {
+			if err := os.Remove(record.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to remove file '%s': %w", record.Path, err))
+			}
+		}
+	}
+
+	w.written = w.written[:0]
+	return errors.Join(errs...)
+}
+
+func lookupFileOwner(owner string) (int, int, error) {
+	userName, groupName, _ := strings.Cut(owner, ":")
+
+	uid, gid := -1, -1
+	if userName != "" {
+		if v, err := strconv.Atoi(userName); err == nil {
+			uid = v
+		} else if u, err := user.Lookup(userName); err != nil {
+			return -1, -1, fmt.Errorf("failed to lookup user '%s': %w", userName, err)
+		} else {
+			uid, _ = strconv.Atoi(u.Uid)
+			gid, _ = strconv.Atoi(u.Gid)
+		}
+	}
+	if groupName != "" {
+		if v, err := strconv.Atoi(groupName); err == nil {
+			gid = v
+		} else if g, err := user.LookupGroup(groupName); err != nil {
+			return -1, -1, fmt.Errorf("failed to lookup group '%s': %w", groupName, err)
+		} else {
+			gid, _ = strconv.Atoi(g.Gid)
+		}
+	}
+
+	if uid < 0 || gid < 0 {
+		return -1, -1, fmt.Errorf("invalid file owner '%s'", owner)
+	}
+
+	return uid, gid, nil
+}
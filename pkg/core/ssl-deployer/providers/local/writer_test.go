@@ -0,0 +1,85 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestFileWriter(t *testing.T) {
+	dir := t.TempDir()
+	existingPath := filepath.Join(dir, "cert.pem")
+	newPath := filepath.Join(dir, "key.pem")
+	backupDir := filepath.Join(dir, "backups")
+
+	if err := os.WriteFile(existingPath, []byte("old"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	writer, err := newFileWriter(&SSLDeployerProviderConfig{
+		AtomicWrite:   true,
+		BackupEnabled: true,
+		BackupDir:     backupDir,
+		FileMode:      "0600",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Write", func(t *testing.T) {
+		if err := writer.Write(existingPath, []byte("new")); err != nil {
+			t.Fatal(err)
+		}
+		if err := writer.Write(newPath, []byte("key")); err != nil {
+			t.Fatal(err)
+		}
+
+		if data, _ := os.ReadFile(existingPath); string(data) != "new" {
+			t.Errorf("unexpected content: %s", string(data))
+		}
+		if runtime.GOOS != "windows" {
+			if info, _ := os.Stat(newPath); info.Mode().Perm() != 0o600 {
+				t.Errorf("unexpected file mode: %o", info.Mode().Perm())
+			}
+		}
+
+		backups := writer.BackupPaths()
+		if len(backups) != 1 {
+			t.Fatalf("expected 1 backup, got %d", len(backups))
+		}
+		if filepath.Dir(backups[0]) != backupDir {
+			t.Errorf("unexpected backup path: %s", backups[0])
+		}
+		if data, _ := os.ReadFile(backups[0]); string(data) != "old" {
+			t.Errorf("unexpected backup content: %s", string(data))
+		}
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		if err := writer.Restore(); err != nil {
+			t.Fatal(err)
+		}
+
+		if data, _ := os.ReadFile(existingPath); string(data) != "old" {
+			t.Errorf("unexpected restored content: %s", string(data))
+		}
+		if runtime.GOOS != "windows" {
+			if info, _ := os.Stat(existingPath); info.Mode().Perm() != 0o640 {
+				t.Errorf("unexpected restored file mode: %o", info.Mode().Perm())
+			}
+		}
+		if _, err := os.Stat(newPath); !os.IsNotExist(err) {
+			t.Errorf("expected new file to be removed, got %v", err)
+		}
+	})
+}
+
+func TestFileWriter_InvalidConfig(t *testing.T) {
+	if _, err := newFileWriter(&SSLDeployerProviderConfig{FileMode: "rw"}); err == nil {
+		t.Error("expected error for invalid file mode")
+	}
+	if _, err := newFileWriter(&SSLDeployerProviderConfig{FileOwner: ":"}); err == nil {
+		t.Error("expected error for invalid file owner")
+	}
+}
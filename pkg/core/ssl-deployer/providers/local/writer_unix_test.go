@@ -0,0 +1,40 @@
+//go:build !windows
+
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func TestFileWriter_PreserveOwner(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(path, []byte("old"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(path, 1234, 5678); err != nil {
+		t.Fatal(err)
+	}
+
+	writer, err := newFileWriter(&SSLDeployerProviderConfig{AtomicWrite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Write(path, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+
+	info, _ := os.Stat(path)
+	if stat := info.Sys().(*syscall.Stat_t); stat.Uid != 1234 || stat.Gid != 5678 {
+		t.Errorf("unexpected file owner: %d:%d", stat.Uid, stat.Gid)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("unexpected file mode: %o", info.Mode().Perm())
+	}
+}
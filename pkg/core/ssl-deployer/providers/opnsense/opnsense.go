@@ -0,0 +1,140 @@
+package opnsense
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	opnsensesdk "github.com/certimate-go/certimate/pkg/sdk3rd/opnsense"
+)
+
+type SSLDeployerProviderConfig struct {
+	// OPNsense 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// OPNsense API Key。
+	ApiKey string `json:"apiKey"`
+	// OPNsense API Secret。
+	ApiSecret string `json:"apiSecret"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书描述。
+	// 已存在具有该描述的证书时原地更新（引用该证书的服务无需重新配置），否则新建证书。
+	CertificateDesc string `json:"certificateDesc"`
+	// 部署后需要重启的服务名称数组。
+	// 选填。
+	RestartServices []string `json:"restartServices,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *opnsensesdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := createSDKClient(config.ServerUrl, config.ApiKey, config.ApiSecret, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if d.config.CertificateDesc == "" {
+		return nil, errors.New("config `certificateDesc` is required")
+	}
+
+	// 查找同名证书
+	searchCertificatesResp, err := d.sdkClient.SearchCertificatesWithContext(ctx, d.config.CertificateDesc)
+	d.logger.Debug("sdk request 'opnsense.SearchCertificates'", slog.String("request.searchPhrase", d.config.CertificateDesc), slog.Any("response", searchCertificatesResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'opnsense.SearchCertificates': %w", err)
+	}
+
+	var certUuid string
+	for _, record := range searchCertificatesResp.Rows {
+		if record.Descr == d.config.CertificateDesc {
+			certUuid = record.Uuid
+			break
+		}
+	}
+
+	payload := &opnsensesdk.CertificatePayload{
+		Action:     "import",
+		Descr:      d.config.CertificateDesc,
+		CrtPayload: certPEM,
+		PrvPayload: privkeyPEM,
+	}
+	if certUuid != "" {
+		// 原地更新已有证书
+		setCertificateReq := &opnsensesdk.SetCertificateRequest{Cert: payload}
+		setCertificateResp, err := d.sdkClient.SetCertificateWithContext(ctx, certUuid, setCertificateReq)
+		d.logger.Debug("sdk request 'opnsense.SetCertificate'", slog.String("request.uuid", certUuid), slog.Any("response", setCertificateResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'opnsense.SetCertificate': %w", err)
+		}
+
+		d.logger.Info("ssl certificate updated", slog.String("uuid", certUuid))
+	} else {
+		// 导入新证书
+		addCertificateReq := &opnsensesdk.AddCertificateRequest{Cert: payload}
+		addCertificateResp, err := d.sdkClient.AddCertificateWithContext(ctx, addCertificateReq)
+		d.logger.Debug("sdk request 'opnsense.AddCertificate'", slog.Any("response", addCertificateResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'opnsense.AddCertificate': %w", err)
+		}
+
+		certUuid = addCertificateResp.Uuid
+		d.logger.Info("ssl certificate imported", slog.String("uuid", certUuid))
+	}
+
+	// 重启服务
+	for _, service := range d.config.RestartServices {
+		restartServiceResp, err := d.sdkClient.RestartServiceWithContext(ctx, service)
+		d.logger.Debug("sdk request 'opnsense.RestartService'", slog.String("request.name", service), slog.Any("response", restartServiceResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'opnsense.RestartService': %w", err)
+		}
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"certUuid": certUuid,
+		},
+	}, nil
+}
+
+func createSDKClient(serverUrl, apiKey, apiSecret string, skipTlsVerify bool) (*opnsensesdk.Client, error) {
+	client, err := opnsensesdk.NewClient(serverUrl, apiKey, apiSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipTlsVerify {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,150 @@
+package opnsense_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/opnsense"
+)
+
+// 模拟 OPNsense 证书管理和服务管理接口。
+type mockOPNsense struct {
+	mu        sync.Mutex
+	certs     map[string]map[string]any
+	restarted []string
+}
+
+func (m *mockOPNsense) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if user, pass, ok := r.BasicAuth(); !ok || user != "key" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	body := make(map[string]map[string]any)
+	json.NewDecoder(r.Body).Decode(&body)
+
+	switch {
+	case r.URL.Path == "/api/trust/cert/search":
+		rows := make([]any, 0)
+		for uuid, cert := range m.certs {
+			rows = append(rows, map[string]any{"uuid": uuid, "descr": cert["descr"]})
+		}
+		writeJSON(map[string]any{"rows": rows, "total": len(rows)})
+
+	case r.URL.Path == "/api/trust/cert/add":
+		if body["cert"]["crt_payload"] == "" {
+			writeJSON(map[string]any{"result": "failed", "validations": map[string]any{"cert.crt_payload": "required"}})
+			return
+		}
+		m.certs["new-uuid"] = body["cert"]
+		writeJSON(map[string]any{"result": "saved", "uuid": "new-uuid"})
+
+	case strings.HasPrefix(r.URL.Path, "/api/trust/cert/set/"):
+		uuid := strings.TrimPrefix(r.URL.Path, "/api/trust/cert/set/")
+		if _, ok := m.certs[uuid]; !ok {
+			writeJSON(map[string]any{"result": "failed"})
+			return
+		}
+		m.certs[uuid] = body["cert"]
+		writeJSON(map[string]any{"result": "saved"})
+
+	case strings.HasPrefix(r.URL.Path, "/api/core/service/restart/"):
+		m.restarted = append(m.restarted, strings.TrimPrefix(r.URL.Path, "/api/core/service/restart/"))
+		writeJSON(map[string]any{"result": "ok"})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	t.Run("UpdateExisting", func(t *testing.T) {
+		mock := &mockOPNsense{
+			certs: map[string]map[string]any{
+				"uuid-1": {"descr": "other"},
+				"uuid-2": {"descr": "certimate"},
+			},
+		}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "key",
+			ApiSecret:       "secret",
+			CertificateDesc: "certimate",
+			RestartServices: []string{"haproxy"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), "cert", "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ExtendedData["certUuid"] != "uuid-2" {
+			t.Errorf("unexpected result: %v", res.ExtendedData)
+		}
+		if mock.certs["uuid-2"]["crt_payload"] != "cert" || mock.certs["uuid-2"]["prv_payload"] != "key" {
+			t.Errorf("expected certificate to be updated, got %v", mock.certs["uuid-2"])
+		}
+		if len(mock.certs) != 2 {
+			t.Errorf("expected no new certificate")
+		}
+		if len(mock.restarted) != 1 || mock.restarted[0] != "haproxy" {
+			t.Errorf("unexpected restarted services: %v", mock.restarted)
+		}
+	})
+
+	t.Run("ImportNew", func(t *testing.T) {
+		mock := &mockOPNsense{certs: map[string]map[string]any{}}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "key",
+			ApiSecret:       "secret",
+			CertificateDesc: "certimate",
+		})
+		res, err := deployer.Deploy(context.Background(), "cert", "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ExtendedData["certUuid"] != "new-uuid" || mock.certs["new-uuid"]["action"] != "import" {
+			t.Errorf("unexpected result: %v, %v", res.ExtendedData, mock.certs)
+		}
+	})
+
+	t.Run("ValidationFailed", func(t *testing.T) {
+		mock := &mockOPNsense{certs: map[string]map[string]any{}}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "key",
+			ApiSecret:       "secret",
+			CertificateDesc: "certimate",
+		})
+		if _, err := deployer.Deploy(context.Background(), "", "key"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
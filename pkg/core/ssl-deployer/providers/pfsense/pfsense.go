@@ -0,0 +1,144 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	pfsensesdk "github.com/certimate-go/certimate/pkg/sdk3rd/pfsense"
+)
+
+type SSLDeployerProviderConfig struct {
+	// pfSense 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// pfSense REST API Key。
+	ApiKey string `json:"apiKey"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书描述。
+	// 已存在具有该描述的证书时原地更新（引用该证书的服务无需重新配置），否则新建证书。
+	CertificateDesc string `json:"certificateDesc"`
+	// 部署后需要重启的服务名称数组。
+	// 选填。
+	RestartServices []string `json:"restartServices,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *pfsensesdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := createSDKClient(config.ServerUrl, config.ApiKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if d.config.CertificateDesc == "" {
+		return nil, errors.New("config `certificateDesc` is required")
+	}
+
+	// 查找同名证书
+	listCertificatesResp, err := d.sdkClient.ListCertificatesWithContext(ctx, d.config.CertificateDesc)
+	d.logger.Debug("sdk request 'pfsense.ListCertificates'", slog.String("request.descr", d.config.CertificateDesc), slog.Any("response", listCertificatesResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'pfsense.ListCertificates': %w", err)
+	}
+
+	var targetCert *pfsensesdk.CertificateInfo
+	for _, certInfo := range listCertificatesResp.Data {
+		if certInfo.Descr == d.config.CertificateDesc {
+			targetCert = certInfo
+			break
+		}
+	}
+
+	var certRefId string
+	if targetCert != nil {
+		// 原地更新已有证书
+		updateCertificateReq := &pfsensesdk.UpdateCertificateRequest{
+			Id:  targetCert.Id,
+			Crt: certPEM,
+			Prv: privkeyPEM,
+		}
+		updateCertificateResp, err := d.sdkClient.UpdateCertificateWithContext(ctx, updateCertificateReq)
+		d.logger.Debug("sdk request 'pfsense.UpdateCertificate'", slog.Int64("request.id", targetCert.Id), slog.Any("response", updateCertificateResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'pfsense.UpdateCertificate': %w", err)
+		}
+
+		certRefId = targetCert.RefId
+		d.logger.Info("ssl certificate updated", slog.String("refid", certRefId))
+	} else {
+		// 导入新证书
+		createCertificateReq := &pfsensesdk.CreateCertificateRequest{
+			Descr: d.config.CertificateDesc,
+			Crt:   certPEM,
+			Prv:   privkeyPEM,
+		}
+		createCertificateResp, err := d.sdkClient.CreateCertificateWithContext(ctx, createCertificateReq)
+		d.logger.Debug("sdk request 'pfsense.CreateCertificate'", slog.String("request.descr", d.config.CertificateDesc), slog.Any("response", createCertificateResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'pfsense.CreateCertificate': %w", err)
+		}
+
+		if createCertificateResp.Data != nil {
+			certRefId = createCertificateResp.Data.RefId
+		}
+		d.logger.Info("ssl certificate imported", slog.String("refid", certRefId))
+	}
+
+	// 重启服务
+	for _, service := range d.config.RestartServices {
+		restartServiceResp, err := d.sdkClient.RestartServiceWithContext(ctx, service)
+		d.logger.Debug("sdk request 'pfsense.RestartService'", slog.String("request.name", service), slog.Any("response", restartServiceResp))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'pfsense.RestartService': %w", err)
+		}
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"certRefId": certRefId,
+		},
+	}, nil
+}
+
+func createSDKClient(serverUrl, apiKey string, skipTlsVerify bool) (*pfsensesdk.Client, error) {
+	client, err := pfsensesdk.NewClient(serverUrl, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipTlsVerify {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
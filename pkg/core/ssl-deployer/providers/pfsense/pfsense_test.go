@@ -0,0 +1,150 @@
+package pfsense_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/pfsense"
+)
+
+// 模拟 pfSense REST API 中与证书管理和服务管理相关的接口。
+type mockPfSense struct {
+	mu        sync.Mutex
+	certs     []map[string]any
+	restarted []string
+}
+
+func (m *mockPfSense) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	writeJSON := func(code int, data any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(map[string]any{"code": code, "status": http.StatusText(code), "response_id": "", "message": "", "data": data})
+	}
+
+	if r.Header.Get("X-API-Key") != "api-key" {
+		writeJSON(http.StatusUnauthorized, nil)
+		return
+	}
+
+	body := make(map[string]any)
+	json.NewDecoder(r.Body).Decode(&body)
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /api/v2/system/certificates":
+		result := make([]any, 0)
+		for _, cert := range m.certs {
+			if descr := r.URL.Query().Get("descr"); descr == "" || cert["descr"] == descr {
+				result = append(result, cert)
+			}
+		}
+		writeJSON(http.StatusOK, result)
+
+	case "POST /api/v2/system/certificate":
+		cert := map[string]any{"id": len(m.certs), "refid": "new-refid", "descr": body["descr"], "crt": body["crt"], "prv": body["prv"]}
+		m.certs = append(m.certs, cert)
+		writeJSON(http.StatusOK, cert)
+
+	case "PATCH /api/v2/system/certificate":
+		id := int(body["id"].(float64))
+		if id >= len(m.certs) {
+			writeJSON(http.StatusNotFound, nil)
+			return
+		}
+		m.certs[id]["crt"] = body["crt"]
+		m.certs[id]["prv"] = body["prv"]
+		writeJSON(http.StatusOK, m.certs[id])
+
+	case "POST /api/v2/status/service":
+		if body["action"] != "restart" {
+			writeJSON(http.StatusBadRequest, nil)
+			return
+		}
+		m.restarted = append(m.restarted, body["name"].(string))
+		writeJSON(http.StatusOK, nil)
+
+	default:
+		writeJSON(http.StatusNotFound, nil)
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	t.Run("UpdateExisting", func(t *testing.T) {
+		mock := &mockPfSense{
+			certs: []map[string]any{
+				{"id": 0, "refid": "refid-0", "descr": "webConfigurator default"},
+				{"id": 1, "refid": "refid-1", "descr": "certimate"},
+			},
+		}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "api-key",
+			CertificateDesc: "certimate",
+			RestartServices: []string{"haproxy", "openvpn"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), "cert", "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ExtendedData["certRefId"] != "refid-1" {
+			t.Errorf("unexpected result: %v", res.ExtendedData)
+		}
+		if mock.certs[1]["crt"] != "cert" || mock.certs[1]["prv"] != "key" {
+			t.Errorf("expected certificate to be updated, got %v", mock.certs[1])
+		}
+		if len(mock.certs) != 2 {
+			t.Errorf("expected no new certificate")
+		}
+		if len(mock.restarted) != 2 {
+			t.Errorf("unexpected restarted services: %v", mock.restarted)
+		}
+	})
+
+	t.Run("ImportNew", func(t *testing.T) {
+		mock := &mockPfSense{certs: []map[string]any{}}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "api-key",
+			CertificateDesc: "certimate",
+		})
+		res, err := deployer.Deploy(context.Background(), "cert", "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ExtendedData["certRefId"] != "new-refid" || len(mock.certs) != 1 {
+			t.Errorf("unexpected result: %v, %v", res.ExtendedData, mock.certs)
+		}
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		server := httptest.NewServer(&mockPfSense{})
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			ApiKey:          "wrong",
+			CertificateDesc: "certimate",
+		})
+		if _, err := deployer.Deploy(context.Background(), "cert", "key"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -1,9 +1,13 @@
 package ssh
 
-type OutputFormatType string
+import (
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+type OutputFormatType = xcert.OutputFormatType
 
 const (
-	OUTPUT_FORMAT_PEM = OutputFormatType("PEM")
-	OUTPUT_FORMAT_PFX = OutputFormatType("PFX")
-	OUTPUT_FORMAT_JKS = OutputFormatType("JKS")
+	OUTPUT_FORMAT_PEM = xcert.OUTPUT_FORMAT_PEM
+	OUTPUT_FORMAT_PFX = xcert.OUTPUT_FORMAT_PFX
+	OUTPUT_FORMAT_JKS = xcert.OUTPUT_FORMAT_JKS
 )
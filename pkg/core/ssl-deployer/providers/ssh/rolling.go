@@ -0,0 +1,94 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type hostStatus string
+
+const (
+	hostStatusSucceeded = hostStatus("succeeded")
+	hostStatusFailed    = hostStatus("failed")
+	hostStatusSkipped   = hostStatus("skipped")
+)
+
+type hostResult struct {
+	Host   string     `json:"host"`
+	Batch  int        `json:"batch"`
+	Status hostStatus `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+type rollingStrategy struct {
+	// 每批主机数量，零值时全部主机作为一批。
+	BatchSize int
+	// 批次间隔。
+	Pause time.Duration
+	// 允许的最大失败数量，超过时中止后续批次。
+	MaxFailures int
+}
+
+// 按批次滚动执行部署。同一批次内的主机并发执行，批次之间串行执行。
+// 失败数量超过阈值时，后续批次的主机将被标记为跳过，并返回错误。
+func runRolling(ctx context.Context, hosts []HostConfig, strategy rollingStrategy, deployFn func(ctx context.Context, host HostConfig) error, logger *slog.Logger) ([]*hostResult, error) {
+	batchSize := strategy.BatchSize
+	if batchSize <= 0 || batchSize > len(hosts) {
+		batchSize = len(hosts)
+	}
+
+	results := make([]*hostResult, len(hosts))
+	for i, host := range hosts {
+		results[i] = &hostResult{Host: host.Name, Batch: i/batchSize + 1, Status: hostStatusSkipped}
+	}
+
+	failures := 0
+	for start := 0; start < len(hosts); start += batchSize {
+		end := min(start+batchSize, len(hosts))
+		batch := start/batchSize + 1
+
+		if start > 0 && strategy.Pause > 0 {
+			logger.Info(fmt.Sprintf("waiting %s before batch #%d", strategy.Pause, batch))
+
+			select {
+			case <-ctx.Done():
+				return results, ctx.Err()
+			case <-time.After(strategy.Pause):
+			}
+		}
+
+		logger.Info(fmt.Sprintf("deploying batch #%d", batch), slog.Int("hosts", end-start))
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				if err := deployFn(ctx, hosts[i]); err != nil {
+					results[i].Status = hostStatusFailed
+					results[i].Error = err.Error()
+					logger.Warn("failed to deploy to host", slog.String("host", hosts[i].Name), slog.Any("error", err))
+				} else {
+					results[i].Status = hostStatusSucceeded
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for i := start; i < end; i++ {
+			if results[i].Status == hostStatusFailed {
+				failures++
+			}
+		}
+
+		if failures > strategy.MaxFailures {
+			return results, fmt.Errorf("rolling deployment aborted after batch #%d: %d host(s) failed, exceeding the limit of %d", batch, failures, strategy.MaxFailures)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,69 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+func Test_runRolling(t *testing.T) {
+	hosts := []HostConfig{{Name: "h1"}, {Name: "h2"}, {Name: "h3"}, {Name: "h4"}, {Name: "h5"}}
+	logger := slog.New(slog.DiscardHandler)
+
+	t.Run("AllSucceeded", func(t *testing.T) {
+		var mtx sync.Mutex
+		visited := make([]string, 0)
+		results, err := runRolling(context.Background(), hosts, rollingStrategy{BatchSize: 2}, func(ctx context.Context, host HostConfig) error {
+			mtx.Lock()
+			visited = append(visited, host.Name)
+			mtx.Unlock()
+			return nil
+		}, logger)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if len(visited) != 5 {
+			t.Errorf("expected 5 hosts deployed, got %d", len(visited))
+		}
+		if results[4].Batch != 3 || results[4].Status != hostStatusSucceeded {
+			t.Errorf("unexpected result: %+v", results[4])
+		}
+	})
+
+	t.Run("AbortOnFailures", func(t *testing.T) {
+		results, err := runRolling(context.Background(), hosts, rollingStrategy{BatchSize: 2, MaxFailures: 1}, func(ctx context.Context, host HostConfig) error {
+			if host.Name == "h1" || host.Name == "h3" {
+				return errors.New("boom")
+			}
+			return nil
+		}, logger)
+		if err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+
+		want := []hostStatus{hostStatusFailed, hostStatusSucceeded, hostStatusFailed, hostStatusSucceeded, hostStatusSkipped}
+		for i, result := range results {
+			if result.Status != want[i] {
+				t.Errorf("host %s: expected status %s, got %s", result.Host, want[i], result.Status)
+			}
+		}
+	})
+
+	t.Run("ToleratedFailures", func(t *testing.T) {
+		results, err := runRolling(context.Background(), hosts, rollingStrategy{MaxFailures: 1}, func(ctx context.Context, host HostConfig) error {
+			if host.Name == "h2" {
+				return errors.New("boom")
+			}
+			return nil
+		}, logger)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+		if results[1].Status != hostStatusFailed || results[1].Error != "boom" || results[1].Batch != 1 {
+			t.Errorf("unexpected result: %+v", results[1])
+		}
+	})
+}
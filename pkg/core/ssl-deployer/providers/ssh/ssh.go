@@ -1,45 +1,54 @@
 package ssh
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
-	"net"
-	"os"
-	"path/filepath"
-	"strconv"
-	"strings"
-
-	"github.com/pkg/sftp"
-	"github.com/povsister/scp"
+	"slices"
+	"time"
+
 	"golang.org/x/crypto/ssh"
 
 	"github.com/certimate-go/certimate/pkg/core"
 	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
 )
 
-type JumpServerConfig struct {
+type JumpServerConfig = xssh.JumpServerConfig
+
+type HostConfig struct {
+	// 主机名称，用于在部署结果中标识主机。
+	// 零值时默认值为 SSH 主机地址。
+	Name string `json:"name,omitempty"`
+	// 主机所属分组数组。
+	Groups []string `json:"groups,omitempty"`
 	// SSH 主机。
-	// 零值时默认值 "localhost"。
-	SshHost string `json:"sshHost,omitempty"`
+	SshHost string `json:"sshHost"`
 	// SSH 端口。
-	// 零值时默认值 22。
+	// 零值时继承顶层配置。
 	SshPort int32 `json:"sshPort,omitempty"`
 	// SSH 认证方式。
-	// 可取值 "none"、"password"、"key"。
-	// 零值时根据有无密码或私钥字段决定。
+	// 零值时继承顶层配置。
 	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
 	// SSH 登录用户名。
-	// 零值时默认值 "root"。
+	// 零值时继承顶层配置。
 	SshUsername string `json:"sshUsername,omitempty"`
 	// SSH 登录密码。
+	// 零值时继承顶层配置。
 	SshPassword string `json:"sshPassword,omitempty"`
 	// SSH 登录私钥。
+	// 零值时继承顶层配置。
 	SshKey string `json:"sshKey,omitempty"`
 	// SSH 登录私钥口令。
+	// 零值时继承顶层配置。
 	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 前置命令。
+	// 零值时继承顶层配置。
+	PreCommand string `json:"preCommand,omitempty"`
+	// 后置命令。
+	// 零值时继承顶层配置。
+	PostCommand string `json:"postCommand,omitempty"`
 }
 
 type SSLDeployerProviderConfig struct {
@@ -64,6 +73,23 @@ type SSLDeployerProviderConfig struct {
 	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
 	// 跳板机配置数组。
 	JumpServers []JumpServerConfig `json:"jumpServers,omitempty"`
+	// 主机清单。
+	// 非空时部署到清单中的主机，顶层的 SSH 连接配置作为各主机的默认值；否则仅部署到顶层配置的主机。
+	Hosts []HostConfig `json:"hosts,omitempty"`
+	// 主机分组。
+	// 非空时仅部署到主机清单中属于该分组的主机。
+	HostGroup string `json:"hostGroup,omitempty"`
+	// 滚动部署的每批主机数量。
+	// 零值时所有主机作为一批同时部署。
+	RollingBatchSize int32 `json:"rollingBatchSize,omitempty"`
+	// 滚动部署的批次间隔秒数。
+	RollingPauseSeconds int32 `json:"rollingPauseSeconds,omitempty"`
+	// 滚动部署允许的最大失败主机数量。
+	// 失败数量超过该值时中止后续批次。
+	RollingMaxFailures int32 `json:"rollingMaxFailures,omitempty"`
+	// 健康检查命令。
+	// 在每台主机执行后置命令后执行，失败时视为该主机部署失败。
+	HealthCheckCommand string `json:"healthCheckCommand,omitempty"`
 	// 是否回退使用 SCP。
 	UseSCP bool `json:"useSCP,omitempty"`
 	// 前置命令。
@@ -94,6 +120,25 @@ type SSLDeployerProviderConfig struct {
 	// JKS 存储密码。
 	// 证书格式为 JKS 时必填。
 	JksStorepass string `json:"jksStorepass,omitempty"`
+	// 是否以原子方式写入文件（先上传临时文件，再重命名覆盖目标文件）。
+	// 不支持与 SCP 同时使用。
+	AtomicWrite bool `json:"atomicWrite,omitempty"`
+	// 是否在覆盖前备份原有文件。
+	// 启用后，后置命令执行失败时将自动还原原有文件。
+	// 不支持与 SCP 同时使用。
+	BackupEnabled bool `json:"backupEnabled,omitempty"`
+	// 备份文件目录。
+	// 零值时备份到原有文件所在目录。
+	BackupDir string `json:"backupDir,omitempty"`
+	// 输出文件权限，八进制字符串，例如 "0600"。
+	// 零值时不修改。
+	FileMode string `json:"fileMode,omitempty"`
+	// 输出文件所有者，格式为 "user:group"，可使用用户名或数字 ID。
+	// 零值时不修改。
+	FileOwner string `json:"fileOwner,omitempty"`
+	// 回滚命令。
+	// 后置命令执行失败时，在还原文件后执行。
+	RollbackCommand string `json:"rollbackCommand,omitempty"`
 }
 
 type SSLDeployerProvider struct {
@@ -123,316 +168,236 @@ func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
 }
 
 func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
-	// 提取服务器证书和中间证书
-	serverCertPEM, intermediaCertPEM, err := xcert.ExtractCertificatesFromPEM(certPEM)
+	hosts, err := d.resolveHosts()
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract certs: %w", err)
+		return nil, err
 	}
 
-	var targetConn net.Conn
-
-	// 连接到跳板机
-	if len(d.config.JumpServers) > 0 {
-		var jumpClient *ssh.Client
-		for i, jumpServerConf := range d.config.JumpServers {
-			d.logger.Info(fmt.Sprintf("connecting to jump server [%d]", i+1), slog.String("host", jumpServerConf.SshHost))
+	// 单主机时保持原有行为
+	if len(d.config.Hosts) == 0 {
+		if err := d.deployToHost(ctx, hosts[0], certPEM, privkeyPEM); err != nil {
+			return nil, err
+		}
 
-			var jumpConn net.Conn
-			// 第一个连接是主机发起，后续通过跳板机发起
-			if jumpClient == nil {
-				jumpConn, err = net.Dial("tcp", net.JoinHostPort(jumpServerConf.SshHost, strconv.Itoa(int(jumpServerConf.SshPort))))
-			} else {
-				jumpConn, err = jumpClient.DialContext(ctx, "tcp", net.JoinHostPort(jumpServerConf.SshHost, strconv.Itoa(int(jumpServerConf.SshPort))))
-			}
-			if err != nil {
-				return nil, fmt.Errorf("failed to connect to jump server [%d]: %w", i+1, err)
-			}
-			defer jumpConn.Close()
-
-			newClient, err := createSshClient(
-				jumpConn,
-				jumpServerConf.SshHost,
-				jumpServerConf.SshPort,
-				jumpServerConf.SshAuthMethod,
-				jumpServerConf.SshUsername,
-				jumpServerConf.SshPassword,
-				jumpServerConf.SshKey,
-				jumpServerConf.SshKeyPassphrase,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create jump server ssh client[%d]: %w", i+1, err)
-			}
-			defer newClient.Close()
+		return &core.SSLDeployResult{}, nil
+	}
 
-			jumpClient = newClient
-			d.logger.Info(fmt.Sprintf("jump server connected [%d]", i+1), slog.String("host", jumpServerConf.SshHost))
+	// 多主机时按批次滚动部署
+	results, err := runRolling(ctx, hosts, rollingStrategy{
+		BatchSize:   int(d.config.RollingBatchSize),
+		Pause:       time.Duration(d.config.RollingPauseSeconds) * time.Second,
+		MaxFailures: int(d.config.RollingMaxFailures),
+	}, func(ctx context.Context, host HostConfig) error {
+		return d.deployToHost(ctx, host, certPEM, privkeyPEM)
+	}, d.logger)
+
+	succeeded, failed := 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case hostStatusSucceeded:
+			succeeded++
+		case hostStatusFailed:
+			failed++
 		}
+	}
 
-		// 通过跳板机发起 TCP 连接到目标服务器
-		targetConn, err = jumpClient.DialContext(ctx, "tcp", net.JoinHostPort(d.config.SshHost, strconv.Itoa(int(d.config.SshPort))))
-		if err != nil {
-			return nil, fmt.Errorf("failed to connect to target server: %w", err)
-		}
-	} else {
-		// 直接发起 TCP 连接到目标服务器
-		targetConn, err = net.Dial("tcp", net.JoinHostPort(d.config.SshHost, strconv.Itoa(int(d.config.SshPort))))
-		if err != nil {
-			return nil, fmt.Errorf("failed to connect to target server: %w", err)
-		}
+	deployResult := &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"hosts":     results,
+			"succeeded": succeeded,
+			"failed":    failed,
+		},
 	}
-	defer targetConn.Close()
-
-	// 通过已有的连接创建目标服务器 SSH 客户端
-	client, err := createSshClient(
-		targetConn,
-		d.config.SshHost,
-		d.config.SshPort,
-		d.config.SshAuthMethod,
-		d.config.SshUsername,
-		d.config.SshPassword,
-		d.config.SshKey,
-		d.config.SshKeyPassphrase,
-	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create ssh client: %w", err)
+		return deployResult, err
+	} else if succeeded == 0 {
+		return deployResult, errors.New("failed to deploy to any host")
 	}
-	defer client.Close()
 
-	d.logger.Info("ssh connected")
+	return deployResult, nil
+}
 
-	// 执行前置命令
-	if d.config.PreCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PreCommand)
-		d.logger.Debug("run pre-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
-		if err != nil {
-			return nil, fmt.Errorf("failed to execute pre-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
-		}
+func (d *SSLDeployerProvider) resolveHosts() ([]HostConfig, error) {
+	if len(d.config.Hosts) == 0 {
+		return []HostConfig{{
+			SshHost:          d.config.SshHost,
+			SshPort:          d.config.SshPort,
+			SshAuthMethod:    d.config.SshAuthMethod,
+			SshUsername:      d.config.SshUsername,
+			SshPassword:      d.config.SshPassword,
+			SshKey:           d.config.SshKey,
+			SshKeyPassphrase: d.config.SshKeyPassphrase,
+			PreCommand:       d.config.PreCommand,
+			PostCommand:      d.config.PostCommand,
+		}}, nil
 	}
 
-	// 上传证书和私钥文件
-	switch d.config.OutputFormat {
-	case OUTPUT_FORMAT_PEM:
-		if err := writeFileString(client, d.config.UseSCP, d.config.OutputCertPath, certPEM); err != nil {
-			return nil, fmt.Errorf("failed to upload certificate file: %w", err)
+	hosts := make([]HostConfig, 0, len(d.config.Hosts))
+	for _, host := range d.config.Hosts {
+		if d.config.HostGroup != "" && !slices.Contains(host.Groups, d.config.HostGroup) {
+			continue
 		}
-		d.logger.Info("ssl certificate file uploaded", slog.String("path", d.config.OutputCertPath))
 
-		if d.config.OutputServerCertPath != "" {
-			if err := writeFileString(client, d.config.UseSCP, d.config.OutputServerCertPath, serverCertPEM); err != nil {
-				return nil, fmt.Errorf("failed to save server certificate file: %w", err)
-			}
-			d.logger.Info("ssl server certificate file uploaded", slog.String("path", d.config.OutputServerCertPath))
+		// 未设置的字段继承顶层配置
+		if host.SshHost == "" {
+			return nil, errors.New("config `hosts[].sshHost` is required")
 		}
-
-		if d.config.OutputIntermediaCertPath != "" {
-			if err := writeFileString(client, d.config.UseSCP, d.config.OutputIntermediaCertPath, intermediaCertPEM); err != nil {
-				return nil, fmt.Errorf("failed to save intermedia certificate file: %w", err)
-			}
-			d.logger.Info("ssl intermedia certificate file uploaded", slog.String("path", d.config.OutputIntermediaCertPath))
+		if host.Name == "" {
+			host.Name = host.SshHost
 		}
-
-		if err := writeFileString(client, d.config.UseSCP, d.config.OutputKeyPath, privkeyPEM); err != nil {
-			return nil, fmt.Errorf("failed to upload private key file: %w", err)
+		if host.SshPort == 0 {
+			host.SshPort = d.config.SshPort
 		}
-		d.logger.Info("ssl private key file uploaded", slog.String("path", d.config.OutputKeyPath))
-
-	case OUTPUT_FORMAT_PFX:
-		pfxData, err := xcert.TransformCertificateFromPEMToPFX(certPEM, privkeyPEM, d.config.PfxPassword)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transform certificate to PFX: %w", err)
+		if host.SshAuthMethod == "" {
+			host.SshAuthMethod = d.config.SshAuthMethod
 		}
-		d.logger.Info("ssl certificate transformed to pfx")
-
-		if err := writeFile(client, d.config.UseSCP, d.config.OutputCertPath, pfxData); err != nil {
-			return nil, fmt.Errorf("failed to upload certificate file: %w", err)
+		if host.SshUsername == "" {
+			host.SshUsername = d.config.SshUsername
 		}
-		d.logger.Info("ssl certificate file uploaded", slog.String("path", d.config.OutputCertPath))
-
-	case OUTPUT_FORMAT_JKS:
-		jksData, err := xcert.TransformCertificateFromPEMToJKS(certPEM, privkeyPEM, d.config.JksAlias, d.config.JksKeypass, d.config.JksStorepass)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transform certificate to JKS: %w", err)
+		if host.SshPassword == "" {
+			host.SshPassword = d.config.SshPassword
 		}
-		d.logger.Info("ssl certificate transformed to jks")
-
-		if err := writeFile(client, d.config.UseSCP, d.config.OutputCertPath, jksData); err != nil {
-			return nil, fmt.Errorf("failed to upload certificate file: %w", err)
+		if host.SshKey == "" {
+			host.SshKey = d.config.SshKey
+			if host.SshKeyPassphrase == "" {
+				host.SshKeyPassphrase = d.config.SshKeyPassphrase
+			}
+		}
+		if host.PreCommand == "" {
+			host.PreCommand = d.config.PreCommand
+		}
+		if host.PostCommand == "" {
+			host.PostCommand = d.config.PostCommand
 		}
-		d.logger.Info("ssl certificate file uploaded", slog.String("path", d.config.OutputCertPath))
 
-	default:
-		return nil, fmt.Errorf("unsupported output format '%s'", d.config.OutputFormat)
+		hosts = append(hosts, host)
 	}
 
-	// 执行后置命令
-	if d.config.PostCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PostCommand)
-		d.logger.Debug("run post-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
-		if err != nil {
-			return nil, fmt.Errorf("failed to execute post-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
+	if len(hosts) == 0 {
+		if d.config.HostGroup != "" {
+			return nil, fmt.Errorf("could not find any hosts in group '%s'", d.config.HostGroup)
 		}
+		return nil, errors.New("could not find any hosts")
 	}
 
-	return &core.SSLDeployResult{}, nil
+	return hosts, nil
 }
 
-func createSshClient(conn net.Conn, host string, port int32, authMethod string, username, password, key, keyPassphrase string) (*ssh.Client, error) {
-	if host == "" {
-		host = "localhost"
+func (d *SSLDeployerProvider) deployToHost(ctx context.Context, host HostConfig, certPEM string, privkeyPEM string) error {
+	logger := d.logger
+	if host.Name != "" {
+		logger = logger.With(slog.String("host", host.Name))
 	}
 
-	if port == 0 {
-		port = 22
+	// 连接到目标服务器（可能经由跳板机）
+	client, err := xssh.DialWithJumpServers(ctx, xssh.ServerConfig{
+		Host:          host.SshHost,
+		Port:          host.SshPort,
+		AuthMethod:    host.SshAuthMethod,
+		Username:      host.SshUsername,
+		Password:      host.SshPassword,
+		Key:           host.SshKey,
+		KeyPassphrase: host.SshKeyPassphrase,
+	}, d.config.JumpServers)
+	if err != nil {
+		return err
 	}
+	defer client.Close()
 
-	if username == "" {
-		username = "root"
-	}
+	logger.Info("ssh connected")
 
-	const AUTH_METHOD_NONE = "none"
-	const AUTH_METHOD_PASSWORD = "password"
-	const AUTH_METHOD_KEY = "key"
-	if authMethod == "" {
-		if key != "" {
-			authMethod = AUTH_METHOD_KEY
-		} else if password != "" {
-			authMethod = AUTH_METHOD_PASSWORD
-		} else {
-			authMethod = AUTH_METHOD_NONE
-		}
+	writer, err := newRemoteFileWriter(client.Client, d.config)
+	if err != nil {
+		return err
 	}
 
-	authentications := make([]ssh.AuthMethod, 0)
-	switch authMethod {
-	case AUTH_METHOD_NONE:
-		{
-		}
-
-	case AUTH_METHOD_PASSWORD:
-		{
-			authentications = append(authentications, ssh.Password(password))
-			authentications = append(authentications, ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
-				if len(questions) == 1 {
-					return []string{password}, nil
-				}
-				return nil, fmt.Errorf("unexpected keyboard interactive question [%s]", strings.Join(questions, ", "))
-			}))
+	// 执行前置命令
+	if host.PreCommand != "" {
+		stdout, stderr, err := xssh.ExecCommand(client.Client, host.PreCommand)
+		logger.Debug("run pre-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			return fmt.Errorf("failed to execute pre-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
 		}
+	}
 
-	case AUTH_METHOD_KEY:
-		{
-			var signer ssh.Signer
-			var err error
-
-			if keyPassphrase != "" {
-				signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(keyPassphrase))
+	// 上传证书和私钥文件
+	if err := d.uploadFiles(writer, logger, certPEM, privkeyPEM); err != nil {
+		if d.config.BackupEnabled {
+			if rerr := writer.Restore(); rerr != nil {
+				logger.Warn("failed to restore files", slog.Any("error", rerr))
 			} else {
-				signer, err = ssh.ParsePrivateKey([]byte(key))
+				logger.Info("files restored")
 			}
-
-			if err != nil {
-				return nil, err
-			}
-
-			authentications = append(authentications, ssh.PublicKeys(signer))
 		}
-
-	default:
-		return nil, fmt.Errorf("unsupported auth method '%s'", authMethod)
-	}
-
-	addr := net.JoinHostPort(host, strconv.Itoa(int(port)))
-	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
-		User:            username,
-		Auth:            authentications,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-	})
-	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return ssh.NewClient(sshConn, chans, reqs), nil
-}
-
-func execSshCommand(sshCli *ssh.Client, command string) (string, string, error) {
-	session, err := sshCli.NewSession()
-	if err != nil {
-		return "", "", err
-	}
-	defer session.Close()
-
-	stdoutBuf := bytes.NewBuffer(nil)
-	session.Stdout = stdoutBuf
-	stderrBuf := bytes.NewBuffer(nil)
-	session.Stderr = stderrBuf
-	err = session.Run(command)
-	if err != nil {
-		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("failed to execute ssh command: %w", err)
-	}
-
-	return stdoutBuf.String(), stderrBuf.String(), nil
-}
-
-func writeFileString(sshCli *ssh.Client, useSCP bool, path string, content string) error {
-	if useSCP {
-		return writeFileStringWithSCP(sshCli, path, content)
+	// 执行后置命令
+	if host.PostCommand != "" {
+		stdout, stderr, err := xssh.ExecCommand(client.Client, host.PostCommand)
+		logger.Debug("run post-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			err = fmt.Errorf("failed to execute post-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
+			return errors.Join(err, d.rollback(client.Client, writer, logger))
+		}
 	}
 
-	return writeFileStringWithSFTP(sshCli, path, content)
-}
-
-func writeFile(sshCli *ssh.Client, useSCP bool, path string, data []byte) error {
-	if useSCP {
-		return writeFileWithSCP(sshCli, path, data)
+	// 执行健康检查命令
+	if d.config.HealthCheckCommand != "" {
+		stdout, stderr, err := xssh.ExecCommand(client.Client, d.config.HealthCheckCommand)
+		logger.Debug("run health-check command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			return fmt.Errorf("failed to pass health check (stdout: %s, stderr: %s): %w ", stdout, stderr, err)
+		}
 	}
 
-	return writeFileWithSFTP(sshCli, path, data)
-}
-
-func writeFileStringWithSCP(sshCli *ssh.Client, path string, content string) error {
-	return writeFileWithSCP(sshCli, path, []byte(content))
+	return nil
 }
 
-func writeFileWithSCP(sshCli *ssh.Client, path string, data []byte) error {
-	scpCli, err := scp.NewClientFromExistingSSH(sshCli, &scp.ClientOption{})
+func (d *SSLDeployerProvider) uploadFiles(writer *remoteFileWriter, logger *slog.Logger, certPEM, privkeyPEM string) error {
+	files, err := xcert.BuildOutputFiles(certPEM, privkeyPEM, xcert.OutputOptions{
+		Format:             d.config.OutputFormat,
+		CertPath:           d.config.OutputCertPath,
+		ServerCertPath:     d.config.OutputServerCertPath,
+		IntermediaCertPath: d.config.OutputIntermediaCertPath,
+		KeyPath:            d.config.OutputKeyPath,
+		PfxPassword:        d.config.PfxPassword,
+		JksAlias:           d.config.JksAlias,
+		JksKeypass:         d.config.JksKeypass,
+		JksStorepass:       d.config.JksStorepass,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create scp client: %w", err)
+		return err
 	}
 
-	reader := bytes.NewReader(data)
-	err = scpCli.CopyToRemote(reader, path, &scp.FileTransferOption{})
-	if err != nil {
-		return fmt.Errorf("failed to write to remote file: %w", err)
+	for _, file := range files {
+		if err := writer.Write(file.Path, file.Data); err != nil {
+			return fmt.Errorf("failed to upload %s file: %w", file.Name, err)
+		}
+		logger.Info(fmt.Sprintf("%s file uploaded", file.Name), slog.String("path", file.Path))
 	}
 
 	return nil
 }
 
-func writeFileStringWithSFTP(sshCli *ssh.Client, path string, content string) error {
-	return writeFileWithSFTP(sshCli, path, []byte(content))
-}
-
-func writeFileWithSFTP(sshCli *ssh.Client, path string, data []byte) error {
-	sftpCli, err := sftp.NewClient(sshCli)
-	if err != nil {
-		return fmt.Errorf("failed to create sftp client: %w", err)
-	}
-	defer sftpCli.Close()
+func (d *SSLDeployerProvider) rollback(client *ssh.Client, writer *remoteFileWriter, logger *slog.Logger) error {
+	var errs []error
 
-	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
-		return fmt.Errorf("failed to create remote directory: %w", err)
-	}
-
-	file, err := sftpCli.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
-	if err != nil {
-		return fmt.Errorf("failed to open remote file: %w", err)
+	if d.config.BackupEnabled {
+		if err := writer.Restore(); err != nil {
+			errs = append(errs, err)
+		} else {
+			logger.Info("files restored")
+		}
 	}
-	defer file.Close()
 
-	_, err = file.Write(data)
-	if err != nil {
-		return fmt.Errorf("failed to write to remote file: %w", err)
+	if d.config.RollbackCommand != "" {
+		stdout, stderr, err := xssh.ExecCommand(client, d.config.RollbackCommand)
+		logger.Debug("run rollback-command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to execute rollback-command (stdout: %s, stderr: %s): %w ", stdout, stderr, err))
+		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
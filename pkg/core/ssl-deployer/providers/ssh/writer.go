@@ -0,0 +1,162 @@
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+type writtenFile struct {
+	Path       string
+	Existed    bool
+	BackupPath string
+}
+
+// remoteFileWriter 负责上传输出文件，并记录被覆盖的原有文件以便在部署失败时还原。
+type remoteFileWriter struct {
+	client    *ssh.Client
+	useSCP    bool
+	atomic    bool
+	backup    bool
+	backupDir string
+	mode      os.FileMode
+	owner     string
+	timestamp string
+
+	written []writtenFile
+}
+
+func newRemoteFileWriter(client *ssh.Client, config *SSLDeployerProviderConfig) (*remoteFileWriter, error) {
+	if config.UseSCP && (config.AtomicWrite || config.BackupEnabled) {
+		return nil, errors.New("atomic write and backup are not supported when using SCP")
+	}
+
+	w := &remoteFileWriter{
+		client:    client,
+		useSCP:    config.UseSCP,
+		atomic:    config.AtomicWrite,
+		backup:    config.BackupEnabled,
+		backupDir: config.BackupDir,
+		owner:     config.FileOwner,
+		timestamp: time.Now().Format("20060102150405"),
+		written:   make([]writtenFile, 0),
+	}
+
+	if config.FileMode != "" {
+		mode, err := strconv.ParseUint(config.FileMode, 8, 32)
+		if err != nil || mode > 0o777 {
+			return nil, fmt.Errorf("invalid file mode '%s'", config.FileMode)
+		}
+		w.mode = os.FileMode(mode)
+	}
+
+	return w, nil
+}
+
+func (w *remoteFileWriter) Write(filePath string, data []byte) error {
+	record := writtenFile{Path: filePath}
+
+	// 备份原有文件
+	if w.backup {
+		backupPath := filePath + "." + w.timestamp + ".bak"
+		if w.backupDir != "" {
+			backupPath = path.Join(w.backupDir, path.Base(backupPath))
+		}
+
+		if err := xssh.CopyFile(w.client, filePath, backupPath); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to backup existing file: %w", err)
+			}
+		} else {
+			record.Existed = true
+			record.BackupPath = backupPath
+		}
+	}
+
+	if w.atomic {
+		if err := xssh.WriteFileAtomic(w.client, filePath, data, w.mode); err != nil {
+			return err
+		}
+	} else {
+		if err := xssh.WriteFile(w.client, w.useSCP, filePath, data); err != nil {
+			return err
+		}
+	}
+
+	if err := w.chmodChown(filePath); err != nil {
+		return err
+	}
+
+	w.written = append(w.written, record)
+	return nil
+}
+
+func (w *remoteFileWriter) BackupPaths() []string {
+	paths := make([]string, 0, len(w.written))
+	for _, record := range w.written {
+		if record.BackupPath != "" {
+			paths = append(paths, record.BackupPath)
+		}
+	}
+	return paths
+}
+
+// Restore 按上传的逆序从备份文件还原原有文件；原本不存在的文件将被删除。
+func (w *remoteFileWriter) Restore() error {
+	var errs []error
+
+	for i := len(w.written) - 1; i >= 0; i-- {
+		record := w.written[i]
+		if record.Existed {
+			if err := xssh.CopyFile(w.client, record.BackupPath, record.Path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore file '%s': %w", record.Path, err))
+			}
+		} else {
+			if err := xssh.RemoveFile(w.client, record.Path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove file '%s': %w", record.Path, err))
+			}
+		}
+	}
+
+	w.written = w.written[:0]
+	return errors.Join(errs...)
+}
+
+func (w *remoteFileWriter) chmodChown(filePath string) error {
+	// 非原子写入时需单独设置文件权限
+	if w.mode != 0 && !w.atomic {
+		if w.useSCP {
+			if stdout, stderr, err := xssh.ExecCommand(w.client, fmt.Sprintf("chmod %o '%s'", w.mode, filePath)); err != nil {
+				return fmt.Errorf("failed to change file mode (stdout: %s, stderr: %s): %w", stdout, stderr, err)
+			}
+		} else if err := xssh.ChmodChown(w.client, filePath, w.mode, -1, -1); err != nil {
+			return err
+		}
+	}
+
+	if w.owner == "" {
+		return nil
+	}
+
+	// 数字形式的所有者可直接通过 SFTP 设置；否则需要在远程服务器上执行 chown 命令解析用户名
+	userName, groupName, _ := strings.Cut(w.owner, ":")
+	uid, uerr := strconv.Atoi(userName)
+	gid, gerr := strconv.Atoi(groupName)
+	if uerr == nil && gerr == nil && !w.useSCP {
+		return xssh.ChmodChown(w.client, filePath, 0, uid, gid)
+	}
+
+	if stdout, stderr, err := xssh.ExecCommand(w.client, fmt.Sprintf("chown '%s' '%s'", w.owner, filePath)); err != nil {
+		return fmt.Errorf("failed to change file owner (stdout: %s, stderr: %s): %w", stdout, stderr, err)
+	}
+
+	return nil
+}
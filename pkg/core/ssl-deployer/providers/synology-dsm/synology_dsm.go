@@ -0,0 +1,153 @@
+package synologydsm
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	synologysdk "github.com/certimate-go/certimate/pkg/sdk3rd/synology"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+type SSLDeployerProviderConfig struct {
+	// 群晖 DSM 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// 群晖 DSM 用户名。
+	Username string `json:"username"`
+	// 群晖 DSM 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书描述。
+	// 非空时替换具有该描述的证书（不存在时新建）；否则替换默认证书。
+	CertificateDesc string `json:"certificateDesc,omitempty"`
+	// 是否设为默认证书。
+	AsDefault bool `json:"asDefault,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *synologysdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := createSDKClient(config.ServerUrl, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	// 提取服务器证书和中间证书
+	serverCertPEM, intermediaCertPEM, err := xcert.ExtractCertificatesFromPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract certs: %w", err)
+	}
+
+	// 登录
+	loginResp, err := d.sdkClient.LoginWithContext(ctx)
+	d.logger.Debug("sdk request 'synology.Login'", slog.Any("response", loginResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'synology.Login': %w", err)
+	}
+	defer func() {
+		logoutResp, err := d.sdkClient.LogoutWithContext(context.Background())
+		d.logger.Debug("sdk request 'synology.Logout'", slog.Any("response", logoutResp))
+		if err != nil {
+			d.logger.Warn("failed to logout", slog.Any("error", err))
+		}
+	}()
+
+	// 查找待替换的证书
+	listCertificatesResp, err := d.sdkClient.ListCertificatesWithContext(ctx)
+	d.logger.Debug("sdk request 'synology.ListCertificates'", slog.Any("response", listCertificatesResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'synology.ListCertificates': %w", err)
+	}
+
+	var targetCert *synologysdk.CertificateInfo
+	if listCertificatesResp.Data != nil {
+		for _, certInfo := range listCertificatesResp.Data.Certificates {
+			if d.config.CertificateDesc != "" {
+				if certInfo.Desc == d.config.CertificateDesc {
+					targetCert = certInfo
+					break
+				}
+			} else if certInfo.IsDefault {
+				targetCert = certInfo
+				break
+			}
+		}
+	}
+
+	// 导入证书
+	importCertificateReq := &synologysdk.ImportCertificateRequest{
+		Desc:                    d.config.CertificateDesc,
+		AsDefault:               d.config.AsDefault,
+		Certificate:             serverCertPEM,
+		IntermediateCertificate: intermediaCertPEM,
+		PrivateKey:              privkeyPEM,
+	}
+	if targetCert != nil {
+		importCertificateReq.Id = targetCert.Id
+		importCertificateReq.AsDefault = importCertificateReq.AsDefault || targetCert.IsDefault
+		if importCertificateReq.Desc == "" {
+			importCertificateReq.Desc = targetCert.Desc
+		}
+		d.logger.Info("ssl certificate found, will replace it", slog.String("certId", targetCert.Id), slog.String("desc", targetCert.Desc))
+	} else {
+		d.logger.Info("no ssl certificate found, will import a new one")
+	}
+	importCertificateResp, err := d.sdkClient.ImportCertificateWithContext(ctx, importCertificateReq)
+	d.logger.Debug("sdk request 'synology.ImportCertificate'", slog.Any("request", importCertificateReq), slog.Any("response", importCertificateResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'synology.ImportCertificate': %w", err)
+	}
+
+	result := &core.SSLDeployResult{}
+	if importCertificateResp.Data != nil {
+		result.ExtendedData = map[string]any{
+			"certId":       importCertificateResp.Data.Id,
+			"restartHttpd": importCertificateResp.Data.RestartHttpd,
+		}
+	}
+
+	return result, nil
+}
+
+func createSDKClient(serverUrl, username, password string, skipTlsVerify bool) (*synologysdk.Client, error) {
+	client, err := synologysdk.NewClient(serverUrl, username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipTlsVerify {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
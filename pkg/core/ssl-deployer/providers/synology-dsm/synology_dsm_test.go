@@ -0,0 +1,199 @@
+package synologydsm_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/synology-dsm"
+)
+
+// 模拟群晖 DSM 中与登录、证书列表和证书导入相关的接口。
+type mockDSM struct {
+	mu           sync.Mutex
+	certificates []map[string]any
+	imports      []map[string]string
+	loggedOut    bool
+}
+
+func (m *mockDSM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	query := r.URL.Query()
+	writeJSON := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	switch query.Get("api") + "." + query.Get("method") {
+	case "SYNO.API.Auth.login":
+		r.ParseForm()
+		if r.PostForm.Get("account") != "admin" || r.PostForm.Get("passwd") != "secret" {
+			writeJSON(map[string]any{"success": false, "error": map[string]any{"code": 400}})
+			return
+		}
+		writeJSON(map[string]any{"success": true, "data": map[string]any{"sid": "sid-1", "synotoken": "token-1"}})
+
+	case "SYNO.API.Auth.logout":
+		m.loggedOut = true
+		writeJSON(map[string]any{"success": true})
+
+	case "SYNO.Core.Certificate.CRT.list":
+		if query.Get("_sid") != "sid-1" {
+			writeJSON(map[string]any{"success": false, "error": map[string]any{"code": 119}})
+			return
+		}
+		writeJSON(map[string]any{"success": true, "data": map[string]any{"certificates": m.certificates}})
+
+	case "SYNO.Core.Certificate.import":
+		if query.Get("_sid") != "sid-1" || r.Header.Get("X-SYNO-TOKEN") != "token-1" {
+			writeJSON(map[string]any{"success": false, "error": map[string]any{"code": 119}})
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		form := map[string]string{
+			"id":         r.FormValue("id"),
+			"desc":       r.FormValue("desc"),
+			"as_default": r.FormValue("as_default"),
+		}
+		for _, name := range []string{"key", "cert", "inter_cert"} {
+			if file, _, err := r.FormFile(name); err == nil {
+				data, _ := io.ReadAll(file)
+				form[name] = string(data)
+			}
+		}
+		m.imports = append(m.imports, form)
+
+		id := form["id"]
+		if id == "" {
+			id = "new-cert"
+		}
+		writeJSON(map[string]any{"success": true, "data": map[string]any{"id": id, "restart_httpd": true}})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func generateTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	privkey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "nas.example.com"},
+		DNSNames:     []string{"nas.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privkeyDER, _ := x509.MarshalECPrivateKey(privkey)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER}))
+}
+
+func TestDeploy(t *testing.T) {
+	certPEM, privkeyPEM := generateTestCertificate(t)
+
+	t.Run("ReplaceDefault", func(t *testing.T) {
+		mock := &mockDSM{
+			certificates: []map[string]any{
+				{"id": "cert-a", "desc": "old", "is_default": false},
+				{"id": "cert-b", "desc": "default", "is_default": true},
+			},
+		}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl: server.URL,
+			Username:  "admin",
+			Password:  "secret",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mock.imports) != 1 {
+			t.Fatalf("expected 1 import, got %d", len(mock.imports))
+		}
+		imported := mock.imports[0]
+		if imported["id"] != "cert-b" || imported["desc"] != "default" || imported["as_default"] != "true" {
+			t.Errorf("unexpected import form: %v", imported)
+		}
+		if imported["cert"] != certPEM || imported["key"] != privkeyPEM {
+			t.Errorf("unexpected import files")
+		}
+		if res.ExtendedData["certId"] != "cert-b" {
+			t.Errorf("unexpected result: %v", res.ExtendedData)
+		}
+		if !mock.loggedOut {
+			t.Errorf("expected logout")
+		}
+	})
+
+	t.Run("ImportNamed", func(t *testing.T) {
+		mock := &mockDSM{
+			certificates: []map[string]any{
+				{"id": "cert-b", "desc": "default", "is_default": true},
+			},
+		}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:       server.URL,
+			Username:        "admin",
+			Password:        "secret",
+			CertificateDesc: "certimate",
+		})
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatal(err)
+		}
+
+		imported := mock.imports[0]
+		if imported["id"] != "" || imported["desc"] != "certimate" || imported["as_default"] != "" {
+			t.Errorf("unexpected import form: %v", imported)
+		}
+	})
+
+	t.Run("LoginFailed", func(t *testing.T) {
+		server := httptest.NewServer(&mockDSM{})
+		defer server.Close()
+
+		deployer, _ := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl: server.URL,
+			Username:  "admin",
+			Password:  "wrong",
+		})
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,261 @@
+package traefik
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+type JumpServerConfig = xssh.JumpServerConfig
+
+type SSLDeployerProviderConfig struct {
+	// 是否通过 SSH 部署到远程服务器。
+	// 否则写入本地文件系统。
+	UseSSH bool `json:"useSSH,omitempty"`
+	// SSH 主机。
+	// 零值时默认值 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认值 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 认证方式。
+	// 可取值 "none"、"password" 或 "key"。
+	// 零值时根据有无密码或私钥字段决定。
+	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机配置数组。
+	JumpServers []JumpServerConfig `json:"jumpServers,omitempty"`
+	// 是否回退使用 SCP。
+	UseSCP bool `json:"useSCP,omitempty"`
+	// Traefik 文件提供者的动态配置文件路径（YAML 格式）。
+	DynamicConfigPath string `json:"dynamicConfigPath"`
+	// 输出证书文件路径。
+	OutputCertPath string `json:"outputCertPath"`
+	// 输出私钥文件路径。
+	OutputKeyPath string `json:"outputKeyPath"`
+	// 证书所属的 TLS 存储名称数组。
+	// 选填。
+	TlsStores []string `json:"tlsStores,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		config: config,
+		logger: slog.Default(),
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if d.config.DynamicConfigPath == "" {
+		return nil, errors.New("config `dynamicConfigPath` is required")
+	}
+	if d.config.OutputCertPath == "" {
+		return nil, errors.New("config `outputCertPath` is required")
+	}
+	if d.config.OutputKeyPath == "" {
+		return nil, errors.New("config `outputKeyPath` is required")
+	}
+
+	var fs xfs.FileSystem
+	if d.config.UseSSH {
+		client, err := xssh.DialWithJumpServers(ctx, xssh.ServerConfig{
+			Host:          d.config.SshHost,
+			Port:          d.config.SshPort,
+			AuthMethod:    d.config.SshAuthMethod,
+			Username:      d.config.SshUsername,
+			Password:      d.config.SshPassword,
+			Key:           d.config.SshKey,
+			KeyPassphrase: d.config.SshKeyPassphrase,
+		}, d.config.JumpServers)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		d.logger.Info("ssh connected")
+		fs = xfs.NewSSHFileSystem(client, d.config.UseSCP)
+	} else {
+		fs = xfs.NewLocalFileSystem()
+	}
+
+	// 先写入证书和私钥文件，再更新动态配置，确保 Traefik 监听到配置变更时文件已就绪
+	if err := fs.WriteFile(d.config.OutputCertPath, []byte(certPEM), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to save certificate file: %w", err)
+	}
+	d.logger.Info("ssl certificate file saved", slog.String("path", d.config.OutputCertPath))
+
+	if err := fs.WriteFile(d.config.OutputKeyPath, []byte(privkeyPEM), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to save private key file: %w", err)
+	}
+	d.logger.Info("ssl private key file saved", slog.String("path", d.config.OutputKeyPath))
+
+	// 读取并合并动态配置
+	configData, err := fs.ReadFile(d.config.DynamicConfigPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read dynamic config file: %w", err)
+	}
+
+	configData, err = mergeDynamicConfig(configData, d.config.OutputCertPath, d.config.OutputKeyPath, d.config.TlsStores)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge dynamic config: %w", err)
+	}
+
+	if err := fs.WriteFile(d.config.DynamicConfigPath, configData, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to save dynamic config file: %w", err)
+	}
+	d.logger.Info("traefik dynamic config file saved", slog.String("path", d.config.DynamicConfigPath))
+
+	return &core.SSLDeployResult{}, nil
+}
+
+// 将证书条目合并到 Traefik 动态配置的 `tls.certificates` 列表中。
+// 具有相同 `certFile` 的条目会被更新，其余配置（包括注释和顺序）保持不变。
+func mergeDynamicConfig(data []byte, certFile, keyFile string, stores []string) ([]byte, error) {
+	doc := &yaml.Node{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return nil, err
+		}
+	}
+
+	if doc.Kind == 0 {
+		doc.Kind = yaml.DocumentNode
+	}
+	if doc.Kind != yaml.DocumentNode {
+		return nil, errors.New("unexpected yaml document")
+	}
+	if len(doc.Content) == 0 {
+		doc.Content = append(doc.Content, &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"})
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("the root of dynamic config must be a mapping")
+	}
+
+	tlsNode, err := ensureYamlChild(root, "tls", yaml.MappingNode)
+	if err != nil {
+		return nil, err
+	}
+
+	certsNode, err := ensureYamlChild(tlsNode, "certificates", yaml.SequenceNode)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, item := range certsNode.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+
+		if v := findYamlChild(item, "certFile"); v != nil && v.Value == certFile {
+			entry = item
+			break
+		}
+	}
+	if len(entry.Content) == 0 {
+		certsNode.Content = append(certsNode.Content, entry)
+	}
+
+	setYamlScalar(entry, "certFile", certFile)
+	setYamlScalar(entry, "keyFile", keyFile)
+	if len(stores) > 0 {
+		storesNode := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, store := range stores {
+			store = strings.TrimSpace(store)
+			if store == "" {
+				continue
+			}
+			storesNode.Content = append(storesNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: store})
+		}
+		setYamlChild(entry, "stores", storesNode)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	encoder.Close()
+
+	return buf.Bytes(), nil
+}
+
+func findYamlChild(parent *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			return parent.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func ensureYamlChild(parent *yaml.Node, key string, kind yaml.Kind) (*yaml.Node, error) {
+	child := findYamlChild(parent, key)
+	if child == nil || (child.Kind == yaml.ScalarNode && child.Tag == "!!null") {
+		tag := "!!map"
+		if kind == yaml.SequenceNode {
+			tag = "!!seq"
+		}
+		child = &yaml.Node{Kind: kind, Tag: tag}
+		setYamlChild(parent, key, child)
+	} else if child.Kind != kind {
+		return nil, fmt.Errorf("unexpected type of yaml node '%s'", key)
+	}
+
+	return child, nil
+}
+
+func setYamlChild(parent *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			parent.Content[i+1] = value
+			return
+		}
+	}
+
+	parent.Content = append(parent.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+func setYamlScalar(parent *yaml.Node, key string, value string) {
+	setYamlChild(parent, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
@@ -0,0 +1,139 @@
+package traefik
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_mergeDynamicConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		certFile string
+		keyFile  string
+		stores   []string
+		want     string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			certFile: "/certs/a.crt",
+			keyFile:  "/certs/a.key",
+			want: `tls:
+  certificates:
+    - certFile: /certs/a.crt
+      keyFile: /certs/a.key
+`,
+		},
+		{
+			name: "AppendAndPreserve",
+			input: `# managed by ops
+http:
+  routers:
+    web:
+      rule: Host(` + "`example.com`" + `)
+tls:
+  options:
+    default:
+      minVersion: VersionTLS12
+  certificates:
+    - certFile: /certs/b.crt
+      keyFile: /certs/b.key
+`,
+			certFile: "/certs/a.crt",
+			keyFile:  "/certs/a.key",
+			stores:   []string{"default"},
+			want: `# managed by ops
+http:
+  routers:
+    web:
+      rule: Host(` + "`example.com`" + `)
+tls:
+  options:
+    default:
+      minVersion: VersionTLS12
+  certificates:
+    - certFile: /certs/b.crt
+      keyFile: /certs/b.key
+    - certFile: /certs/a.crt
+      keyFile: /certs/a.key
+      stores:
+        - default
+`,
+		},
+		{
+			name: "Update",
+			input: `tls:
+  certificates:
+    - certFile: /certs/a.crt
+      keyFile: /certs/old.key
+    - certFile: /certs/b.crt
+      keyFile: /certs/b.key
+`,
+			certFile: "/certs/a.crt",
+			keyFile:  "/certs/a.key",
+			want: `tls:
+  certificates:
+    - certFile: /certs/a.crt
+      keyFile: /certs/a.key
+    - certFile: /certs/b.crt
+      keyFile: /certs/b.key
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeDynamicConfig([]byte(tt.input), tt.certFile, tt.keyFile, tt.stores)
+			if err != nil {
+				t.Fatalf("err: %+v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", string(got), tt.want)
+			}
+		})
+	}
+
+	if _, err := mergeDynamicConfig([]byte("tls: [1, 2]"), "/a.crt", "/a.key", nil); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestDeployLocal(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "dynamic", "certs.yml")
+
+	deployer, err := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+		DynamicConfigPath: configPath,
+		OutputCertPath:    filepath.Join(tempDir, "certs", "a.crt"),
+		OutputKeyPath:     filepath.Join(tempDir, "certs", "a.key"),
+	})
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := deployer.Deploy(context.Background(), "CERT", "KEY"); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+	if strings.Count(string(data), "certFile:") != 1 {
+		t.Errorf("unexpected dynamic config:\n%s", string(data))
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(tempDir, "certs", "a.key")); string(data) != "KEY" {
+		t.Errorf("unexpected private key file: %s", string(data))
+	}
+	if info, _ := os.Stat(filepath.Join(tempDir, "certs", "a.key")); runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Errorf("expected private key file mode 0600, got %o", info.Mode().Perm())
+	}
+}
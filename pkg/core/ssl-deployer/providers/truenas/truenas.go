@@ -0,0 +1,165 @@
+package truenas
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	truenassdk "github.com/certimate-go/certimate/pkg/sdk3rd/truenas"
+)
+
+type SSLDeployerProviderConfig struct {
+	// TrueNAS 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// TrueNAS API Key。
+	ApiKey string `json:"apiKey"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 是否设为 Web UI 证书。
+	SetAsUICertificate bool `json:"setAsUICertificate,omitempty"`
+	// 是否自动重启 Web UI。
+	// 仅当设为 Web UI 证书时有效。
+	AutoRestart bool `json:"autoRestart,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *truenassdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := createSDKClient(config.ServerUrl, config.ApiKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	// 导入证书
+	// 证书名称在 TrueNAS 中须唯一
+	certName := fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+	createCertificateReq := &truenassdk.CreateCertificateRequest{
+		CreateType:  "CERTIFICATE_CREATE_IMPORTED",
+		Name:        certName,
+		Certificate: certPEM,
+		PrivateKey:  privkeyPEM,
+	}
+	jobId, err := d.sdkClient.CreateCertificateWithContext(ctx, createCertificateReq)
+	d.logger.Debug("sdk request 'truenas.CreateCertificate'", slog.String("request.name", certName), slog.Int64("response", jobId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'truenas.CreateCertificate': %w", err)
+	}
+
+	// 等待导入任务完成
+	if err := d.waitJob(ctx, jobId); err != nil {
+		return nil, err
+	}
+
+	queryCertificatesResp, err := d.sdkClient.QueryCertificatesWithContext(ctx, certName)
+	d.logger.Debug("sdk request 'truenas.QueryCertificates'", slog.String("request.name", certName), slog.Any("response", queryCertificatesResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'truenas.QueryCertificates': %w", err)
+	} else if len(queryCertificatesResp) == 0 {
+		return nil, fmt.Errorf("could not find certificate '%s' after import", certName)
+	}
+
+	certId := queryCertificatesResp[0].Id
+	d.logger.Info("ssl certificate imported", slog.Int64("certId", certId), slog.String("name", certName))
+
+	// 设为 Web UI 证书
+	if d.config.SetAsUICertificate {
+		updateSystemGeneralReq := &truenassdk.UpdateSystemGeneralRequest{
+			UICertificate: &certId,
+		}
+		err := d.sdkClient.UpdateSystemGeneralWithContext(ctx, updateSystemGeneralReq)
+		d.logger.Debug("sdk request 'truenas.UpdateSystemGeneral'", slog.Any("request", updateSystemGeneralReq))
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute sdk request 'truenas.UpdateSystemGeneral': %w", err)
+		}
+
+		if d.config.AutoRestart {
+			err := d.sdkClient.RestartUIWithContext(ctx)
+			d.logger.Debug("sdk request 'truenas.RestartUI'")
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute sdk request 'truenas.RestartUI': %w", err)
+			}
+		}
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"certId":   certId,
+			"certName": certName,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) waitJob(ctx context.Context, jobId int64) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		getJobResp, err := d.sdkClient.GetJobWithContext(ctx, jobId)
+		d.logger.Debug("sdk request 'truenas.GetJob'", slog.Int64("request.jobId", jobId), slog.Any("response", getJobResp))
+		if err != nil {
+			return fmt.Errorf("failed to execute sdk request 'truenas.GetJob': %w", err)
+		}
+
+		switch getJobResp.State {
+		case truenassdk.JobStateSuccess:
+			return nil
+		case truenassdk.JobStateFailed, truenassdk.JobStateAborted:
+			return fmt.Errorf("truenas job #%d %s: %s", jobId, getJobResp.State, getJobResp.Error)
+		}
+
+		d.logger.Info("waiting for truenas job completion ...", slog.Int64("jobId", jobId), slog.String("state", getJobResp.State))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+var jobPollInterval = time.Second
+
+func createSDKClient(serverUrl, apiKey string, skipTlsVerify bool) (*truenassdk.Client, error) {
+	client, err := truenassdk.NewClient(serverUrl, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipTlsVerify {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,138 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 模拟 TrueNAS REST API 中与证书导入和 Web UI 证书设置相关的接口。
+type mockTrueNAS struct {
+	mu            sync.Mutex
+	certificates  []map[string]any
+	jobPolls      int
+	jobFail       bool
+	uiCertificate int64
+	uiRestarted   bool
+}
+
+func (m *mockTrueNAS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer api-key" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /api/v2.0/certificate":
+		body := make(map[string]any)
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["create_type"] != "CERTIFICATE_CREATE_IMPORTED" || body["certificate"] == "" || body["privatekey"] == "" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		m.certificates = append(m.certificates, map[string]any{"id": len(m.certificates) + 1, "name": body["name"]})
+		writeJSON(42)
+
+	case "GET /api/v2.0/core/get_jobs":
+		if r.URL.Query().Get("id") != "42" {
+			writeJSON([]any{})
+			return
+		}
+		m.jobPolls++
+		state := "RUNNING"
+		if m.jobPolls > 1 {
+			state = "SUCCESS"
+			if m.jobFail {
+				state = "FAILED"
+			}
+		}
+		writeJSON([]any{map[string]any{"id": 42, "state": state, "error": "boom"}})
+
+	case "GET /api/v2.0/certificate":
+		result := make([]any, 0)
+		for _, cert := range m.certificates {
+			if cert["name"] == r.URL.Query().Get("name") {
+				result = append(result, cert)
+			}
+		}
+		writeJSON(result)
+
+	case "PUT /api/v2.0/system/general":
+		body := make(map[string]any)
+		json.NewDecoder(r.Body).Decode(&body)
+		id, _ := body["ui_certificate"].(float64)
+		m.uiCertificate = int64(id)
+		writeJSON(map[string]any{"ui_certificate": id})
+
+	case "POST /api/v2.0/system/general/ui_restart":
+		m.uiRestarted = true
+		writeJSON(nil)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	jobPollInterval = 10 * time.Millisecond
+
+	t.Run("SetAsUICertificate", func(t *testing.T) {
+		mock := &mockTrueNAS{certificates: []map[string]any{{"id": 1, "name": "existing"}}}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, err := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+			ServerUrl:          server.URL,
+			ApiKey:             "api-key",
+			SetAsUICertificate: true,
+			AutoRestart:        true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), "cert", "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ExtendedData["certId"] != int64(2) {
+			t.Errorf("unexpected result: %v", res.ExtendedData)
+		}
+		if mock.uiCertificate != 2 {
+			t.Errorf("expected ui certificate to be 2, got %d", mock.uiCertificate)
+		}
+		if !mock.uiRestarted {
+			t.Errorf("expected ui to be restarted")
+		}
+	})
+
+	t.Run("JobFailed", func(t *testing.T) {
+		mock := &mockTrueNAS{jobFail: true}
+		server := httptest.NewServer(mock)
+		defer server.Close()
+
+		deployer, _ := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+			ServerUrl: server.URL,
+			ApiKey:    "api-key",
+		})
+		if _, err := deployer.Deploy(context.Background(), "cert", "key"); err == nil {
+			t.Error("expected error")
+		}
+		if mock.uiCertificate != 0 {
+			t.Errorf("expected ui certificate not to be changed")
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package vaultkv
+
+type AuthMethodType string
+
+const (
+	// 认证方式：Token。
+	AUTH_METHOD_TOKEN = AuthMethodType("token")
+	// 认证方式：AppRole。
+	AUTH_METHOD_APPROLE = AuthMethodType("approle")
+)
+
+const (
+	defaultKVMountPath          = "secret"
+	defaultFieldNameCertificate = "certificate"
+	defaultFieldNameChain       = "chain"
+	defaultFieldNamePrivateKey  = "private_key"
+)
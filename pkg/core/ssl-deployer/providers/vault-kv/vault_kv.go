@@ -0,0 +1,186 @@
+package vaultkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	vaultsdk "github.com/certimate-go/certimate/pkg/sdk3rd/vault"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+)
+
+type SSLDeployerProviderConfig struct {
+	// Vault 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Vault 命名空间（仅企业版）。
+	Namespace string `json:"namespace,omitempty"`
+	// 认证方式。
+	// 零值时默认值 [AUTH_METHOD_TOKEN]。
+	AuthMethod AuthMethodType `json:"authMethod,omitempty"`
+	// Vault Token。
+	// 认证方式为 [AUTH_METHOD_TOKEN] 时必填。
+	Token string `json:"token,omitempty"`
+	// AppRole 认证挂载路径。
+	// 零值时默认值 "approle"。
+	AppRoleMountPath string `json:"appRoleMountPath,omitempty"`
+	// AppRole RoleID。
+	// 认证方式为 [AUTH_METHOD_APPROLE] 时必填。
+	AppRoleRoleId string `json:"appRoleRoleId,omitempty"`
+	// AppRole SecretID。
+	AppRoleSecretId string `json:"appRoleSecretId,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// KV 引擎挂载路径。
+	// 零值时默认值 "secret"。
+	KVMountPath string `json:"kvMountPath,omitempty"`
+	// KV 引擎版本。
+	// 可取值 1、2。零值时默认值 2。
+	KVVersion int32 `json:"kvVersion,omitempty"`
+	// 密钥路径。
+	SecretPath string `json:"secretPath"`
+	// 证书字段名。
+	// 零值时默认值 "certificate"。
+	FieldNameCertificate string `json:"fieldNameCertificate,omitempty"`
+	// 证书链字段名。
+	// 零值时默认值 "chain"。
+	FieldNameChain string `json:"fieldNameChain,omitempty"`
+	// 私钥字段名。
+	// 零值时默认值 "private_key"。
+	FieldNamePrivateKey string `json:"fieldNamePrivateKey,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config    *SSLDeployerProviderConfig
+	logger    *slog.Logger
+	sdkClient *vaultsdk.Client
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	client, err := vaultsdk.NewClientWithOptions(&vaultsdk.ClientOptions{
+		ServerUrl:                config.ServerUrl,
+		Namespace:                config.Namespace,
+		AllowInsecureConnections: config.AllowInsecureConnections,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create sdk client: %w", err)
+	}
+
+	return &SSLDeployerProvider{
+		config:    config,
+		logger:    slog.Default(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	if d.config.SecretPath == "" {
+		return nil, errors.New("config `secretPath` is required")
+	}
+
+	// 认证
+	if err := d.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPEM, intermediaCertPEM, err := xcert.ExtractCertificatesFromPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract certs: %w", err)
+	}
+
+	fieldNameCertificate := d.config.FieldNameCertificate
+	if fieldNameCertificate == "" {
+		fieldNameCertificate = defaultFieldNameCertificate
+	}
+	fieldNameChain := d.config.FieldNameChain
+	if fieldNameChain == "" {
+		fieldNameChain = defaultFieldNameChain
+	}
+	fieldNamePrivateKey := d.config.FieldNamePrivateKey
+	if fieldNamePrivateKey == "" {
+		fieldNamePrivateKey = defaultFieldNamePrivateKey
+	}
+	if fieldNameCertificate == fieldNameChain || fieldNameCertificate == fieldNamePrivateKey || fieldNameChain == fieldNamePrivateKey {
+		return nil, errors.New("config `fieldNameCertificate`, `fieldNameChain` and `fieldNamePrivateKey` must be different")
+	}
+
+	kvMountPath := d.config.KVMountPath
+	if kvMountPath == "" {
+		kvMountPath = defaultKVMountPath
+	}
+
+	// 读取已有的 KV 密钥
+	// KV 写入为整体覆盖，需保留同一路径下的其他字段
+	kvReadReq := &vaultsdk.KVReadRequest{
+		Version: d.config.KVVersion,
+	}
+	kvReadResp, err := d.sdkClient.KVReadWithContext(ctx, kvMountPath, d.config.SecretPath, kvReadReq)
+	d.logger.Debug("sdk request 'vault.KVRead'", slog.String("mountPath", kvMountPath), slog.String("secretPath", d.config.SecretPath))
+	if err != nil && !errors.Is(err, vaultsdk.ErrSecretNotFound) {
+		return nil, fmt.Errorf("failed to execute sdk request 'vault.KVRead': %w", err)
+	}
+
+	kvData := make(map[string]any)
+	if err == nil && kvReadResp.Data != nil {
+		maps.Copy(kvData, kvReadResp.Data)
+	}
+	kvData[fieldNameCertificate] = serverCertPEM
+	kvData[fieldNameChain] = intermediaCertPEM
+	kvData[fieldNamePrivateKey] = privkeyPEM
+
+	// 写入 KV 密钥
+	kvWriteReq := &vaultsdk.KVWriteRequest{
+		Version: d.config.KVVersion,
+		Data:    kvData,
+	}
+	kvWriteResp, err := d.sdkClient.KVWriteWithContext(ctx, kvMountPath, d.config.SecretPath, kvWriteReq)
+	d.logger.Debug("sdk request 'vault.KVWrite'", slog.String("mountPath", kvMountPath), slog.String("secretPath", d.config.SecretPath), slog.Any("response", kvWriteResp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sdk request 'vault.KVWrite': %w", err)
+	}
+
+	extendedData := map[string]any{
+		"mountPath":  kvMountPath,
+		"secretPath": d.config.SecretPath,
+	}
+	if kvWriteResp.Data != nil && kvWriteResp.Data.Version > 0 {
+		extendedData["version"] = kvWriteResp.Data.Version
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: extendedData,
+	}, nil
+}
+
+func (d *SSLDeployerProvider) authenticate(ctx context.Context) error {
+	err := d.sdkClient.Login(ctx, &vaultsdk.AuthOptions{
+		Method:           string(d.config.AuthMethod),
+		Token:            d.config.Token,
+		AppRoleMountPath: d.config.AppRoleMountPath,
+		AppRoleRoleId:    d.config.AppRoleRoleId,
+		AppRoleSecretId:  d.config.AppRoleSecretId,
+	})
+	d.logger.Debug("sdk request 'vault.Login'", slog.String("authMethod", string(d.config.AuthMethod)))
+	if err != nil {
+		return fmt.Errorf("failed to execute sdk request 'vault.Login': %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package vaultkv_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	provider "github.com/certimate-go/certimate/pkg/core/ssl-deployer/providers/vault-kv"
+)
+
+func TestDeploy(t *testing.T) {
+	certPEM, privkeyPEM := mockCertificate(t)
+
+	var (
+		lastPath  string
+		lastToken string
+		lastBody  map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/auth/approle/login":
+			var req map[string]any
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["role_id"] != "test-role" || req["secret_id"] != "test-secret" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"errors":["invalid role or secret ID"]}`))
+				return
+			}
+			w.Write([]byte(`{"auth":{"client_token":"approle-token","lease_duration":3600}}`))
+
+		case "/v1/secret/data/certs/example.com":
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`{"data":{"data":{"certificate":"old","username":"keep-me"},"metadata":{"version":2}}}`))
+				return
+			}
+			fallthrough
+
+		default:
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errors":[]}`))
+				return
+			}
+
+			lastPath = r.URL.Path
+			lastToken = r.Header.Get("X-Vault-Token")
+			lastBody = make(map[string]any)
+			json.NewDecoder(r.Body).Decode(&lastBody)
+			if lastToken == "" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"errors":["permission denied"]}`))
+				return
+			}
+			w.Write([]byte(`{"data":{"version":3}}`))
+		}
+	}))
+	defer server.Close()
+
+	t.Run("KVv2WithToken", func(t *testing.T) {
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:  server.URL,
+			AuthMethod: provider.AUTH_METHOD_TOKEN,
+			Token:      "root-token",
+			SecretPath: "certs/example.com",
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		res, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM)
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if lastPath != "/v1/secret/data/certs/example.com" {
+			t.Errorf("unexpected path: %s", lastPath)
+		}
+		if lastToken != "root-token" {
+			t.Errorf("unexpected token: %s", lastToken)
+		}
+		data, _ := lastBody["data"].(map[string]any)
+		if data["certificate"] == "old" || data["private_key"] != privkeyPEM || data["username"] != "keep-me" {
+			t.Errorf("unexpected data: %v", data)
+		}
+		if res.ExtendedData["version"] != int64(3) {
+			t.Errorf("unexpected extended data: %v", res.ExtendedData)
+		}
+	})
+
+	t.Run("KVv1WithAppRole", func(t *testing.T) {
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:            server.URL,
+			AuthMethod:           provider.AUTH_METHOD_APPROLE,
+			AppRoleRoleId:        "test-role",
+			AppRoleSecretId:      "test-secret",
+			KVMountPath:          "kv",
+			KVVersion:            1,
+			SecretPath:           "/tls/example.com/",
+			FieldNameCertificate: "tls.crt",
+			FieldNamePrivateKey:  "tls.key",
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if lastPath != "/v1/kv/tls/example.com" {
+			t.Errorf("unexpected path: %s", lastPath)
+		}
+		if lastToken != "approle-token" {
+			t.Errorf("unexpected token: %s", lastToken)
+		}
+		if lastBody["tls.crt"] == nil || lastBody["tls.key"] != privkeyPEM || lastBody["chain"] == nil {
+			t.Errorf("unexpected data: %v", lastBody)
+		}
+	})
+
+	t.Run("AppRoleLoginFailed", func(t *testing.T) {
+		deployer, err := provider.NewSSLDeployerProvider(&provider.SSLDeployerProviderConfig{
+			ServerUrl:     server.URL,
+			AuthMethod:    provider.AUTH_METHOD_APPROLE,
+			AppRoleRoleId: "wrong-role",
+			SecretPath:    "certs/example.com",
+		})
+		if err != nil {
+			t.Fatalf("err: %+v", err)
+		}
+
+		if _, err := deployer.Deploy(context.Background(), certPEM, privkeyPEM); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func mockCertificate(t *testing.T) (string, string) {
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	privkeyDER, err := x509.MarshalECPrivateKey(privkey)
+	if err != nil {
+		t.Fatalf("err: %+v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	privkeyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER})
+	return string(certPEM), string(privkeyPEM)
+}
@@ -0,0 +1,236 @@
+package webserver
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+)
+
+// 解析 Apache 配置文件，返回所有启用了 SSL 证书的虚拟主机。
+func parseApacheVirtualHosts(fs xfs.FileSystem, configPath string) ([]*virtualHost, error) {
+	serverRoot := path.Dir(configPath)
+
+	var parse func(fs xfs.FileSystem, file string, depth int) ([]*directive, error)
+	parse = func(fs xfs.FileSystem, file string, depth int) ([]*directive, error) {
+		data, err := readConfigFile(fs, file, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		directives, err := parseApacheConfig(string(data), file)
+		if err != nil {
+			return nil, err
+		}
+
+		// ServerRoot 决定后续相对路径的解析基准
+		if root := findDirectiveArg(directives, "ServerRoot"); root != "" {
+			serverRoot = root
+		}
+
+		return expandIncludes(fs, directives, func() string { return serverRoot }, parse, depth)
+	}
+
+	directives, err := parse(fs, configPath, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(p string) string {
+		if p == "" || path.IsAbs(p) {
+			return p
+		}
+		return path.Join(serverRoot, p)
+	}
+
+	// SSLCertificateFile 可在全局配置中声明，由虚拟主机继承
+	globalSettings := collectApacheSettings(directives, false)
+
+	vhosts := make([]*virtualHost, 0)
+	var walk func(directives []*directive)
+	walk = func(directives []*directive) {
+		for _, d := range directives {
+			if d.Block == nil {
+				continue
+			}
+
+			if !strings.EqualFold(d.Name, "VirtualHost") {
+				walk(d.Block)
+				continue
+			}
+
+			settings := collectApacheSettings(d.Block, true)
+			if settings.CertPath == "" {
+				settings.CertPath = globalSettings.CertPath
+				settings.KeyPath = globalSettings.KeyPath
+				settings.ChainPath = globalSettings.ChainPath
+			}
+			if settings.CertPath == "" {
+				continue
+			}
+
+			vhosts = append(vhosts, &virtualHost{
+				Names:     settings.Names,
+				CertPath:  resolve(settings.CertPath),
+				KeyPath:   resolve(settings.KeyPath),
+				ChainPath: resolve(settings.ChainPath),
+				File:      d.File,
+			})
+		}
+	}
+	walk(directives)
+
+	return vhosts, nil
+}
+
+type apacheSettings struct {
+	Names     []string
+	CertPath  string
+	KeyPath   string
+	ChainPath string
+}
+
+// 收集指令中的 SSL 相关设置。
+// recursive 为 true 时会进入嵌套的条件块（如 <IfModule>），但不进入 <VirtualHost>。
+func collectApacheSettings(directives []*directive, recursive bool) apacheSettings {
+	settings := apacheSettings{Names: make([]string, 0)}
+
+	var walk func(directives []*directive)
+	walk = func(directives []*directive) {
+		for _, d := range directives {
+			if d.Block != nil {
+				if recursive && !strings.EqualFold(d.Name, "VirtualHost") {
+					walk(d.Block)
+				}
+				continue
+			}
+
+			switch strings.ToLower(d.Name) {
+			case "servername":
+				if len(d.Args) > 0 {
+					// ServerName 可能带有协议和端口，例如 "https://example.com:443"
+					name := d.Args[0]
+					if i := strings.Index(name, "://"); i >= 0 {
+						name = name[i+3:]
+					}
+					if i := strings.LastIndex(name, ":"); i >= 0 {
+						name = name[:i]
+					}
+					settings.Names = append(settings.Names, name)
+				}
+			case "serveralias":
+				settings.Names = append(settings.Names, d.Args...)
+			case "sslcertificatefile":
+				if len(d.Args) > 0 && settings.CertPath == "" {
+					settings.CertPath = d.Args[0]
+				}
+			case "sslcertificatekeyfile":
+				if len(d.Args) > 0 && settings.KeyPath == "" {
+					settings.KeyPath = d.Args[0]
+				}
+			case "sslcertificatechainfile":
+				if len(d.Args) > 0 && settings.ChainPath == "" {
+					settings.ChainPath = d.Args[0]
+				}
+			}
+		}
+	}
+	walk(directives)
+
+	return settings
+}
+
+// 将 Apache 配置文本解析为指令树。
+func parseApacheConfig(content string, file string) ([]*directive, error) {
+	// 合并以反斜杠结尾的续行
+	content = strings.ReplaceAll(content, "\\\r\n", " ")
+	content = strings.ReplaceAll(content, "\\\n", " ")
+
+	type frame struct {
+		section    *directive
+		directives []*directive
+	}
+
+	stack := []*frame{{directives: make([]*directive, 0)}}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		current := stack[len(stack)-1]
+
+		switch {
+		case strings.HasPrefix(line, "</"):
+			name := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "</"), ">"))
+			if current.section == nil || !strings.EqualFold(current.section.Name, name) {
+				return nil, fmt.Errorf("failed to parse '%s': unexpected '</%s>' at line %d", file, name, i+1)
+			}
+
+			current.section.Block = current.directives
+			stack = stack[:len(stack)-1]
+			parent := stack[len(stack)-1]
+			parent.directives = append(parent.directives, current.section)
+
+		case strings.HasPrefix(line, "<"):
+			fields := splitApacheArgs(strings.TrimSuffix(strings.TrimPrefix(line, "<"), ">"))
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("failed to parse '%s': invalid section at line %d", file, i+1)
+			}
+
+			section := &directive{Name: fields[0], Args: fields[1:], File: file}
+			stack = append(stack, &frame{section: section, directives: make([]*directive, 0)})
+
+		default:
+			fields := splitApacheArgs(line)
+			current.directives = append(current.directives, &directive{Name: fields[0], Args: fields[1:], File: file})
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("failed to parse '%s': unclosed section '<%s>'", file, stack[len(stack)-1].section.Name)
+	}
+
+	return stack[0].directives, nil
+}
+
+func splitApacheArgs(line string) []string {
+	args := make([]string, 0)
+
+	var arg strings.Builder
+	var quote byte
+	hasArg := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				arg.WriteByte(c)
+			}
+
+		case c == '"' || c == '\'':
+			quote = c
+			hasArg = true
+
+		case c == ' ' || c == '\t':
+			if hasArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				hasArg = false
+			}
+
+		default:
+			arg.WriteByte(c)
+			hasArg = true
+		}
+	}
+	if hasArg {
+		args = append(args, arg.String())
+	}
+
+	return args
+}
@@ -0,0 +1,121 @@
+package webserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+)
+
+// 配置文件的最大嵌套包含深度，防止循环包含。
+const maxIncludeDepth = 16
+
+type directive struct {
+	Name  string
+	Args  []string
+	Block []*directive
+	File  string
+}
+
+type virtualHost struct {
+	Names     []string `json:"names"`
+	CertPath  string   `json:"certPath"`
+	KeyPath   string   `json:"keyPath,omitempty"`
+	ChainPath string   `json:"chainPath,omitempty"`
+	File      string   `json:"file"`
+}
+
+// 展开配置中的包含指令。
+// 包含路径为相对路径时，基于 root 解析；支持通配符。
+func expandIncludes(fs xfs.FileSystem, directives []*directive, root func() string, parse func(fs xfs.FileSystem, file string, depth int) ([]*directive, error), depth int) ([]*directive, error) {
+	expanded := make([]*directive, 0, len(directives))
+
+	for _, d := range directives {
+		name := strings.ToLower(d.Name)
+		if name != "include" && name != "includeoptional" {
+			if d.Block != nil {
+				block, err := expandIncludes(fs, d.Block, root, parse, depth)
+				if err != nil {
+					return nil, err
+				}
+				d.Block = block
+			}
+
+			expanded = append(expanded, d)
+			continue
+		}
+
+		if len(d.Args) == 0 {
+			continue
+		}
+
+		pattern := d.Args[0]
+		if !path.IsAbs(pattern) {
+			pattern = path.Join(root(), pattern)
+		}
+
+		var files []string
+		if strings.ContainsAny(pattern, "*?[") {
+			matches, err := fs.Glob(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve include '%s' in '%s': %w", d.Args[0], d.File, err)
+			}
+			files = matches
+		} else {
+			files = []string{pattern}
+		}
+
+		for _, file := range files {
+			children, err := parse(fs, file, depth+1)
+			if err != nil {
+				// Apache 的 IncludeOptional 允许文件不存在
+				if name == "includeoptional" && errors.Is(err, errConfigNotFound) {
+					continue
+				}
+				return nil, err
+			}
+
+			expanded = append(expanded, children...)
+		}
+	}
+
+	return expanded, nil
+}
+
+var errConfigNotFound = errors.New("config file not found")
+
+func readConfigFile(fs xfs.FileSystem, file string, depth int) ([]byte, error) {
+	if depth > maxIncludeDepth {
+		return nil, fmt.Errorf("too many nested includes at '%s'", file)
+	}
+
+	data, err := fs.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: '%s'", errConfigNotFound, file)
+		}
+		return nil, fmt.Errorf("failed to read config file '%s': %w", file, err)
+	}
+
+	return data, nil
+}
+
+func findDirectiveArgs(directives []*directive, name string) []string {
+	for _, d := range directives {
+		if strings.EqualFold(d.Name, name) {
+			return d.Args
+		}
+	}
+	return nil
+}
+
+func findDirectiveArg(directives []*directive, name string) string {
+	args := findDirectiveArgs(directives, name)
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
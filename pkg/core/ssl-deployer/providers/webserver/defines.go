@@ -0,0 +1,18 @@
+package webserver
+
+type ServerType string
+
+const (
+	SERVER_TYPE_NGINX  = ServerType("nginx")
+	SERVER_TYPE_APACHE = ServerType("apache")
+)
+
+const (
+	defaultNginxConfigPath    = "/etc/nginx/nginx.conf"
+	defaultNginxTestCommand   = "nginx -t"
+	defaultNginxReloadCommand = "nginx -s reload"
+
+	defaultApacheConfigPath    = "/etc/apache2/apache2.conf"
+	defaultApacheTestCommand   = "apachectl configtest"
+	defaultApacheReloadCommand = "apachectl graceful"
+)
@@ -0,0 +1,231 @@
+package webserver
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+)
+
+// 解析 nginx 配置文件，返回所有启用了 SSL 证书的虚拟主机。
+func parseNginxVirtualHosts(fs xfs.FileSystem, configPath string) ([]*virtualHost, error) {
+	prefix := path.Dir(configPath)
+
+	var parse func(fs xfs.FileSystem, file string, depth int) ([]*directive, error)
+	parse = func(fs xfs.FileSystem, file string, depth int) ([]*directive, error) {
+		data, err := readConfigFile(fs, file, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		directives, err := parseNginxConfig(string(data), file)
+		if err != nil {
+			return nil, err
+		}
+
+		return expandIncludes(fs, directives, func() string { return prefix }, parse, depth)
+	}
+
+	directives, err := parse(fs, configPath, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(p string) string {
+		if p == "" || path.IsAbs(p) {
+			return p
+		}
+		return path.Join(prefix, p)
+	}
+
+	vhosts := make([]*virtualHost, 0)
+	for _, http := range directives {
+		if http.Name != "http" || http.Block == nil {
+			continue
+		}
+
+		// ssl_certificate 可在 http 块中声明，由 server 块继承
+		httpCertPath := findDirectiveArg(http.Block, "ssl_certificate")
+		httpKeyPath := findDirectiveArg(http.Block, "ssl_certificate_key")
+
+		for _, server := range http.Block {
+			if server.Name != "server" || server.Block == nil {
+				continue
+			}
+
+			vhost := &virtualHost{
+				Names:    make([]string, 0),
+				CertPath: findDirectiveArg(server.Block, "ssl_certificate"),
+				KeyPath:  findDirectiveArg(server.Block, "ssl_certificate_key"),
+				File:     server.File,
+			}
+			if vhost.CertPath == "" {
+				vhost.CertPath = httpCertPath
+			}
+			if vhost.KeyPath == "" {
+				vhost.KeyPath = httpKeyPath
+			}
+			if vhost.CertPath == "" {
+				continue
+			}
+
+			for _, d := range server.Block {
+				if d.Name == "server_name" {
+					vhost.Names = append(vhost.Names, d.Args...)
+				}
+			}
+
+			vhost.CertPath = resolve(vhost.CertPath)
+			vhost.KeyPath = resolve(vhost.KeyPath)
+			vhosts = append(vhosts, vhost)
+		}
+	}
+
+	return vhosts, nil
+}
+
+// 将 nginx 配置文本解析为指令树。
+func parseNginxConfig(content string, file string) ([]*directive, error) {
+	tokens, err := tokenizeNginxConfig(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s': %w", file, err)
+	}
+
+	pos := 0
+	directives, err := parseNginxBlock(tokens, &pos, file, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s': %w", file, err)
+	}
+
+	return directives, nil
+}
+
+type nginxToken struct {
+	Value  string
+	Quoted bool
+	Line   int
+}
+
+func parseNginxBlock(tokens []nginxToken, pos *int, file string, inBlock bool) ([]*directive, error) {
+	directives := make([]*directive, 0)
+	words := make([]string, 0)
+
+	for *pos < len(tokens) {
+		token := tokens[*pos]
+		*pos++
+
+		if token.Quoted {
+			words = append(words, token.Value)
+			continue
+		}
+
+		switch token.Value {
+		case ";":
+			if len(words) == 0 {
+				return nil, fmt.Errorf("unexpected ';' at line %d", token.Line)
+			}
+			directives = append(directives, &directive{Name: words[0], Args: words[1:], File: file})
+			words = make([]string, 0)
+
+		case "{":
+			if len(words) == 0 {
+				return nil, fmt.Errorf("unexpected '{' at line %d", token.Line)
+			}
+			block, err := parseNginxBlock(tokens, pos, file, true)
+			if err != nil {
+				return nil, err
+			}
+			directives = append(directives, &directive{Name: words[0], Args: words[1:], Block: block, File: file})
+			words = make([]string, 0)
+
+		case "}":
+			if !inBlock || len(words) > 0 {
+				return nil, fmt.Errorf("unexpected '}' at line %d", token.Line)
+			}
+			return directives, nil
+
+		default:
+			words = append(words, token.Value)
+		}
+	}
+
+	if inBlock {
+		return nil, fmt.Errorf("unexpected end of file, expecting '}'")
+	}
+	if len(words) > 0 {
+		return nil, fmt.Errorf("unexpected end of file, expecting ';'")
+	}
+
+	return directives, nil
+}
+
+func tokenizeNginxConfig(content string) ([]nginxToken, error) {
+	tokens := make([]nginxToken, 0)
+	line := 1
+
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, nginxToken{Value: word.String(), Line: line})
+			word.Reset()
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		switch {
+		case c == '\n':
+			flush()
+			line++
+
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+
+		case c == '#' && word.Len() == 0:
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			i--
+
+		case c == ';' || c == '{' || c == '}':
+			flush()
+			tokens = append(tokens, nginxToken{Value: string(c), Line: line})
+
+		case (c == '"' || c == '\'') && word.Len() == 0:
+			var quoted strings.Builder
+			startLine := line
+			closed := false
+			for i++; i < len(content); i++ {
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+					quoted.WriteByte(content[i])
+					continue
+				}
+				if content[i] == c {
+					closed = true
+					break
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				quoted.WriteByte(content[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted string at line %d", startLine)
+			}
+			tokens = append(tokens, nginxToken{Value: quoted.String(), Quoted: true, Line: startLine})
+
+		case c == '\\' && i+1 < len(content):
+			i++
+			word.WriteByte(content[i])
+
+		default:
+			word.WriteByte(c)
+		}
+	}
+	flush()
+
+	return tokens, nil
+}
@@ -0,0 +1,347 @@
+package webserver
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/certimate-go/certimate/pkg/core"
+	xcert "github.com/certimate-go/certimate/pkg/utils/cert"
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+type JumpServerConfig = xssh.JumpServerConfig
+
+type SSLDeployerProviderConfig struct {
+	// Web 服务器类型。
+	ServerType ServerType `json:"serverType"`
+	// 是否通过 SSH 部署到远程服务器。
+	// 否则部署到本地服务器。
+	UseSSH bool `json:"useSSH,omitempty"`
+	// SSH 主机。
+	// 零值时默认值 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认值 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 认证方式。
+	// 可取值 "none"、"password" 或 "key"。
+	// 零值时根据有无密码或私钥字段决定。
+	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机配置数组。
+	JumpServers []JumpServerConfig `json:"jumpServers,omitempty"`
+	// 是否回退使用 SCP。
+	UseSCP bool `json:"useSCP,omitempty"`
+	// 主配置文件路径。
+	// 零值时根据服务器类型决定，nginx 默认值 "/etc/nginx/nginx.conf"，Apache 默认值 "/etc/apache2/apache2.conf"。
+	ConfigPath string `json:"configPath,omitempty"`
+	// 虚拟主机名称数组，即 nginx 的 server_name 或 Apache 的 ServerName/ServerAlias。
+	// 零值时匹配所有被证书覆盖的虚拟主机。
+	ServerNames []string `json:"serverNames,omitempty"`
+	// 配置检查命令。
+	// 零值时根据服务器类型决定，nginx 默认值 "nginx -t"，Apache 默认值 "apachectl configtest"。
+	TestCommand string `json:"testCommand,omitempty"`
+	// 重载命令。
+	// 零值时根据服务器类型决定，nginx 默认值 "nginx -s reload"，Apache 默认值 "apachectl graceful"。
+	ReloadCommand string `json:"reloadCommand,omitempty"`
+}
+
+type SSLDeployerProvider struct {
+	config *SSLDeployerProviderConfig
+	logger *slog.Logger
+}
+
+var _ core.SSLDeployer = (*SSLDeployerProvider)(nil)
+
+func NewSSLDeployerProvider(config *SSLDeployerProviderConfig) (*SSLDeployerProvider, error) {
+	if config == nil {
+		return nil, errors.New("the configuration of the ssl deployer provider is nil")
+	}
+
+	return &SSLDeployerProvider{
+		config: config,
+		logger: slog.Default(),
+	}, nil
+}
+
+func (d *SSLDeployerProvider) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		d.logger = slog.New(slog.DiscardHandler)
+	} else {
+		d.logger = logger
+	}
+}
+
+func (d *SSLDeployerProvider) Deploy(ctx context.Context, certPEM string, privkeyPEM string) (*core.SSLDeployResult, error) {
+	configPath, testCommand, reloadCommand := d.config.ConfigPath, d.config.TestCommand, d.config.ReloadCommand
+	switch d.config.ServerType {
+	case SERVER_TYPE_NGINX:
+		if configPath == "" {
+			configPath = defaultNginxConfigPath
+		}
+		if testCommand == "" {
+			testCommand = defaultNginxTestCommand
+		}
+		if reloadCommand == "" {
+			reloadCommand = defaultNginxReloadCommand
+		}
+
+	case SERVER_TYPE_APACHE:
+		if configPath == "" {
+			configPath = defaultApacheConfigPath
+		}
+		if testCommand == "" {
+			testCommand = defaultApacheTestCommand
+		}
+		if reloadCommand == "" {
+			reloadCommand = defaultApacheReloadCommand
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported server type '%s'", d.config.ServerType)
+	}
+
+	certX509, err := xcert.ParseCertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	serverCertPEM, intermediaCertPEM, err := xcert.ExtractCertificatesFromPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract certs: %w", err)
+	}
+
+	var fs xfs.FileSystem
+	if d.config.UseSSH {
+		client, err := xssh.DialWithJumpServers(ctx, xssh.ServerConfig{
+			Host:          d.config.SshHost,
+			Port:          d.config.SshPort,
+			AuthMethod:    d.config.SshAuthMethod,
+			Username:      d.config.SshUsername,
+			Password:      d.config.SshPassword,
+			Key:           d.config.SshKey,
+			KeyPassphrase: d.config.SshKeyPassphrase,
+		}, d.config.JumpServers)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		d.logger.Info("ssh connected")
+		fs = xfs.NewSSHFileSystem(client, d.config.UseSCP)
+	} else {
+		fs = xfs.NewLocalFileSystem()
+	}
+
+	// 解析配置，查找匹配的虚拟主机
+	var vhosts []*virtualHost
+	switch d.config.ServerType {
+	case SERVER_TYPE_NGINX:
+		vhosts, err = parseNginxVirtualHosts(fs, configPath)
+	case SERVER_TYPE_APACHE:
+		vhosts, err = parseApacheVirtualHosts(fs, configPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s config: %w", d.config.ServerType, err)
+	}
+	d.logger.Info("web server config parsed", slog.String("path", configPath), slog.Int("vhosts", len(vhosts)))
+
+	matchedVhosts := make([]*virtualHost, 0)
+	for _, vhost := range vhosts {
+		if d.isVirtualHostMatched(certX509, vhost) {
+			matchedVhosts = append(matchedVhosts, vhost)
+			d.logger.Info("virtual host matched", slog.Any("names", vhost.Names), slog.String("file", vhost.File))
+		}
+	}
+	if len(matchedVhosts) == 0 {
+		return nil, errors.New("could not find any virtual hosts matching the certificate")
+	}
+
+	// 计划写入的文件
+	files, err := planOutputFiles(matchedVhosts, certPEM, serverCertPEM, intermediaCertPEM, privkeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	// 记录原有文件内容，以便配置检查失败时还原
+	previous := make(map[string][]byte, len(files))
+	for _, file := range files {
+		data, err := fs.ReadFile(file.Path)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("failed to read existing file '%s': %w", file.Path, err)
+			}
+			data = nil
+		}
+		previous[file.Path] = data
+	}
+
+	for _, file := range files {
+		if err := fs.WriteFile(file.Path, file.Data, file.Perm); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to save file '%s': %w", file.Path, err), d.restore(fs, previous))
+		}
+		d.logger.Info("ssl file saved", slog.String("path", file.Path))
+	}
+
+	// 配置检查通过后才重载服务
+	stdout, stderr, err := fs.Exec(ctx, testCommand)
+	d.logger.Debug("run test command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+	if err != nil {
+		err = fmt.Errorf("failed to pass config test (stdout: %s, stderr: %s): %w", stdout, stderr, err)
+		return nil, errors.Join(err, d.restore(fs, previous))
+	}
+
+	stdout, stderr, err = fs.Exec(ctx, reloadCommand)
+	d.logger.Debug("run reload command", slog.String("stdout", stdout), slog.String("stderr", stderr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload %s (stdout: %s, stderr: %s): %w", d.config.ServerType, stdout, stderr, err)
+	}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = file.Path
+	}
+
+	return &core.SSLDeployResult{
+		ExtendedData: map[string]any{
+			"vhosts": matchedVhosts,
+			"files":  paths,
+		},
+	}, nil
+}
+
+func (d *SSLDeployerProvider) isVirtualHostMatched(certX509 *x509.Certificate, vhost *virtualHost) bool {
+	for _, name := range vhost.Names {
+		for _, hostname := range normalizeServerName(name) {
+			if len(d.config.ServerNames) > 0 {
+				for _, serverName := range d.config.ServerNames {
+					if strings.EqualFold(serverName, hostname) {
+						return true
+					}
+				}
+			} else if isHostnameCovered(certX509, hostname) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (d *SSLDeployerProvider) restore(fs xfs.FileSystem, previous map[string][]byte) error {
+	var errs []error
+	for path, data := range previous {
+		if data == nil {
+			if err := fs.RemoveFile(path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove file '%s': %w", path, err))
+			}
+		} else {
+			if err := fs.WriteFile(path, data, 0); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore file '%s': %w", path, err))
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		d.logger.Info("ssl files restored")
+	}
+
+	return errors.Join(errs...)
+}
+
+type outputFile struct {
+	Path string
+	Data []byte
+	Perm os.FileMode
+}
+
+func planOutputFiles(vhosts []*virtualHost, certPEM, serverCertPEM, intermediaCertPEM, privkeyPEM string) ([]outputFile, error) {
+	files := make([]outputFile, 0)
+	indexes := make(map[string]int)
+
+	add := func(path string, data string, perm os.FileMode) error {
+		if strings.Contains(path, "$") {
+			return fmt.Errorf("could not write to path '%s' containing variables", path)
+		}
+
+		if i, ok := indexes[path]; ok {
+			if string(files[i].Data) != data {
+				return fmt.Errorf("conflicting contents for file '%s'", path)
+			}
+			return nil
+		}
+
+		indexes[path] = len(files)
+		files = append(files, outputFile{Path: path, Data: []byte(data), Perm: perm})
+		return nil
+	}
+
+	for _, vhost := range vhosts {
+		certData := certPEM
+		certPerm := os.FileMode(0o644)
+		if vhost.ChainPath != "" {
+			certData = serverCertPEM
+			if err := add(vhost.ChainPath, intermediaCertPEM, 0o644); err != nil {
+				return nil, err
+			}
+		}
+
+		// 未单独配置私钥文件时，私钥与证书写入同一文件
+		// 包含私钥的文件在新建时仅允许所有者读写
+		if vhost.KeyPath == "" || vhost.KeyPath == vhost.CertPath {
+			certData = strings.TrimSpace(certData) + "\n" + privkeyPEM
+			certPerm = 0o600
+		} else if err := add(vhost.KeyPath, privkeyPEM, 0o600); err != nil {
+			return nil, err
+		}
+
+		if err := add(vhost.CertPath, certData, certPerm); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// 将 server_name 规范化为主机名数组。
+// nginx 的 ".example.com" 等价于 "example.com" 和 "*.example.com"；正则表达式和无效名称将被忽略。
+func normalizeServerName(name string) []string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == "_" || name == "*" || strings.HasPrefix(name, "~") {
+		return nil
+	}
+
+	if strings.HasPrefix(name, ".") {
+		return []string{name[1:], "*" + name}
+	}
+
+	return []string{name}
+}
+
+func isHostnameCovered(certX509 *x509.Certificate, hostname string) bool {
+	// 通配符主机名要求证书中有完全相同的通配符 SAN
+	if strings.HasPrefix(hostname, "*.") {
+		for _, san := range certX509.DNSNames {
+			if strings.EqualFold(san, hostname) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return certX509.VerifyHostname(hostname) == nil
+}
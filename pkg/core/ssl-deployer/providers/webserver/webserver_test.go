@@ -0,0 +1,246 @@
+package webserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	xfs "github.com/certimate-go/certimate/pkg/utils/fs"
+)
+
+func writeTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func generateTestCertificate(t *testing.T, dnsNames ...string) (string, string) {
+	t.Helper()
+
+	privkey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privkey.PublicKey, privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privkeyDER, err := x509.MarshalECPrivateKey(privkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	privkeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privkeyDER}))
+	return certPEM, privkeyPEM
+}
+
+func TestParseNginxVirtualHosts(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(dir, "nginx.conf"), `
+user nginx;
+events { worker_connections 1024; }
+http {
+    # ssl_certificate /should/be/ignored.pem;
+    ssl_certificate_key /etc/ssl/default.key;
+    include conf.d/*.conf;
+    server {
+        listen 80;
+        server_name plain.example.com;
+    }
+}
+`)
+	writeTestFile(t, filepath.Join(dir, "conf.d", "a.conf"), `
+server {
+    listen 443 ssl;
+    server_name example.com www.example.com;
+    ssl_certificate     "/etc/ssl/example.com.pem";
+    ssl_certificate_key /etc/ssl/example.com.key;
+    location / { return 200 "ok;"; }
+}
+`)
+	writeTestFile(t, filepath.Join(dir, "conf.d", "b.conf"), `
+server {
+    listen 443 ssl;
+    server_name .example.org;
+    ssl_certificate certs/example.org.pem;
+}
+`)
+
+	vhosts, err := parseNginxVirtualHosts(xfs.NewLocalFileSystem(), filepath.Join(dir, "nginx.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vhosts) != 2 {
+		t.Fatalf("expected 2 vhosts, got %d", len(vhosts))
+	}
+
+	if !slices.Equal(vhosts[0].Names, []string{"example.com", "www.example.com"}) {
+		t.Errorf("unexpected names: %v", vhosts[0].Names)
+	}
+	if vhosts[0].CertPath != "/etc/ssl/example.com.pem" || vhosts[0].KeyPath != "/etc/ssl/example.com.key" {
+		t.Errorf("unexpected paths: %s, %s", vhosts[0].CertPath, vhosts[0].KeyPath)
+	}
+	if vhosts[1].CertPath != filepath.ToSlash(filepath.Join(dir, "certs", "example.org.pem")) {
+		t.Errorf("unexpected cert path: %s", vhosts[1].CertPath)
+	}
+	if vhosts[1].KeyPath != "/etc/ssl/default.key" {
+		t.Errorf("unexpected key path: %s", vhosts[1].KeyPath)
+	}
+}
+
+func TestParseApacheVirtualHosts(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(dir, "conf", "httpd.conf"), `
+ServerRoot "`+filepath.ToSlash(dir)+`"
+Listen 443
+IncludeOptional sites-enabled/*.conf
+IncludeOptional missing/*.conf
+`)
+	writeTestFile(t, filepath.Join(dir, "sites-enabled", "example.conf"), `
+<VirtualHost *:80>
+    ServerName example.com
+</VirtualHost>
+<IfModule mod_ssl.c>
+    <VirtualHost *:443>
+        ServerName https://example.com:443
+        ServerAlias www.example.com \
+            api.example.com
+        SSLEngine on
+        SSLCertificateFile "/etc/ssl/example.com.crt"
+        SSLCertificateKeyFile /etc/ssl/example.com.key
+        SSLCertificateChainFile ssl/chain.crt
+    </VirtualHost>
+</IfModule>
+`)
+
+	vhosts, err := parseApacheVirtualHosts(xfs.NewLocalFileSystem(), filepath.Join(dir, "conf", "httpd.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vhosts) != 1 {
+		t.Fatalf("expected 1 vhost, got %d", len(vhosts))
+	}
+
+	if !slices.Equal(vhosts[0].Names, []string{"example.com", "www.example.com", "api.example.com"}) {
+		t.Errorf("unexpected names: %v", vhosts[0].Names)
+	}
+	if vhosts[0].CertPath != "/etc/ssl/example.com.crt" || vhosts[0].KeyPath != "/etc/ssl/example.com.key" {
+		t.Errorf("unexpected paths: %s, %s", vhosts[0].CertPath, vhosts[0].KeyPath)
+	}
+	if vhosts[0].ChainPath != filepath.ToSlash(filepath.Join(dir, "ssl", "chain.crt")) {
+		t.Errorf("unexpected chain path: %s", vhosts[0].ChainPath)
+	}
+}
+
+func TestDeployLocal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on windows")
+	}
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "ssl", "example.com.pem")
+	keyPath := filepath.Join(dir, "ssl", "example.com.key")
+	otherCertPath := filepath.Join(dir, "ssl", "other.pem")
+
+	writeTestFile(t, filepath.Join(dir, "nginx.conf"), `
+http {
+    server {
+        server_name www.example.com;
+        ssl_certificate `+certPath+`;
+        ssl_certificate_key `+keyPath+`;
+    }
+    server {
+        server_name other.test;
+        ssl_certificate `+otherCertPath+`;
+        ssl_certificate_key `+otherCertPath+`;
+    }
+}
+`)
+	writeTestFile(t, certPath, "old-cert")
+
+	certPEM, privkeyPEM := generateTestCertificate(t, "example.com", "*.example.com")
+
+	t.Run("ConfigTestFailed", func(t *testing.T) {
+		provider, _ := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+			ServerType:    SERVER_TYPE_NGINX,
+			ConfigPath:    filepath.Join(dir, "nginx.conf"),
+			TestCommand:   "exit 1",
+			ReloadCommand: "touch " + filepath.Join(dir, "reloaded"),
+		})
+		if _, err := provider.Deploy(context.Background(), certPEM, privkeyPEM); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if data, _ := os.ReadFile(certPath); string(data) != "old-cert" {
+			t.Errorf("expected certificate file to be restored, got %s", string(data))
+		}
+		if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+			t.Errorf("expected private key file to be removed")
+		}
+		if _, err := os.Stat(filepath.Join(dir, "reloaded")); !os.IsNotExist(err) {
+			t.Errorf("expected reload command not to run")
+		}
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		provider, _ := NewSSLDeployerProvider(&SSLDeployerProviderConfig{
+			ServerType:    SERVER_TYPE_NGINX,
+			ConfigPath:    filepath.Join(dir, "nginx.conf"),
+			TestCommand:   "true",
+			ReloadCommand: "touch " + filepath.Join(dir, "reloaded"),
+		})
+		res, err := provider.Deploy(context.Background(), certPEM, privkeyPEM)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if data, _ := os.ReadFile(certPath); string(data) != certPEM {
+			t.Errorf("unexpected certificate file content")
+		}
+		if data, _ := os.ReadFile(keyPath); string(data) != privkeyPEM {
+			t.Errorf("unexpected private key file content")
+		}
+		if info, _ := os.Stat(certPath); info.Mode().Perm() != 0o644 {
+			t.Errorf("expected certificate file mode to be kept, got %o", info.Mode().Perm())
+		}
+		if info, _ := os.Stat(keyPath); info.Mode().Perm() != 0o600 {
+			t.Errorf("expected new private key file mode 0600, got %o", info.Mode().Perm())
+		}
+		if _, err := os.Stat(otherCertPath); !os.IsNotExist(err) {
+			t.Errorf("expected unmatched vhost not to be touched")
+		}
+		if _, err := os.Stat(filepath.Join(dir, "reloaded")); err != nil {
+			t.Errorf("expected reload command to run")
+		}
+		if files := res.ExtendedData["files"].([]string); len(files) != 2 {
+			t.Errorf("expected 2 files, got %v", files)
+		}
+	})
+}
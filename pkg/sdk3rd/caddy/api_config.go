@@ -0,0 +1,93 @@
+package caddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 读取指定路径下的配置。
+// 路径不存在时 Caddy 返回 `null`，此时返回的 JSON 也为 `null`。
+// REF: https://caddyserver.com/docs/api#get-configpath
+func (c *Client) GetConfig(path string) (json.RawMessage, error) {
+	return c.GetConfigWithContext(context.Background(), path)
+}
+
+func (c *Client) GetConfigWithContext(ctx context.Context, path string) (json.RawMessage, error) {
+	httpreq, err := c.newRequest(http.MethodGet, configPath(path))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	resp, err := c.doRequest(httpreq)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(resp.Body()), nil
+}
+
+// 在指定路径下创建配置（路径已存在时报错）。
+// REF: https://caddyserver.com/docs/api#put-configpath
+func (c *Client) PutConfig(path string, value any) error {
+	return c.PutConfigWithContext(context.Background(), path, value)
+}
+
+func (c *Client) PutConfigWithContext(ctx context.Context, path string, value any) error {
+	return c.sendConfig(ctx, http.MethodPut, path, value)
+}
+
+// 替换指定路径下已存在的配置。
+// REF: https://caddyserver.com/docs/api#patch-configpath
+func (c *Client) PatchConfig(path string, value any) error {
+	return c.PatchConfigWithContext(context.Background(), path, value)
+}
+
+func (c *Client) PatchConfigWithContext(ctx context.Context, path string, value any) error {
+	return c.sendConfig(ctx, http.MethodPatch, path, value)
+}
+
+func (c *Client) sendConfig(ctx context.Context, method string, path string, value any) error {
+	httpreq, err := c.newRequest(method, configPath(path))
+	if err != nil {
+		return err
+	} else {
+		httpreq.SetBody(value)
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
+
+// 以新配置整体替换当前运行的配置。
+// REF: https://caddyserver.com/docs/api#post-load
+func (c *Client) Load(config any) error {
+	return c.LoadWithContext(context.Background(), config)
+}
+
+func (c *Client) LoadWithContext(ctx context.Context, config any) error {
+	httpreq, err := c.newRequest(http.MethodPost, "/load")
+	if err != nil {
+		return err
+	} else {
+		httpreq.SetBody(config)
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
+
+func configPath(path string) string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "/config/"
+	}
+
+	return fmt.Sprintf("/config/%s", path)
+}
@@ -0,0 +1,116 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// 列出容器。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Container/operation/ContainerList
+func (c *Client) ListContainers(all bool, filters map[string][]string) ([]*ContainerSummary, error) {
+	return c.ListContainersWithContext(context.Background(), all, filters)
+}
+
+func (c *Client) ListContainersWithContext(ctx context.Context, all bool, filters map[string][]string) ([]*ContainerSummary, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/containers/json")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetQueryParam("all", strconv.FormatBool(all))
+		if len(filters) > 0 {
+			filtersJson, _ := json.Marshal(filters)
+			httpreq.SetQueryParam("filters", string(filtersJson))
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	result := make([]*ContainerSummary, 0)
+	if _, err := c.doRequestWithResult(httpreq, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// 向容器发送信号。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Container/operation/ContainerKill
+func (c *Client) KillContainer(containerId string, signal string) error {
+	return c.KillContainerWithContext(context.Background(), containerId, signal)
+}
+
+func (c *Client) KillContainerWithContext(ctx context.Context, containerId string, signal string) error {
+	if containerId == "" {
+		return fmt.Errorf("sdkerr: unset containerId")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/containers/%s/kill", url.PathEscape(containerId)))
+	if err != nil {
+		return err
+	} else {
+		if signal != "" {
+			httpreq.SetQueryParam("signal", signal)
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
+
+// 重启容器。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Container/operation/ContainerRestart
+func (c *Client) RestartContainer(containerId string, timeout int32) error {
+	return c.RestartContainerWithContext(context.Background(), containerId, timeout)
+}
+
+func (c *Client) RestartContainerWithContext(ctx context.Context, containerId string, timeout int32) error {
+	if containerId == "" {
+		return fmt.Errorf("sdkerr: unset containerId")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/containers/%s/restart", url.PathEscape(containerId)))
+	if err != nil {
+		return err
+	} else {
+		if timeout > 0 {
+			httpreq.SetQueryParam("t", strconv.Itoa(int(timeout)))
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
+
+// 将 tar 归档解压到容器内的指定目录。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Container/operation/PutContainerArchive
+func (c *Client) PutContainerArchive(containerId string, path string, archive []byte) error {
+	return c.PutContainerArchiveWithContext(context.Background(), containerId, path, archive)
+}
+
+func (c *Client) PutContainerArchiveWithContext(ctx context.Context, containerId string, path string, archive []byte) error {
+	if containerId == "" {
+		return fmt.Errorf("sdkerr: unset containerId")
+	}
+	if path == "" {
+		return fmt.Errorf("sdkerr: unset path")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPut, fmt.Sprintf("/containers/%s/archive", url.PathEscape(containerId)))
+	if err != nil {
+		return err
+	} else {
+		httpreq.SetQueryParam("path", path)
+		httpreq.SetHeader("Content-Type", "application/x-tar")
+		httpreq.SetBody(bytes.NewReader(archive))
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
@@ -0,0 +1,135 @@
+package docker
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// 在容器内创建执行实例。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Exec/operation/ContainerExec
+func (c *Client) CreateExec(containerId string, cmd []string) (string, error) {
+	return c.CreateExecWithContext(context.Background(), containerId, cmd)
+}
+
+func (c *Client) CreateExecWithContext(ctx context.Context, containerId string, cmd []string) (string, error) {
+	if containerId == "" {
+		return "", fmt.Errorf("sdkerr: unset containerId")
+	}
+	if len(cmd) == 0 {
+		return "", fmt.Errorf("sdkerr: unset cmd")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/containers/%s/exec", url.PathEscape(containerId)))
+	if err != nil {
+		return "", err
+	} else {
+		httpreq.SetHeader("Content-Type", "application/json")
+		httpreq.SetBody(map[string]any{
+			"AttachStdout": true,
+			"AttachStderr": true,
+			"Cmd":          cmd,
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	result := &struct {
+		Id string `json:"Id"`
+	}{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+}
+
+// 启动执行实例并等待其结束，返回标准输出和标准错误。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Exec/operation/ExecStart
+func (c *Client) StartExec(execId string) (string, string, error) {
+	return c.StartExecWithContext(context.Background(), execId)
+}
+
+func (c *Client) StartExecWithContext(ctx context.Context, execId string) (string, string, error) {
+	if execId == "" {
+		return "", "", fmt.Errorf("sdkerr: unset execId")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/exec/%s/start", url.PathEscape(execId)))
+	if err != nil {
+		return "", "", err
+	} else {
+		httpreq.SetHeader("Content-Type", "application/json")
+		httpreq.SetBody(map[string]any{
+			"Detach": false,
+			"Tty":    false,
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	resp, err := c.doRequest(httpreq)
+	if err != nil {
+		return "", "", err
+	}
+
+	stdout, stderr := demuxStream(resp.Body())
+	return stdout, stderr, nil
+}
+
+// 查询执行实例的状态。
+// REF: https://docs.docker.com/reference/api/engine/version/v1.43/#tag/Exec/operation/ExecInspect
+func (c *Client) InspectExec(execId string) (*ExecInspect, error) {
+	return c.InspectExecWithContext(context.Background(), execId)
+}
+
+func (c *Client) InspectExecWithContext(ctx context.Context, execId string) (*ExecInspect, error) {
+	if execId == "" {
+		return nil, fmt.Errorf("sdkerr: unset execId")
+	}
+
+	httpreq, err := c.newRequest(http.MethodGet, fmt.Sprintf("/exec/%s/json", url.PathEscape(execId)))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	result := &ExecInspect{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// 解析 Docker 多路复用的输出流。
+// 每一帧以 8 字节头部开始：第 1 字节为流类型（1 = stdout，2 = stderr），第 5~8 字节为大端序的负载长度。
+// 若数据不符合该格式（例如启用了 TTY），则整体视为标准输出。
+func demuxStream(data []byte) (string, string) {
+	var stdout, stderr []byte
+
+	rest := data
+	for len(rest) > 0 {
+		if len(rest) < 8 || rest[1] != 0 || rest[2] != 0 || rest[3] != 0 || rest[0] > 2 {
+			return string(data), ""
+		}
+
+		size := int(binary.BigEndian.Uint32(rest[4:8]))
+		if len(rest) < 8+size {
+			return string(data), ""
+		}
+
+		payload := rest[8 : 8+size]
+		switch rest[0] {
+		case 2:
+			stderr = append(stderr, payload...)
+		default:
+			stdout = append(stdout, payload...)
+		}
+
+		rest = rest[8+size:]
+	}
+
+	return string(stdout), string(stderr)
+}
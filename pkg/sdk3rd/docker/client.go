@@ -0,0 +1,136 @@
+package docker
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// 创建 Docker Engine API 客户端。
+// 支持 "unix:///var/run/docker.sock"、"tcp://host:2375"、"http(s)://host:port" 形式的地址。
+func NewClient(dockerHost string) (*Client, error) {
+	if dockerHost == "" {
+		return nil, fmt.Errorf("sdkerr: unset dockerHost")
+	}
+
+	hostUrl, err := url.Parse(dockerHost)
+	if err != nil {
+		return nil, fmt.Errorf("sdkerr: invalid dockerHost: %w", err)
+	}
+
+	client := resty.New().
+		SetHeader("Accept", "application/json").
+		SetHeader("User-Agent", "certimate")
+
+	switch hostUrl.Scheme {
+	case "unix":
+		socketPath := hostUrl.Path
+		client.SetBaseURL("http://docker")
+		client.SetTransport(&http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				dialer := &net.Dialer{}
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		})
+
+	case "tcp":
+		client.SetBaseURL("http://" + hostUrl.Host)
+
+	case "http", "https":
+		client.SetBaseURL(strings.TrimRight(dockerHost, "/"))
+
+	default:
+		return nil, fmt.Errorf("sdkerr: unsupported dockerHost scheme '%s'", hostUrl.Scheme)
+	}
+
+	return &Client{client}, nil
+}
+
+// 创建经由自定义拨号函数连接的 Docker Engine API 客户端，通常用于通过 SSH 隧道访问远程主机上的 Unix 套接字。
+func NewClientWithDialer(dialContext DialContextFunc) (*Client, error) {
+	if dialContext == nil {
+		return nil, fmt.Errorf("sdkerr: unset dialContext")
+	}
+
+	client := resty.New().
+		SetBaseURL("http://docker").
+		SetHeader("Accept", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetTransport(&http.Transport{
+			DialContext: dialContext,
+		})
+
+	return &Client{client}, nil
+}
+
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) SetTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) newRequest(method string, path string) (*resty.Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("sdkerr: unset method")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("sdkerr: unset path")
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	return req, nil
+}
+
+func (c *Client) doRequest(req *resty.Request) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("sdkerr: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &apiErrorResponse{}
+		if err := json.Unmarshal(resp.Body(), errResp); err == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("sdkerr: unexpected status code: %d, message: %s", resp.StatusCode(), errResp.Message)
+		}
+		return resp, fmt.Errorf("sdkerr: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) doRequestWithResult(req *resty.Request, res any) (*resty.Response, error) {
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(resp.Body()) != 0 {
+		if err := json.Unmarshal(resp.Body(), res); err != nil {
+			return resp, fmt.Errorf("sdkerr: failed to unmarshal response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
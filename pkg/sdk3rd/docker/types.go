@@ -0,0 +1,20 @@
+package docker
+
+type apiErrorResponse struct {
+	Message string `json:"message"`
+}
+
+type ContainerSummary struct {
+	Id     string            `json:"Id"`
+	Names  []string          `json:"Names"`
+	Image  string            `json:"Image"`
+	Labels map[string]string `json:"Labels"`
+	State  string            `json:"State"`
+	Status string            `json:"Status"`
+}
+
+type ExecInspect struct {
+	Id       string `json:"ID"`
+	Running  bool   `json:"Running"`
+	ExitCode int    `json:"ExitCode"`
+}
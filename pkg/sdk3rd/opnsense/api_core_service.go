@@ -0,0 +1,33 @@
+package opnsense
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type RestartServiceResponse struct {
+	apiResponseBase
+}
+
+// 重启服务。
+// REF: https://docs.opnsense.org/development/api/core/core.html
+func (c *Client) RestartService(name string) (*RestartServiceResponse, error) {
+	return c.RestartServiceWithContext(context.Background(), name)
+}
+
+func (c *Client) RestartServiceWithContext(ctx context.Context, name string) (*RestartServiceResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/core/service/restart/"+url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	result := &RestartServiceResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package opnsense
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type SearchCertificatesResponse struct {
+	apiResponseBase
+	Rows     []*CertificateRecord `json:"rows"`
+	RowCount int32                `json:"rowCount"`
+	Total    int32                `json:"total"`
+}
+
+// 搜索证书。
+// REF: https://docs.opnsense.org/development/api/core/trust.html
+func (c *Client) SearchCertificates(searchPhrase string) (*SearchCertificatesResponse, error) {
+	return c.SearchCertificatesWithContext(context.Background(), searchPhrase)
+}
+
+func (c *Client) SearchCertificatesWithContext(ctx context.Context, searchPhrase string) (*SearchCertificatesResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/trust/cert/search")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(map[string]any{
+			"current":      1,
+			"rowCount":     -1,
+			"searchPhrase": searchPhrase,
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	result := &SearchCertificatesResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type AddCertificateRequest struct {
+	Cert *CertificatePayload `json:"cert"`
+}
+
+type AddCertificateResponse struct {
+	apiResponseBase
+	Uuid string `json:"uuid,omitempty"`
+}
+
+// 添加（导入）证书。
+// REF: https://docs.opnsense.org/development/api/core/trust.html
+func (c *Client) AddCertificate(req *AddCertificateRequest) (*AddCertificateResponse, error) {
+	return c.AddCertificateWithContext(context.Background(), req)
+}
+
+func (c *Client) AddCertificateWithContext(ctx context.Context, req *AddCertificateRequest) (*AddCertificateResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/trust/cert/add")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &AddCertificateResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type SetCertificateRequest struct {
+	Cert *CertificatePayload `json:"cert"`
+}
+
+type SetCertificateResponse struct {
+	apiResponseBase
+}
+
+// 更新证书内容。引用该证书的服务保持不变。
+// REF: https://docs.opnsense.org/development/api/core/trust.html
+func (c *Client) SetCertificate(uuid string, req *SetCertificateRequest) (*SetCertificateResponse, error) {
+	return c.SetCertificateWithContext(context.Background(), uuid, req)
+}
+
+func (c *Client) SetCertificateWithContext(ctx context.Context, uuid string, req *SetCertificateRequest) (*SetCertificateResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/trust/cert/set/"+url.PathEscape(uuid))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &SetCertificateResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
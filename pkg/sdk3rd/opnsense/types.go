@@ -0,0 +1,37 @@
+package opnsense
+
+type apiResponse interface {
+	GetResult() string
+	GetValidations() map[string]any
+}
+
+type apiResponseBase struct {
+	Result      string         `json:"result,omitempty"`
+	Validations map[string]any `json:"validations,omitempty"`
+}
+
+func (r *apiResponseBase) GetResult() string {
+	return r.Result
+}
+
+func (r *apiResponseBase) GetValidations() map[string]any {
+	return r.Validations
+}
+
+var _ apiResponse = (*apiResponseBase)(nil)
+
+type CertificateRecord struct {
+	Uuid       string `json:"uuid"`
+	RefId      string `json:"refid"`
+	Descr      string `json:"descr"`
+	CommonName string `json:"commonname,omitempty"`
+	ValidFrom  string `json:"valid_from,omitempty"`
+	ValidTo    string `json:"valid_to,omitempty"`
+}
+
+type CertificatePayload struct {
+	Action     string `json:"action,omitempty"`
+	Descr      string `json:"descr"`
+	CrtPayload string `json:"crt_payload"`
+	PrvPayload string `json:"prv_payload"`
+}
@@ -0,0 +1,36 @@
+package pfsense
+
+import (
+	"context"
+	"net/http"
+)
+
+type RestartServiceResponse struct {
+	apiResponseBase
+}
+
+// 重启服务。
+// REF: https://pfrest.org/api-docs/#/STATUS/postStatusServiceEndpoint
+func (c *Client) RestartService(name string) (*RestartServiceResponse, error) {
+	return c.RestartServiceWithContext(context.Background(), name)
+}
+
+func (c *Client) RestartServiceWithContext(ctx context.Context, name string) (*RestartServiceResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/status/service")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(map[string]any{
+			"name":   name,
+			"action": "restart",
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	result := &RestartServiceResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
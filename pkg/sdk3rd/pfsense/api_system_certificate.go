@@ -0,0 +1,104 @@
+package pfsense
+
+import (
+	"context"
+	"net/http"
+)
+
+type ListCertificatesResponse struct {
+	apiResponseBase
+	Data []*CertificateInfo `json:"data"`
+}
+
+// 查询证书列表。
+// REF: https://pfrest.org/api-docs/#/SYSTEM/getSystemCertificatesEndpoint
+func (c *Client) ListCertificates(descr string) (*ListCertificatesResponse, error) {
+	return c.ListCertificatesWithContext(context.Background(), descr)
+}
+
+func (c *Client) ListCertificatesWithContext(ctx context.Context, descr string) (*ListCertificatesResponse, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/system/certificates")
+	if err != nil {
+		return nil, err
+	} else {
+		if descr != "" {
+			httpreq.SetQueryParam("descr", descr)
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	result := &ListCertificatesResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type CreateCertificateRequest struct {
+	Descr string `json:"descr"`
+	Crt   string `json:"crt"`
+	Prv   string `json:"prv"`
+}
+
+type CreateCertificateResponse struct {
+	apiResponseBase
+	Data *CertificateInfo `json:"data,omitempty"`
+}
+
+// 导入证书。
+// REF: https://pfrest.org/api-docs/#/SYSTEM/postSystemCertificateEndpoint
+func (c *Client) CreateCertificate(req *CreateCertificateRequest) (*CreateCertificateResponse, error) {
+	return c.CreateCertificateWithContext(context.Background(), req)
+}
+
+func (c *Client) CreateCertificateWithContext(ctx context.Context, req *CreateCertificateRequest) (*CreateCertificateResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/system/certificate")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &CreateCertificateResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type UpdateCertificateRequest struct {
+	Id  int64  `json:"id"`
+	Crt string `json:"crt"`
+	Prv string `json:"prv"`
+}
+
+type UpdateCertificateResponse struct {
+	apiResponseBase
+	Data *CertificateInfo `json:"data,omitempty"`
+}
+
+// 更新证书内容。引用该证书的服务保持不变。
+// REF: https://pfrest.org/api-docs/#/SYSTEM/patchSystemCertificateEndpoint
+func (c *Client) UpdateCertificate(req *UpdateCertificateRequest) (*UpdateCertificateResponse, error) {
+	return c.UpdateCertificateWithContext(context.Background(), req)
+}
+
+func (c *Client) UpdateCertificateWithContext(ctx context.Context, req *UpdateCertificateRequest) (*UpdateCertificateResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPatch, "/system/certificate")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &UpdateCertificateResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package pfsense
+
+type apiResponse interface {
+	GetCode() int32
+	GetResponseId() string
+	GetMessage() string
+}
+
+type apiResponseBase struct {
+	Code       int32  `json:"code"`
+	Status     string `json:"status"`
+	ResponseId string `json:"response_id"`
+	Message    string `json:"message"`
+}
+
+func (r *apiResponseBase) GetCode() int32 {
+	return r.Code
+}
+
+func (r *apiResponseBase) GetResponseId() string {
+	return r.ResponseId
+}
+
+func (r *apiResponseBase) GetMessage() string {
+	return r.Message
+}
+
+var _ apiResponse = (*apiResponseBase)(nil)
+
+type CertificateInfo struct {
+	Id    int64  `json:"id"`
+	RefId string `json:"refid"`
+	Descr string `json:"descr"`
+	Type  string `json:"type,omitempty"`
+	Crt   string `json:"crt,omitempty"`
+	Prv   string `json:"prv,omitempty"`
+}
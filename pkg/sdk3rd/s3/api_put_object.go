@@ -0,0 +1,45 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+// SHA-256 of an empty payload.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// 上传对象。
+// REF: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObject.html
+func (c *Client) PutObject(bucket string, key string, data []byte, contentType string) error {
+	return c.PutObjectWithContext(context.Background(), bucket, key, data, contentType)
+}
+
+func (c *Client) PutObjectWithContext(ctx context.Context, bucket string, key string, data []byte, contentType string) error {
+	if bucket == "" {
+		return fmt.Errorf("sdkerr: unset bucket")
+	}
+	if key == "" {
+		return fmt.Errorf("sdkerr: unset key")
+	}
+
+	payloadHash := sha256.Sum256(data)
+
+	httpreq, err := c.newRequest(http.MethodPut, c.objectUrl(bucket, key))
+	if err != nil {
+		return err
+	} else {
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		httpreq.SetHeader("Content-Type", contentType)
+		httpreq.SetHeader("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+		httpreq.SetBody(data)
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
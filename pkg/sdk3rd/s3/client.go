@@ -0,0 +1,126 @@
+package s3
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/go-resty/resty/v2"
+)
+
+const defaultRegion = "us-east-1"
+
+// 表示一个兼容 S3 协议的对象存储客户端（如 MinIO、Ceph RGW 等）。
+type Client struct {
+	client *resty.Client
+
+	endpoint           *url.URL
+	virtualHostedStyle bool
+}
+
+func NewClient(endpoint, accessKey, secretKey, region string) (*Client, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("sdkerr: unset endpoint")
+	}
+	if accessKey == "" {
+		return nil, fmt.Errorf("sdkerr: unset accessKey")
+	}
+	if secretKey == "" {
+		return nil, fmt.Errorf("sdkerr: unset secretKey")
+	}
+	if region == "" {
+		region = defaultRegion
+	}
+
+	endpointUrl, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("sdkerr: invalid endpoint: %w", err)
+	} else if endpointUrl.Scheme == "" || endpointUrl.Host == "" {
+		return nil, fmt.Errorf("sdkerr: invalid endpoint: %s", endpoint)
+	}
+
+	credentials := aws.Credentials{AccessKeyID: accessKey, SecretAccessKey: secretKey}
+	signer := v4.NewSigner()
+
+	client := &Client{endpoint: endpointUrl}
+	client.client = resty.New().
+		SetHeader("User-Agent", "certimate").
+		SetPreRequestHook(func(c *resty.Client, req *http.Request) error {
+			// 使用 AWS Signature Version 4 对请求签名
+			payloadHash := req.Header.Get("X-Amz-Content-Sha256")
+			if payloadHash == "" {
+				payloadHash = emptyPayloadHash
+				req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+			}
+
+			return signer.SignHTTP(req.Context(), credentials, req, payloadHash, "s3", region, time.Now().UTC())
+		})
+
+	return client, nil
+}
+
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) SetTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 设置是否使用虚拟主机风格（"https://bucket.endpoint/key"）访问存储桶。
+// 默认使用路径风格（"https://endpoint/bucket/key"）。
+func (c *Client) SetVirtualHostedStyle(enabled bool) *Client {
+	c.virtualHostedStyle = enabled
+	return c
+}
+
+func (c *Client) objectUrl(bucket, key string) string {
+	key = strings.TrimLeft(key, "/")
+
+	u := *c.endpoint
+	u.RawPath = ""
+	if c.virtualHostedStyle {
+		u.Host = bucket + "." + u.Host
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + key
+	} else {
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + bucket + "/" + key
+	}
+
+	return u.String()
+}
+
+func (c *Client) newRequest(method string, url string) (*resty.Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("sdkerr: unset method")
+	}
+	if url == "" {
+		return nil, fmt.Errorf("sdkerr: unset url")
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = url
+	return req, nil
+}
+
+func (c *Client) doRequest(req *resty.Request) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("sdkerr: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("sdkerr: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package synology
+
+import (
+	"context"
+	"net/http"
+)
+
+type LoginResponse struct {
+	apiResponseBase
+	Data *struct {
+		Sid       string `json:"sid"`
+		SynoToken string `json:"synotoken"`
+	} `json:"data,omitempty"`
+}
+
+// 登录并保存会话，后续请求将自动携带会话信息。
+// REF: https://global.download.synology.com/download/Document/Software/DeveloperGuide/Os/DSM/All/enu/DSM_Login_Web_API_Guide_enu.pdf
+func (c *Client) Login() (*LoginResponse, error) {
+	return c.LoginWithContext(context.Background())
+}
+
+func (c *Client) LoginWithContext(ctx context.Context) (*LoginResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/auth.cgi", "SYNO.API.Auth", 6, "login")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetFormData(map[string]string{
+			"account":           c.username,
+			"passwd":            c.password,
+			"session":           "Certimate",
+			"format":            "sid",
+			"enable_syno_token": "yes",
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	result := &LoginResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	if result.Data != nil {
+		c.sessionMtx.Lock()
+		c.sid = result.Data.Sid
+		c.synoToken = result.Data.SynoToken
+		c.sessionMtx.Unlock()
+	}
+
+	return result, nil
+}
+
+type LogoutResponse struct {
+	apiResponseBase
+}
+
+// 注销当前会话。
+func (c *Client) Logout() (*LogoutResponse, error) {
+	return c.LogoutWithContext(context.Background())
+}
+
+func (c *Client) LogoutWithContext(ctx context.Context) (*LogoutResponse, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/auth.cgi", "SYNO.API.Auth", 6, "logout")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetQueryParam("session", "Certimate")
+		httpreq.SetContext(ctx)
+	}
+
+	result := &LogoutResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	c.sessionMtx.Lock()
+	c.sid = ""
+	c.synoToken = ""
+	c.sessionMtx.Unlock()
+
+	return result, nil
+}
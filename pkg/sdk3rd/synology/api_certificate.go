@@ -0,0 +1,95 @@
+package synology
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type ListCertificatesResponse struct {
+	apiResponseBase
+	Data *struct {
+		Certificates []*CertificateInfo `json:"certificates"`
+	} `json:"data,omitempty"`
+}
+
+// 列出证书。
+func (c *Client) ListCertificates() (*ListCertificatesResponse, error) {
+	return c.ListCertificatesWithContext(context.Background())
+}
+
+func (c *Client) ListCertificatesWithContext(ctx context.Context) (*ListCertificatesResponse, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/entry.cgi", "SYNO.Core.Certificate.CRT", 1, "list")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	result := &ListCertificatesResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type ImportCertificateRequest struct {
+	// 待替换的证书 ID。零值时新建证书。
+	Id string `json:"id"`
+	// 证书描述。
+	Desc string `json:"desc"`
+	// 是否设为默认证书。
+	AsDefault bool `json:"as_default"`
+	// 证书 PEM 内容（服务器证书）。
+	Certificate string `json:"-"`
+	// 中间证书 PEM 内容。
+	IntermediateCertificate string `json:"-"`
+	// 私钥 PEM 内容。
+	PrivateKey string `json:"-"`
+}
+
+type ImportCertificateResponse struct {
+	apiResponseBase
+	Data *struct {
+		Id           string `json:"id"`
+		RestartHttpd bool   `json:"restart_httpd"`
+	} `json:"data,omitempty"`
+}
+
+// 导入证书。
+// 指定证书 ID 时替换已有证书，否则新建证书。
+func (c *Client) ImportCertificate(req *ImportCertificateRequest) (*ImportCertificateResponse, error) {
+	return c.ImportCertificateWithContext(context.Background(), req)
+}
+
+func (c *Client) ImportCertificateWithContext(ctx context.Context, req *ImportCertificateRequest) (*ImportCertificateResponse, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/entry.cgi", "SYNO.Core.Certificate", 1, "import")
+	if err != nil {
+		return nil, err
+	} else {
+		asDefault := ""
+		if req.AsDefault {
+			asDefault = "true"
+		}
+
+		httpreq.SetMultipartFormData(map[string]string{
+			"id":         req.Id,
+			"desc":       req.Desc,
+			"as_default": asDefault,
+		})
+		httpreq.SetFileReader("key", "privkey.pem", strings.NewReader(req.PrivateKey))
+		httpreq.SetFileReader("cert", "cert.pem", strings.NewReader(req.Certificate))
+		if req.IntermediateCertificate != "" {
+			httpreq.SetFileReader("inter_cert", "chain.pem", strings.NewReader(req.IntermediateCertificate))
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	result := &ImportCertificateResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package synology
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+
+	username string
+	password string
+
+	sessionMtx sync.Mutex
+	sid        string
+	synoToken  string
+}
+
+func NewClient(serverUrl, username, password string) (*Client, error) {
+	if serverUrl == "" {
+		return nil, fmt.Errorf("sdkerr: unset serverUrl")
+	}
+	if _, err := url.Parse(serverUrl); err != nil {
+		return nil, fmt.Errorf("sdkerr: invalid serverUrl: %w", err)
+	}
+	if username == "" {
+		return nil, fmt.Errorf("sdkerr: unset username")
+	}
+	if password == "" {
+		return nil, fmt.Errorf("sdkerr: unset password")
+	}
+
+	client := &Client{
+		username: username,
+		password: password,
+	}
+	client.client = resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/webapi").
+		SetHeader("Accept", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetPreRequestHook(func(c *resty.Client, req *http.Request) error {
+			client.sessionMtx.Lock()
+			sid, synoToken := client.sid, client.synoToken
+			client.sessionMtx.Unlock()
+
+			if sid != "" {
+				query := req.URL.Query()
+				query.Set("_sid", sid)
+				req.URL.RawQuery = query.Encode()
+			}
+			if synoToken != "" {
+				req.Header.Set("X-SYNO-TOKEN", synoToken)
+			}
+
+			return nil
+		})
+
+	return client, nil
+}
+
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) SetTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) newRequest(method string, path string, api string, version int, apiMethod string) (*resty.Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("sdkerr: unset method")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("sdkerr: unset path")
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	req.SetQueryParam("api", api)
+	req.SetQueryParam("version", fmt.Sprintf("%d", version))
+	req.SetQueryParam("method", apiMethod)
+	return req, nil
+}
+
+func (c *Client) doRequest(req *resty.Request) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	// WARN:
+	//   PLEASE DO NOT USE `req.SetResult` or `req.SetError` HERE! USE `doRequestWithResult` INSTEAD.
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("sdkerr: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("sdkerr: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) doRequestWithResult(req *resty.Request, res apiResponse) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		if resp != nil {
+			json.Unmarshal(resp.Body(), &res)
+		}
+		return resp, err
+	}
+
+	if len(resp.Body()) != 0 {
+		if err := json.Unmarshal(resp.Body(), &res); err != nil {
+			return resp, fmt.Errorf("sdkerr: failed to unmarshal response: %w", err)
+		} else if !res.IsSuccess() {
+			return resp, fmt.Errorf("sdkerr: api error: code='%d'", res.GetErrorCode())
+		}
+	}
+
+	return resp, nil
+}
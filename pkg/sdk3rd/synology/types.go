@@ -0,0 +1,42 @@
+package synology
+
+type apiResponse interface {
+	IsSuccess() bool
+	GetErrorCode() int32
+}
+
+type apiResponseBase struct {
+	Success bool `json:"success"`
+	Error   *struct {
+		Code int32 `json:"code"`
+	} `json:"error,omitempty"`
+}
+
+func (r *apiResponseBase) IsSuccess() bool {
+	return r.Success
+}
+
+func (r *apiResponseBase) GetErrorCode() int32 {
+	if r.Error == nil {
+		return 0
+	}
+
+	return r.Error.Code
+}
+
+var _ apiResponse = (*apiResponseBase)(nil)
+
+type CertificateSubject struct {
+	CommonName string   `json:"common_name"`
+	SubAltName []string `json:"sub_alt_name,omitempty"`
+}
+
+type CertificateInfo struct {
+	Id        string              `json:"id"`
+	Desc      string              `json:"desc"`
+	IsDefault bool                `json:"is_default"`
+	IsBroken  bool                `json:"is_broken"`
+	ValidFrom string              `json:"valid_from"`
+	ValidTill string              `json:"valid_till"`
+	Subject   *CertificateSubject `json:"subject,omitempty"`
+}
@@ -0,0 +1,61 @@
+package truenas
+
+import (
+	"context"
+	"net/http"
+)
+
+// 查询证书列表。
+// REF: https://www.truenas.com/docs/api/scale_rest_api.html#/certificate/get_certificate
+func (c *Client) QueryCertificates(name string) ([]*CertificateInfo, error) {
+	return c.QueryCertificatesWithContext(context.Background(), name)
+}
+
+func (c *Client) QueryCertificatesWithContext(ctx context.Context, name string) ([]*CertificateInfo, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/certificate")
+	if err != nil {
+		return nil, err
+	} else {
+		if name != "" {
+			httpreq.SetQueryParam("name", name)
+		}
+		httpreq.SetContext(ctx)
+	}
+
+	result := make([]*CertificateInfo, 0)
+	if _, err := c.doRequestWithResult(httpreq, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+type CreateCertificateRequest struct {
+	CreateType  string `json:"create_type"`
+	Name        string `json:"name"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privatekey"`
+}
+
+// 创建证书，返回异步任务 ID。
+// REF: https://www.truenas.com/docs/api/scale_rest_api.html#/certificate/post_certificate
+func (c *Client) CreateCertificate(req *CreateCertificateRequest) (int64, error) {
+	return c.CreateCertificateWithContext(context.Background(), req)
+}
+
+func (c *Client) CreateCertificateWithContext(ctx context.Context, req *CreateCertificateRequest) (int64, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/certificate")
+	if err != nil {
+		return 0, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	var jobId int64
+	if _, err := c.doRequestWithResult(httpreq, &jobId); err != nil {
+		return 0, err
+	}
+
+	return jobId, nil
+}
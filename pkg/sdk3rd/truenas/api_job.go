@@ -0,0 +1,33 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// 查询异步任务。
+// REF: https://www.truenas.com/docs/api/scale_rest_api.html#/core/get_core_get_jobs
+func (c *Client) GetJob(jobId int64) (*JobInfo, error) {
+	return c.GetJobWithContext(context.Background(), jobId)
+}
+
+func (c *Client) GetJobWithContext(ctx context.Context, jobId int64) (*JobInfo, error) {
+	httpreq, err := c.newRequest(http.MethodGet, "/core/get_jobs")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetQueryParam("id", fmt.Sprintf("%d", jobId))
+		httpreq.SetContext(ctx)
+	}
+
+	result := make([]*JobInfo, 0)
+	if _, err := c.doRequestWithResult(httpreq, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("sdkerr: job #%d not found", jobId)
+	}
+
+	return result[0], nil
+}
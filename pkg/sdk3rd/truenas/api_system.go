@@ -0,0 +1,47 @@
+package truenas
+
+import (
+	"context"
+	"net/http"
+)
+
+type UpdateSystemGeneralRequest struct {
+	UICertificate *int64 `json:"ui_certificate,omitempty"`
+}
+
+// 更新系统通用设置。
+// REF: https://www.truenas.com/docs/api/scale_rest_api.html#/system%2Fgeneral/put_system_general
+func (c *Client) UpdateSystemGeneral(req *UpdateSystemGeneralRequest) error {
+	return c.UpdateSystemGeneralWithContext(context.Background(), req)
+}
+
+func (c *Client) UpdateSystemGeneralWithContext(ctx context.Context, req *UpdateSystemGeneralRequest) error {
+	httpreq, err := c.newRequest(http.MethodPut, "/system/general")
+	if err != nil {
+		return err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
+
+// 重启 Web UI 服务，使新证书生效。
+// REF: https://www.truenas.com/docs/api/scale_rest_api.html#/system%2Fgeneral/post_system_general_ui_restart
+func (c *Client) RestartUI() error {
+	return c.RestartUIWithContext(context.Background())
+}
+
+func (c *Client) RestartUIWithContext(ctx context.Context) error {
+	httpreq, err := c.newRequest(http.MethodPost, "/system/general/ui_restart")
+	if err != nil {
+		return err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	_, err = c.doRequest(httpreq)
+	return err
+}
@@ -0,0 +1,30 @@
+package truenas
+
+type CertificateInfo struct {
+	Id          int64    `json:"id"`
+	Name        string   `json:"name"`
+	CommonName  string   `json:"common"`
+	SAN         []string `json:"san,omitempty"`
+	From        string   `json:"from"`
+	Until       string   `json:"until"`
+	Fingerprint string   `json:"fingerprint"`
+}
+
+type JobInfo struct {
+	Id       int64  `json:"id"`
+	Method   string `json:"method"`
+	State    string `json:"state"`
+	Error    string `json:"error,omitempty"`
+	Progress *struct {
+		Percent     float64 `json:"percent"`
+		Description string  `json:"description"`
+	} `json:"progress,omitempty"`
+}
+
+const (
+	JobStateWaiting = "WAITING"
+	JobStateRunning = "RUNNING"
+	JobStateSuccess = "SUCCESS"
+	JobStateFailed  = "FAILED"
+	JobStateAborted = "ABORTED"
+)
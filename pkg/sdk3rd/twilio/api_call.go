@@ -0,0 +1,39 @@
+package twilio
+
+import (
+	"context"
+	"net/http"
+)
+
+type CreateCallRequest struct {
+	To    string
+	From  string
+	Twiml string
+}
+
+// 发起语音呼叫。
+// REF: https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+func (c *Client) CreateCall(req *CreateCallRequest) (*CallInfo, error) {
+	return c.CreateCallWithContext(context.Background(), req)
+}
+
+func (c *Client) CreateCallWithContext(ctx context.Context, req *CreateCallRequest) (*CallInfo, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/Calls.json")
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetFormData(map[string]string{
+			"To":    req.To,
+			"From":  req.From,
+			"Twiml": req.Twiml,
+		})
+		httpreq.SetContext(ctx)
+	}
+
+	result := &CallInfo{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
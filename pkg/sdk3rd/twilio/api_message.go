@@ -0,0 +1,54 @@
+package twilio
+
+import (
+	"context"
+	"net/http"
+)
+
+type CreateMessageRequest struct {
+	To                  string
+	From                string
+	MessagingServiceSid string
+	Body                string
+	ContentSid          string
+	ContentVariables    string
+}
+
+// 发送短信。
+// REF: https://www.twilio.com/docs/messaging/api/message-resource#create-a-message-resource
+func (c *Client) CreateMessage(req *CreateMessageRequest) (*MessageInfo, error) {
+	return c.CreateMessageWithContext(context.Background(), req)
+}
+
+func (c *Client) CreateMessageWithContext(ctx context.Context, req *CreateMessageRequest) (*MessageInfo, error) {
+	httpreq, err := c.newRequest(http.MethodPost, "/Messages.json")
+	if err != nil {
+		return nil, err
+	} else {
+		formData := map[string]string{"To": req.To}
+		if req.From != "" {
+			formData["From"] = req.From
+		}
+		if req.MessagingServiceSid != "" {
+			formData["MessagingServiceSid"] = req.MessagingServiceSid
+		}
+		if req.ContentSid != "" {
+			formData["ContentSid"] = req.ContentSid
+			if req.ContentVariables != "" {
+				formData["ContentVariables"] = req.ContentVariables
+			}
+		} else {
+			formData["Body"] = req.Body
+		}
+
+		httpreq.SetFormData(formData)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &MessageInfo{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
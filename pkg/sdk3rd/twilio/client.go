@@ -0,0 +1,100 @@
+package twilio
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+func NewClient(accountSid, authToken string) (*Client, error) {
+	if accountSid == "" {
+		return nil, fmt.Errorf("sdkerr: unset accountSid")
+	}
+	if authToken == "" {
+		return nil, fmt.Errorf("sdkerr: unset authToken")
+	}
+
+	client := resty.New().
+		SetBaseURL("https://api.twilio.com/2010-04-01/Accounts/"+url.PathEscape(accountSid)).
+		SetHeader("Accept", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetBasicAuth(accountSid, authToken)
+
+	return &Client{client}, nil
+}
+
+func (c *Client) SetBaseURL(baseUrl string) *Client {
+	c.client.SetBaseURL(strings.TrimRight(baseUrl, "/"))
+	return c
+}
+
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) SetTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) newRequest(method string, path string) (*resty.Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("sdkerr: unset method")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("sdkerr: unset path")
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	return req, nil
+}
+
+func (c *Client) doRequest(req *resty.Request) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("sdkerr: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &apiErrorResponse{}
+		if err := json.Unmarshal(resp.Body(), errResp); err == nil && errResp.Code != 0 {
+			return resp, fmt.Errorf("sdkerr: code='%d', message='%s'", errResp.Code, errResp.Message)
+		}
+		return resp, fmt.Errorf("sdkerr: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) doRequestWithResult(req *resty.Request, res any) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(resp.Body()) != 0 {
+		if err := json.Unmarshal(resp.Body(), res); err != nil {
+			return resp, fmt.Errorf("sdkerr: failed to unmarshal response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,24 @@
+package twilio
+
+type apiErrorResponse struct {
+	Code     int32  `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+	Status   int32  `json:"status"`
+}
+
+type MessageInfo struct {
+	Sid          string  `json:"sid"`
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	Status       string  `json:"status"`
+	ErrorCode    *int32  `json:"error_code"`
+	ErrorMessage *string `json:"error_message"`
+}
+
+type CallInfo struct {
+	Sid    string `json:"sid"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status string `json:"status"`
+}
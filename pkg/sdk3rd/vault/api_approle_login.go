@@ -0,0 +1,48 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type AppRoleLoginRequest struct {
+	RoleId   string `json:"role_id"`
+	SecretId string `json:"secret_id,omitempty"`
+}
+
+type AppRoleLoginResponse struct {
+	apiResponseBase
+	Auth *AuthInfo `json:"auth,omitempty"`
+}
+
+func (c *Client) AppRoleLogin(mountPath string, req *AppRoleLoginRequest) (*AppRoleLoginResponse, error) {
+	return c.AppRoleLoginWithContext(context.Background(), mountPath, req)
+}
+
+func (c *Client) AppRoleLoginWithContext(ctx context.Context, mountPath string, req *AppRoleLoginRequest) (*AppRoleLoginResponse, error) {
+	if mountPath == "" {
+		mountPath = "approle"
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/auth/%s/login", strings.Trim(mountPath, "/")))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &AppRoleLoginResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	if result.Auth == nil || result.Auth.ClientToken == "" {
+		return result, fmt.Errorf("sdkerr: approle login succeeded but no client token returned")
+	}
+
+	c.SetToken(result.Auth.ClientToken)
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 密钥不存在时返回的错误。
+var ErrSecretNotFound = errors.New("sdkerr: secret not found")
+
+type KVReadRequest struct {
+	// KV 引擎版本。可取值 1、2，零值时默认为 2。
+	Version int32
+}
+
+type KVReadResponse struct {
+	apiResponseBase
+	Data map[string]any `json:"data,omitempty"`
+}
+
+func (c *Client) KVRead(mountPath string, secretPath string, req *KVReadRequest) (*KVReadResponse, error) {
+	return c.KVReadWithContext(context.Background(), mountPath, secretPath, req)
+}
+
+func (c *Client) KVReadWithContext(ctx context.Context, mountPath string, secretPath string, req *KVReadRequest) (*KVReadResponse, error) {
+	if mountPath == "" {
+		return nil, fmt.Errorf("sdkerr: unset mountPath")
+	}
+	if secretPath == "" {
+		return nil, fmt.Errorf("sdkerr: unset secretPath")
+	}
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	mountPath = strings.Trim(mountPath, "/")
+	secretPath = strings.Trim(secretPath, "/")
+
+	var path string
+	switch req.Version {
+	case 1:
+		path = fmt.Sprintf("/%s/%s", mountPath, secretPath)
+
+	case 0, 2:
+		path = fmt.Sprintf("/%s/data/%s", mountPath, secretPath)
+
+	default:
+		return nil, fmt.Errorf("sdkerr: unsupported kv version: %d", req.Version)
+	}
+
+	httpreq, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetContext(ctx)
+	}
+
+	result := &KVReadResponse{}
+	if resp, err := c.doRequestWithResult(httpreq, result); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return result, ErrSecretNotFound
+		}
+		return result, err
+	}
+
+	// KV v2 的响应中，键值对位于 `data.data` 中
+	if req.Version != 1 && result.Data != nil {
+		if data, ok := result.Data["data"].(map[string]any); ok {
+			result.Data = data
+		}
+	}
+
+	return result, nil
+}
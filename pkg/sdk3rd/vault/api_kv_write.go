@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type KVWriteRequest struct {
+	// KV 引擎版本。可取值 1、2，零值时默认为 2。
+	Version int32
+	// 要写入的键值对。
+	Data map[string]any
+}
+
+type KVWriteResponse struct {
+	apiResponseBase
+	Data *struct {
+		CreatedTime  string `json:"created_time,omitempty"`
+		DeletionTime string `json:"deletion_time,omitempty"`
+		Destroyed    bool   `json:"destroyed,omitempty"`
+		Version      int64  `json:"version,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+func (c *Client) KVWrite(mountPath string, secretPath string, req *KVWriteRequest) (*KVWriteResponse, error) {
+	return c.KVWriteWithContext(context.Background(), mountPath, secretPath, req)
+}
+
+func (c *Client) KVWriteWithContext(ctx context.Context, mountPath string, secretPath string, req *KVWriteRequest) (*KVWriteResponse, error) {
+	if mountPath == "" {
+		return nil, fmt.Errorf("sdkerr: unset mountPath")
+	}
+	if secretPath == "" {
+		return nil, fmt.Errorf("sdkerr: unset secretPath")
+	}
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	mountPath = strings.Trim(mountPath, "/")
+	secretPath = strings.Trim(secretPath, "/")
+
+	var path string
+	var body any
+	switch req.Version {
+	case 1:
+		path = fmt.Sprintf("/%s/%s", mountPath, secretPath)
+		body = req.Data
+
+	case 0, 2:
+		path = fmt.Sprintf("/%s/data/%s", mountPath, secretPath)
+		body = map[string]any{"data": req.Data}
+
+	default:
+		return nil, fmt.Errorf("sdkerr: unsupported kv version: %d", req.Version)
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(body)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &KVWriteResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type PKISignRequest struct {
+	Csr               string `json:"csr"`
+	CommonName        string `json:"common_name,omitempty"`
+	AltNames          string `json:"alt_names,omitempty"`
+	IPSans            string `json:"ip_sans,omitempty"`
+	TTL               string `json:"ttl,omitempty"`
+	Format            string `json:"format,omitempty"`
+	ExcludeCNFromSans bool   `json:"exclude_cn_from_sans,omitempty"`
+}
+
+type PKISignResponse struct {
+	apiResponseBase
+	Data *struct {
+		Certificate    string   `json:"certificate"`
+		IssuingCA      string   `json:"issuing_ca"`
+		CAChain        []string `json:"ca_chain,omitempty"`
+		SerialNumber   string   `json:"serial_number"`
+		Expiration     int64    `json:"expiration"`
+		PrivateKey     string   `json:"private_key,omitempty"`
+		PrivateKeyType string   `json:"private_key_type,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+func (c *Client) PKISign(mountPath string, roleName string, req *PKISignRequest) (*PKISignResponse, error) {
+	return c.PKISignWithContext(context.Background(), mountPath, roleName, req)
+}
+
+func (c *Client) PKISignWithContext(ctx context.Context, mountPath string, roleName string, req *PKISignRequest) (*PKISignResponse, error) {
+	if mountPath == "" {
+		mountPath = "pki"
+	}
+	if roleName == "" {
+		return nil, fmt.Errorf("sdkerr: unset roleName")
+	}
+
+	httpreq, err := c.newRequest(http.MethodPost, fmt.Sprintf("/%s/sign/%s", strings.Trim(mountPath, "/"), roleName))
+	if err != nil {
+		return nil, err
+	} else {
+		httpreq.SetBody(req)
+		httpreq.SetContext(ctx)
+	}
+
+	result := &PKISignResponse{}
+	if _, err := c.doRequestWithResult(httpreq, result); err != nil {
+		return result, err
+	}
+
+	if result.Data == nil || result.Data.Certificate == "" {
+		return result, fmt.Errorf("sdkerr: pki sign succeeded but no certificate returned")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+)
+
+const (
+	AUTH_METHOD_TOKEN   = "token"
+	AUTH_METHOD_APPROLE = "approle"
+)
+
+type ClientOptions struct {
+	// Vault 服务地址。
+	ServerUrl string
+	// Vault 命名空间（仅企业版）。
+	Namespace string
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool
+}
+
+type AuthOptions struct {
+	// 认证方式。
+	// 可取值 [AUTH_METHOD_TOKEN]、[AUTH_METHOD_APPROLE]。零值时默认值 [AUTH_METHOD_TOKEN]。
+	Method string
+	// Vault Token。
+	Token string
+	// AppRole 认证挂载路径。
+	// 零值时默认值 "approle"。
+	AppRoleMountPath string
+	// AppRole RoleID。
+	AppRoleRoleId string
+	// AppRole SecretID。
+	AppRoleSecretId string
+}
+
+// 按选项创建客户端，设置命名空间与 TLS 配置，但不进行认证。
+func NewClientWithOptions(options *ClientOptions) (*Client, error) {
+	if options == nil {
+		return nil, fmt.Errorf("sdkerr: nil options")
+	}
+
+	client, err := NewClient(options.ServerUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Namespace != "" {
+		client.SetNamespace(options.Namespace)
+	}
+
+	if options.AllowInsecureConnections {
+		client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
+
+// 按认证方式完成认证，认证成功后客户端将携带相应的令牌。
+func (c *Client) Login(ctx context.Context, options *AuthOptions) error {
+	if options == nil {
+		return fmt.Errorf("sdkerr: nil options")
+	}
+
+	switch options.Method {
+	case "", AUTH_METHOD_TOKEN:
+		if options.Token == "" {
+			return fmt.Errorf("sdkerr: unset token")
+		}
+
+		c.SetToken(options.Token)
+
+	case AUTH_METHOD_APPROLE:
+		if options.AppRoleRoleId == "" {
+			return fmt.Errorf("sdkerr: unset appRoleRoleId")
+		}
+
+		if _, err := c.AppRoleLoginWithContext(ctx, options.AppRoleMountPath, &AppRoleLoginRequest{
+			RoleId:   options.AppRoleRoleId,
+			SecretId: options.AppRoleSecretId,
+		}); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("sdkerr: unsupported auth method '%s'", options.Method)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+
+	token   string
+	tokenMu sync.RWMutex
+}
+
+func NewClient(serverUrl string) (*Client, error) {
+	if serverUrl == "" {
+		return nil, fmt.Errorf("sdkerr: unset serverUrl")
+	}
+	if _, err := url.Parse(serverUrl); err != nil {
+		return nil, fmt.Errorf("sdkerr: invalid serverUrl: %w", err)
+	}
+
+	client := &Client{}
+	client.client = resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/v1").
+		SetHeader("Accept", "application/json").
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetPreRequestHook(func(c *resty.Client, req *http.Request) error {
+			client.tokenMu.RLock()
+			token := client.token
+			client.tokenMu.RUnlock()
+
+			if token != "" {
+				req.Header.Set("X-Vault-Token", token)
+			}
+
+			return nil
+		})
+
+	return client, nil
+}
+
+func (c *Client) SetToken(token string) *Client {
+	c.tokenMu.Lock()
+	c.token = token
+	c.tokenMu.Unlock()
+	return c
+}
+
+func (c *Client) SetNamespace(namespace string) *Client {
+	if namespace == "" {
+		c.client.Header.Del("X-Vault-Namespace")
+	} else {
+		c.client.SetHeader("X-Vault-Namespace", namespace)
+	}
+	return c
+}
+
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) SetTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) newRequest(method string, path string) (*resty.Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("sdkerr: unset method")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("sdkerr: unset path")
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	return req, nil
+}
+
+func (c *Client) doRequest(req *resty.Request) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	// WARN:
+	//   PLEASE DO NOT USE `req.SetResult` or `req.SetError` HERE! USE `doRequestWithResult` INSTEAD.
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("sdkerr: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("sdkerr: unexpected status code: %d, resp: %s", resp.StatusCode(), resp.String())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) doRequestWithResult(req *resty.Request, res apiResponse) (*resty.Response, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sdkerr: nil request")
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		if resp != nil {
+			json.Unmarshal(resp.Body(), &res)
+			if errs := res.GetErrors(); len(errs) > 0 {
+				return resp, fmt.Errorf("sdkerr: api error: status='%d', errors='%s'", resp.StatusCode(), strings.Join(errs, "; "))
+			}
+		}
+		return resp, err
+	}
+
+	if len(resp.Body()) != 0 {
+		if err := json.Unmarshal(resp.Body(), &res); err != nil {
+			return resp, fmt.Errorf("sdkerr: failed to unmarshal response: %w", err)
+		} else if errs := res.GetErrors(); len(errs) > 0 {
+			return resp, fmt.Errorf("sdkerr: api error: errors='%s'", strings.Join(errs, "; "))
+		}
+	}
+
+	return resp, nil
+}
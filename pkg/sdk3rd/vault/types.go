@@ -0,0 +1,30 @@
+package vault
+
+type apiResponse interface {
+	GetErrors() []string
+	GetWarnings() []string
+}
+
+type apiResponseBase struct {
+	RequestId string   `json:"request_id,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+func (r *apiResponseBase) GetErrors() []string {
+	return r.Errors
+}
+
+func (r *apiResponseBase) GetWarnings() []string {
+	return r.Warnings
+}
+
+var _ apiResponse = (*apiResponseBase)(nil)
+
+type AuthInfo struct {
+	ClientToken   string   `json:"client_token"`
+	Accessor      string   `json:"accessor"`
+	Policies      []string `json:"policies"`
+	LeaseDuration int64    `json:"lease_duration"`
+	Renewable     bool     `json:"renewable"`
+}
@@ -0,0 +1,110 @@
+package cert
+
+import (
+	"errors"
+	"fmt"
+)
+
+type OutputFormatType string
+
+const (
+	OUTPUT_FORMAT_PEM = OutputFormatType("PEM")
+	OUTPUT_FORMAT_PFX = OutputFormatType("PFX")
+	OUTPUT_FORMAT_JKS = OutputFormatType("JKS")
+)
+
+// 表示证书输出选项的数据结构。
+type OutputOptions struct {
+	// 输出证书格式。
+	// 零值时默认值 [OUTPUT_FORMAT_PEM]。
+	Format OutputFormatType
+	// 输出证书文件路径。
+	CertPath string
+	// 输出服务器证书文件路径。
+	// 选填，仅 PEM 格式有效。
+	ServerCertPath string
+	// 输出中间证书文件路径。
+	// 选填，仅 PEM 格式有效。
+	IntermediaCertPath string
+	// 输出私钥文件路径。
+	// 证书格式为 PEM 时必填。
+	KeyPath string
+	// PFX 导出密码。
+	PfxPassword string
+	// JKS 别名。
+	JksAlias string
+	// JKS 密钥密码。
+	JksKeypass string
+	// JKS 存储密码。
+	JksStorepass string
+}
+
+// 表示一个待输出的证书文件。
+type OutputFile struct {
+	// 文件描述，如 "ssl certificate"、"ssl private key"。
+	Name string
+	// 文件路径。
+	Path string
+	// 文件数据。
+	Data []byte
+}
+
+// 按输出选项生成待输出的证书文件。
+// PEM 格式输出证书（及可选的服务器证书、中间证书）与私钥文件；PFX、JKS 格式仅输出单个证书文件。
+//
+// 入参:
+//   - certPEM: 证书 PEM 内容。
+//   - privkeyPEM: 私钥 PEM 内容。
+//   - options: 输出选项。
+//
+// 出参:
+//   - files: 待输出的证书文件数组。
+//   - err: 错误。
+func BuildOutputFiles(certPEM string, privkeyPEM string, options OutputOptions) (_files []OutputFile, _err error) {
+	if options.CertPath == "" {
+		return nil, errors.New("output certificate path is required")
+	}
+
+	files := make([]OutputFile, 0)
+	switch options.Format {
+	case "", OUTPUT_FORMAT_PEM:
+		if options.KeyPath == "" {
+			return nil, errors.New("output private key path is required")
+		}
+
+		serverCertPEM, intermediaCertPEM, err := ExtractCertificatesFromPEM(certPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract certs: %w", err)
+		}
+
+		files = append(files, OutputFile{Name: "ssl certificate", Path: options.CertPath, Data: []byte(certPEM)})
+		if options.ServerCertPath != "" {
+			files = append(files, OutputFile{Name: "ssl server certificate", Path: options.ServerCertPath, Data: []byte(serverCertPEM)})
+		}
+		if options.IntermediaCertPath != "" {
+			files = append(files, OutputFile{Name: "ssl intermedia certificate", Path: options.IntermediaCertPath, Data: []byte(intermediaCertPEM)})
+		}
+		files = append(files, OutputFile{Name: "ssl private key", Path: options.KeyPath, Data: []byte(privkeyPEM)})
+
+	case OUTPUT_FORMAT_PFX:
+		pfxData, err := TransformCertificateFromPEMToPFX(certPEM, privkeyPEM, options.PfxPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform certificate to PFX: %w", err)
+		}
+
+		files = append(files, OutputFile{Name: "ssl certificate", Path: options.CertPath, Data: pfxData})
+
+	case OUTPUT_FORMAT_JKS:
+		jksData, err := TransformCertificateFromPEMToJKS(certPEM, privkeyPEM, options.JksAlias, options.JksKeypass, options.JksStorepass)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform certificate to JKS: %w", err)
+		}
+
+		files = append(files, OutputFile{Name: "ssl certificate", Path: options.CertPath, Data: jksData})
+
+	default:
+		return nil, fmt.Errorf("unsupported output format '%s'", options.Format)
+	}
+
+	return files, nil
+}
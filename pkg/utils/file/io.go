@@ -49,3 +49,91 @@ func Write(path string, data []byte) error {
 
 	return nil
 }
+
+// 与 [Write] 类似，但以原子方式写入：先写入同目录下的临时文件，再重命名覆盖目标文件。
+// 写入过程中发生错误时，目标文件保持不变。
+// 原文件存在时，将沿用原文件的所有者；无权限变更所有者时返回错误。
+//
+// 入参:
+//   - path: 文件路径。
+//   - data: 文件数据字节数组。
+//   - perm: 文件权限。零值时沿用原文件的权限，原文件不存在时默认值 0644。
+//
+// 出参:
+//   - 错误。
+func WriteAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	prevInfo, prevErr := os.Stat(path)
+	if perm == 0 {
+		if prevErr == nil {
+			perm = prevInfo.Mode().Perm()
+		} else {
+			perm = 0o644
+		}
+	}
+
+	// 临时文件创建时的权限为 0600，写入完成后再调整为目标权限
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tempPath := file.Name()
+	defer os.Remove(tempPath)
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err := os.Chmod(tempPath, perm); err != nil {
+		return fmt.Errorf("failed to change file mode: %w", err)
+	}
+	if prevErr == nil {
+		if uid, gid, ok := getFileOwner(prevInfo); ok {
+			if err := os.Chown(tempPath, uid, gid); err != nil {
+				return fmt.Errorf("failed to preserve file owner: %w", err)
+			}
+		}
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("failed to rename temporary file: %w", err)
+	}
+
+	return nil
+}
+
+// 复制文件，保留原文件的权限。
+// 如果目标目录不存在，将会递归创建目录。
+//
+// 入参:
+//   - src: 源文件路径。
+//   - dst: 目标文件路径。
+//
+// 出参:
+//   - 错误。源文件不存在时返回 [os.ErrNotExist]。
+func Copy(src string, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return WriteAtomic(dst, data, info.Mode().Perm())
+}
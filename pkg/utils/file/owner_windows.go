@@ -0,0 +1,10 @@
+package file
+
+import (
+	"os"
+)
+
+func getFileOwner(info os.FileInfo) (int, int, bool) {
+	// Windows 下的文件所有者由 ACL 管理，重命名覆盖不受影响
+	return -1, -1, false
+}
@@ -0,0 +1,125 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	xfile "github.com/certimate-go/certimate/pkg/utils/file"
+	xshell "github.com/certimate-go/certimate/pkg/utils/shell"
+	xssh "github.com/certimate-go/certimate/pkg/utils/ssh"
+)
+
+// 表示一个可读写文件并执行命令的文件系统，屏蔽本地与远程服务器之间的差异。
+type FileSystem interface {
+	// 读取指定路径的文件。
+	// 如果文件不存在，将返回 [os.ErrNotExist]。
+	ReadFile(path string) ([]byte, error)
+	// 以原子方式写入指定路径的文件。
+	// 目标文件已存在时沿用其原有的权限和所有者，否则以 perm 权限新建；perm 零值时默认值 0644。
+	WriteFile(path string, data []byte, perm os.FileMode) error
+	// 删除指定路径的文件。
+	// 文件不存在时不返回错误。
+	RemoveFile(path string) error
+	// 查找匹配指定模式的文件路径。
+	Glob(pattern string) ([]string, error)
+	// 执行命令。
+	// 上下文取消时将终止命令。
+	Exec(ctx context.Context, command string) (stdout string, stderr string, err error)
+}
+
+// 创建本地文件系统。
+//
+// 出参:
+//   - 文件系统。
+func NewLocalFileSystem() FileSystem {
+	return &localFileSystem{}
+}
+
+// 创建通过 SSH 连接访问的远程文件系统。
+//
+// 入参:
+//   - client: SSH 客户端。
+//   - useSCP: 是否使用 SCP 协议写入文件，否则使用 SFTP 协议。
+//
+// 出参:
+//   - 文件系统。
+func NewSSHFileSystem(client *xssh.Client, useSCP bool) FileSystem {
+	return &sshFileSystem{client: client, useSCP: useSCP}
+}
+
+type localFileSystem struct{}
+
+var _ FileSystem = (*localFileSystem)(nil)
+
+func (fs *localFileSystem) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func (fs *localFileSystem) WriteFile(path string, data []byte, perm os.FileMode) error {
+	if _, err := os.Stat(path); err == nil {
+		perm = 0
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	} else if perm == 0 {
+		perm = 0o644
+	}
+
+	return xfile.WriteAtomic(path, data, perm)
+}
+
+func (fs *localFileSystem) RemoveFile(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (fs *localFileSystem) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (fs *localFileSystem) Exec(ctx context.Context, command string) (string, string, error) {
+	return xshell.ExecCommand(ctx, "", command, "", nil)
+}
+
+type sshFileSystem struct {
+	client *xssh.Client
+	useSCP bool
+}
+
+var _ FileSystem = (*sshFileSystem)(nil)
+
+func (fs *sshFileSystem) ReadFile(path string) ([]byte, error) {
+	return xssh.ReadFile(fs.client.Client, path)
+}
+
+func (fs *sshFileSystem) WriteFile(path string, data []byte, perm os.FileMode) error {
+	// SCP 协议无法以原子方式写入，但服务端仅在新建文件时应用指定的权限
+	if fs.useSCP {
+		return xssh.WriteFileWithSCP(fs.client.Client, path, data, perm)
+	}
+
+	if _, err := xssh.Stat(fs.client.Client, path); err == nil {
+		perm = 0
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	} else if perm == 0 {
+		perm = 0o644
+	}
+
+	return xssh.WriteFileAtomic(fs.client.Client, path, data, perm)
+}
+
+func (fs *sshFileSystem) RemoveFile(path string) error {
+	return xssh.RemoveFile(fs.client.Client, path)
+}
+
+func (fs *sshFileSystem) Glob(pattern string) ([]string, error) {
+	return xssh.Glob(fs.client.Client, pattern)
+}
+
+func (fs *sshFileSystem) Exec(ctx context.Context, command string) (string, string, error) {
+	return xssh.ExecCommandWithContext(ctx, fs.client.Client, command)
+}
@@ -0,0 +1,350 @@
+package ftp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TLSMode string
+
+const (
+	// 不使用 TLS。
+	TLS_MODE_NONE = TLSMode("none")
+	// 显式 TLS（FTPES），连接后通过 `AUTH TLS` 升级。
+	TLS_MODE_EXPLICIT = TLSMode("explicit")
+	// 隐式 TLS（FTPS），连接时即使用 TLS。
+	TLS_MODE_IMPLICIT = TLSMode("implicit")
+)
+
+// 表示 FTP 服务器连接配置的数据结构。
+type ServerConfig struct {
+	// FTP 主机。
+	Host string
+	// FTP 端口。
+	// 零值时隐式 TLS 默认值 990，否则默认值 21。
+	Port int32
+	// FTP 登录用户名。
+	// 零值时默认值 "anonymous"。
+	Username string
+	// FTP 登录密码。
+	Password string
+	// TLS 模式。
+	// 零值时默认值 [TLS_MODE_NONE]。
+	TLSMode TLSMode
+	// TLS 配置。
+	TLSConfig *tls.Config
+	// 超时时间。
+	// 零值时默认值 30 秒。
+	Timeout time.Duration
+}
+
+// 表示一个 FTP 客户端，仅实现上传证书所需的最小命令集。
+type Client struct {
+	conn      net.Conn
+	text      *textproto.Conn
+	tlsConfig *tls.Config
+	timeout   time.Duration
+	host      string
+}
+
+// 连接并登录到 FTP 服务器。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - config: 服务器配置。
+//
+// 出参:
+//   - client: FTP 客户端。
+//   - err: 错误。
+func Dial(ctx context.Context, config ServerConfig) (*Client, error) {
+	if config.Host == "" {
+		return nil, errors.New("ftp: unset host")
+	}
+
+	port := config.Port
+	if port == 0 {
+		if config.TLSMode == TLS_MODE_IMPLICIT {
+			port = 990
+		} else {
+			port = 21
+		}
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	tlsConfig := config.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = config.Host
+	}
+	// 数据连接需复用控制连接的 TLS 会话
+	if tlsConfig.ClientSessionCache == nil {
+		tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(config.Host, strconv.Itoa(int(port))))
+	if err != nil {
+		return nil, fmt.Errorf("ftp: failed to connect: %w", err)
+	}
+
+	client := &Client{
+		conn:    conn,
+		timeout: timeout,
+		host:    config.Host,
+	}
+
+	if config.TLSMode == TLS_MODE_IMPLICIT {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ftp: failed to handshake: %w", err)
+		}
+
+		client.conn = tlsConn
+		client.tlsConfig = tlsConfig
+	}
+	client.text = textproto.NewConn(client.conn)
+
+	if _, _, err := client.readResponse(220); err != nil {
+		client.conn.Close()
+		return nil, err
+	}
+
+	if config.TLSMode == TLS_MODE_EXPLICIT {
+		if _, _, err := client.cmd(234, "AUTH TLS"); err != nil {
+			client.conn.Close()
+			return nil, err
+		}
+
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ftp: failed to handshake: %w", err)
+		}
+
+		client.conn = tlsConn
+		client.text = textproto.NewConn(tlsConn)
+		client.tlsConfig = tlsConfig
+	}
+
+	if err := client.login(config.Username, config.Password); err != nil {
+		client.conn.Close()
+		return nil, err
+	}
+
+	if client.tlsConfig != nil {
+		if _, _, err := client.cmd(200, "PBSZ 0"); err != nil {
+			client.conn.Close()
+			return nil, err
+		}
+		if _, _, err := client.cmd(200, "PROT P"); err != nil {
+			client.conn.Close()
+			return nil, err
+		}
+	}
+
+	if _, _, err := client.cmd(200, "TYPE I"); err != nil {
+		client.conn.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (c *Client) Close() error {
+	c.cmd(221, "QUIT")
+	return c.conn.Close()
+}
+
+// 递归创建目录，已存在的目录将被忽略。
+func (c *Client) MakeDirAll(dir string) error {
+	dir = path.Clean(dir)
+	if dir == "." || dir == "/" {
+		return nil
+	}
+
+	current := ""
+	if strings.HasPrefix(dir, "/") {
+		current = "/"
+	}
+	for _, segment := range strings.Split(strings.Trim(dir, "/"), "/") {
+		current = path.Join(current, segment)
+
+		// 目录已存在时服务器通常返回 550，忽略即可
+		code, msg, err := c.cmd(257, "MKD "+current)
+		if err != nil && code != 550 && code != 521 {
+			return fmt.Errorf("ftp: failed to create directory '%s': %s", current, msg)
+		}
+	}
+
+	return nil
+}
+
+// 上传文件。
+// 如果目录不存在，将会递归创建目录。
+func (c *Client) Store(filePath string, data []byte) error {
+	if err := c.MakeDirAll(path.Dir(filePath)); err != nil {
+		return err
+	}
+
+	dataConn, err := c.openDataConn()
+	if err != nil {
+		return err
+	}
+
+	// 服务器返回 125 或 150 均表示可以开始传输
+	if _, _, err := c.cmd(1, "STOR "+filePath); err != nil {
+		dataConn.Close()
+		return err
+	}
+
+	dataConn.SetDeadline(time.Now().Add(c.timeout))
+	if _, err := dataConn.Write(data); err != nil {
+		dataConn.Close()
+		return fmt.Errorf("ftp: failed to write data: %w", err)
+	}
+	if err := dataConn.Close(); err != nil {
+		return fmt.Errorf("ftp: failed to close data connection: %w", err)
+	}
+
+	if _, _, err := c.readResponse(226); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) login(username, password string) error {
+	if username == "" {
+		username = "anonymous"
+	}
+
+	code, msg, err := c.cmd(0, "USER "+username)
+	if err != nil {
+		return err
+	}
+	switch code {
+	case 230:
+		return nil
+	case 331:
+		if _, _, err := c.cmd(230, "PASS "+password); err != nil {
+			return err
+		}
+		return nil
+	default:
+		return fmt.Errorf("ftp: failed to login: %d %s", code, msg)
+	}
+}
+
+func (c *Client) openDataConn() (net.Conn, error) {
+	addr, err := c.passive()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, c.timeout)
+	if err != nil {
+		return nil, fmt.Errorf("ftp: failed to open data connection: %w", err)
+	}
+
+	if c.tlsConfig != nil {
+		return tls.Client(conn, c.tlsConfig), nil
+	}
+
+	return conn, nil
+}
+
+func (c *Client) passive() (string, error) {
+	// 优先使用 EPSV，失败后回退到 PASV
+	if _, msg, err := c.cmd(229, "EPSV"); err == nil {
+		start := strings.Index(msg, "(|||")
+		end := strings.LastIndex(msg, "|)")
+		if start >= 0 && end > start {
+			if port, err := strconv.Atoi(msg[start+4 : end]); err == nil {
+				return net.JoinHostPort(c.remoteHost(), strconv.Itoa(port)), nil
+			}
+		}
+	}
+
+	_, msg, err := c.cmd(227, "PASV")
+	if err != nil {
+		return "", err
+	}
+
+	start := strings.Index(msg, "(")
+	end := strings.LastIndex(msg, ")")
+	if start < 0 || end < start {
+		return "", fmt.Errorf("ftp: invalid PASV response: %s", msg)
+	}
+
+	parts := strings.Split(msg[start+1:end], ",")
+	if len(parts) != 6 {
+		return "", fmt.Errorf("ftp: invalid PASV response: %s", msg)
+	}
+
+	p1, err1 := strconv.Atoi(strings.TrimSpace(parts[4]))
+	p2, err2 := strconv.Atoi(strings.TrimSpace(parts[5]))
+	if err1 != nil || err2 != nil {
+		return "", fmt.Errorf("ftp: invalid PASV response: %s", msg)
+	}
+
+	// 忽略服务器返回的 IP，避免 NAT 环境下的内网地址问题
+	return net.JoinHostPort(c.remoteHost(), strconv.Itoa(p1*256+p2)), nil
+}
+
+func (c *Client) remoteHost() string {
+	if host, _, err := net.SplitHostPort(c.conn.RemoteAddr().String()); err == nil {
+		return host
+	}
+
+	return c.host
+}
+
+func (c *Client) cmd(expectCode int, command string) (int, string, error) {
+	c.conn.SetDeadline(time.Now().Add(c.timeout))
+
+	if _, err := c.text.Cmd("%s", command); err != nil {
+		return 0, "", fmt.Errorf("ftp: failed to send command: %w", err)
+	}
+
+	return c.readResponse(expectCode)
+}
+
+func (c *Client) readResponse(expectCode int) (int, string, error) {
+	c.conn.SetDeadline(time.Now().Add(c.timeout))
+
+	code, msg, err := c.text.ReadResponse(expectCode)
+	if err != nil {
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) {
+			return code, msg, &responseError{Code: code, Msg: msg}
+		}
+		return code, msg, fmt.Errorf("ftp: failed to read response: %w", err)
+	}
+
+	return code, msg, nil
+}
+
+type responseError struct {
+	Code int
+	Msg  string
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("ftp: unexpected response: %d %s", e.Code, e.Msg)
+}
@@ -0,0 +1,70 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+const (
+	SHELL_ENV_SH         = "sh"
+	SHELL_ENV_CMD        = "cmd"
+	SHELL_ENV_POWERSHELL = "powershell"
+)
+
+// 在本地执行命令。
+// 上下文取消时将终止命令进程。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - shellEnv: Shell 执行环境，可取值 "sh"、"cmd"、"powershell"。零值时根据操作系统决定。
+//   - command: 要执行的命令。
+//   - workDir: 工作目录。零值时使用当前进程的工作目录。
+//   - env: 环境变量，形如 "KEY=VALUE"。为 nil 时继承当前进程的环境变量。
+//
+// 出参:
+//   - stdout: 标准输出。
+//   - stderr: 标准错误输出。
+//   - err: 错误。
+func ExecCommand(ctx context.Context, shellEnv string, command string, workDir string, env []string) (_stdout string, _stderr string, _err error) {
+	var cmd *exec.Cmd
+
+	switch shellEnv {
+	case SHELL_ENV_SH:
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+
+	case SHELL_ENV_CMD:
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+
+	case SHELL_ENV_POWERSHELL:
+		cmd = exec.CommandContext(ctx, "powershell", "-Command", command)
+
+	case "":
+		if runtime.GOOS == "windows" {
+			cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+		} else {
+			cmd = exec.CommandContext(ctx, "sh", "-c", command)
+		}
+
+	default:
+		return "", "", fmt.Errorf("unsupported shell env '%s'", shellEnv)
+	}
+
+	cmd.Dir = workDir
+	cmd.Env = env
+	cmd.WaitDelay = 5 * time.Second
+
+	stdoutBuf := bytes.NewBuffer(nil)
+	cmd.Stdout = stdoutBuf
+	stderrBuf := bytes.NewBuffer(nil)
+	cmd.Stderr = stderrBuf
+	err := cmd.Run()
+	if err != nil {
+		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), nil
+}
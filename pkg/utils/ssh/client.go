@@ -0,0 +1,216 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// 表示 SSH 服务器连接配置的数据结构。
+type ServerConfig struct {
+	// SSH 主机。
+	// 零值时默认值 "localhost"。
+	Host string
+	// SSH 端口。
+	// 零值时默认值 22。
+	Port int32
+	// SSH 认证方式。
+	// 可取值 "none"、"password" 或 "key"。
+	// 零值时根据有无密码或私钥字段决定。
+	AuthMethod string
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	Username string
+	// SSH 登录密码。
+	Password string
+	// SSH 登录私钥。
+	Key string
+	// SSH 登录私钥口令。
+	KeyPassphrase string
+}
+
+func (c ServerConfig) address() string {
+	host := c.Host
+	if host == "" {
+		host = "localhost"
+	}
+
+	port := c.Port
+	if port == 0 {
+		port = 22
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
+
+// 表示一个 SSH 客户端，关闭时会同时关闭经过的所有跳板机连接。
+type Client struct {
+	*ssh.Client
+
+	closers []io.Closer
+}
+
+func (c *Client) Close() error {
+	err := c.Client.Close()
+	for i := len(c.closers) - 1; i >= 0; i-- {
+		c.closers[i].Close()
+	}
+	return err
+}
+
+// 连接到目标 SSH 服务器，可选择经由若干跳板机依次转发。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - target: 目标服务器配置。
+//   - jumpServers: 跳板机配置数组，按连接顺序排列。
+//
+// 出参:
+//   - client: SSH 客户端。
+//   - err: 错误。
+func Dial(ctx context.Context, target ServerConfig, jumpServers []ServerConfig) (*Client, error) {
+	closers := make([]io.Closer, 0)
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i].Close()
+		}
+	}
+
+	var jumpClient *ssh.Client
+	for i, jumpServer := range jumpServers {
+		var jumpConn net.Conn
+		var err error
+
+		// 第一个连接是主机发起，后续通过跳板机发起
+		if jumpClient == nil {
+			dialer := &net.Dialer{}
+			jumpConn, err = dialer.DialContext(ctx, "tcp", jumpServer.address())
+		} else {
+			jumpConn, err = jumpClient.DialContext(ctx, "tcp", jumpServer.address())
+		}
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to connect to jump server [%d]: %w", i+1, err)
+		}
+		closers = append(closers, jumpConn)
+
+		newClient, err := NewClient(jumpConn, jumpServer)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to create jump server ssh client[%d]: %w", i+1, err)
+		}
+		closers = append(closers, newClient)
+
+		jumpClient = newClient
+	}
+
+	var targetConn net.Conn
+	var err error
+	if jumpClient != nil {
+		// 通过跳板机发起 TCP 连接到目标服务器
+		targetConn, err = jumpClient.DialContext(ctx, "tcp", target.address())
+	} else {
+		// 直接发起 TCP 连接到目标服务器
+		dialer := &net.Dialer{}
+		targetConn, err = dialer.DialContext(ctx, "tcp", target.address())
+	}
+	if err != nil {
+		closeAll()
+		return nil, fmt.Errorf("failed to connect to target server: %w", err)
+	}
+	closers = append(closers, targetConn)
+
+	client, err := NewClient(targetConn, target)
+	if err != nil {
+		closeAll()
+		return nil, fmt.Errorf("failed to create ssh client: %w", err)
+	}
+
+	return &Client{Client: client, closers: closers}, nil
+}
+
+// 通过已有的网络连接创建 SSH 客户端。
+//
+// 入参:
+//   - conn: 网络连接。
+//   - config: 服务器配置。
+//
+// 出参:
+//   - client: SSH 客户端。
+//   - err: 错误。
+func NewClient(conn net.Conn, config ServerConfig) (*ssh.Client, error) {
+	username := config.Username
+	if username == "" {
+		username = "root"
+	}
+
+	const AUTH_METHOD_NONE = "none"
+	const AUTH_METHOD_PASSWORD = "password"
+	const AUTH_METHOD_KEY = "key"
+	authMethod := config.AuthMethod
+	if authMethod == "" {
+		if config.Key != "" {
+			authMethod = AUTH_METHOD_KEY
+		} else if config.Password != "" {
+			authMethod = AUTH_METHOD_PASSWORD
+		} else {
+			authMethod = AUTH_METHOD_NONE
+		}
+	}
+
+	authentications := make([]ssh.AuthMethod, 0)
+	switch authMethod {
+	case AUTH_METHOD_NONE:
+		{
+		}
+
+	case AUTH_METHOD_PASSWORD:
+		{
+			password := config.Password
+			authentications = append(authentications, ssh.Password(password))
+			authentications = append(authentications, ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				if len(questions) == 1 {
+					return []string{password}, nil
+				}
+				return nil, fmt.Errorf("unexpected keyboard interactive question [%s]", strings.Join(questions, ", "))
+			}))
+		}
+
+	case AUTH_METHOD_KEY:
+		{
+			var signer ssh.Signer
+			var err error
+
+			if config.KeyPassphrase != "" {
+				signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(config.Key), []byte(config.KeyPassphrase))
+			} else {
+				signer, err = ssh.ParsePrivateKey([]byte(config.Key))
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			authentications = append(authentications, ssh.PublicKeys(signer))
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported auth method '%s'", authMethod)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, config.address(), &ssh.ClientConfig{
+		User:            username,
+		Auth:            authentications,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
@@ -0,0 +1,76 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// 在远程服务器上执行命令。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - command: 要执行的命令。
+//
+// 出参:
+//   - stdout: 标准输出。
+//   - stderr: 标准错误输出。
+//   - err: 错误。
+func ExecCommand(sshCli *ssh.Client, command string) (_stdout string, _stderr string, _err error) {
+	session, err := sshCli.NewSession()
+	if err != nil {
+		return "", "", err
+	}
+	defer session.Close()
+
+	stdoutBuf := bytes.NewBuffer(nil)
+	session.Stdout = stdoutBuf
+	stderrBuf := bytes.NewBuffer(nil)
+	session.Stderr = stderrBuf
+	err = session.Run(command)
+	if err != nil {
+		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("failed to execute ssh command: %w", err)
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), nil
+}
+
+// 与 [ExecCommand] 类似，但上下文取消时将关闭会话以终止命令。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - sshCli: SSH 客户端。
+//   - command: 要执行的命令。
+//
+// 出参:
+//   - stdout: 标准输出。
+//   - stderr: 标准错误输出。
+//   - err: 错误。
+func ExecCommandWithContext(ctx context.Context, sshCli *ssh.Client, command string) (_stdout string, _stderr string, _err error) {
+	session, err := sshCli.NewSession()
+	if err != nil {
+		return "", "", err
+	}
+	defer session.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		session.Close()
+	})
+	defer stop()
+
+	stdoutBuf := bytes.NewBuffer(nil)
+	session.Stdout = stdoutBuf
+	stderrBuf := bytes.NewBuffer(nil)
+	session.Stderr = stderrBuf
+	err = session.Run(command)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("failed to execute ssh command: %w", err)
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), nil
+}
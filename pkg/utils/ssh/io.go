@@ -0,0 +1,359 @@
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/povsister/scp"
+	"golang.org/x/crypto/ssh"
+)
+
+// 与 [WriteFile] 类似，但写入的是字符串内容。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - useSCP: 是否使用 SCP 协议，否则使用 SFTP 协议。
+//   - path: 远程文件路径。
+//   - content: 文件内容。
+//
+// 出参:
+//   - 错误。
+func WriteFileString(sshCli *ssh.Client, useSCP bool, path string, content string) error {
+	return WriteFile(sshCli, useSCP, path, []byte(content))
+}
+
+// 将数据写入远程服务器上指定路径的文件。
+// 使用 SFTP 协议时，如果目录不存在，将会递归创建目录。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - useSCP: 是否使用 SCP 协议，否则使用 SFTP 协议。
+//   - path: 远程文件路径。
+//   - data: 文件数据字节数组。
+//
+// 出参:
+//   - 错误。
+func WriteFile(sshCli *ssh.Client, useSCP bool, path string, data []byte) error {
+	if useSCP {
+		return writeFileWithSCP(sshCli, path, data, 0)
+	}
+
+	return writeFileWithSFTP(sshCli, path, data)
+}
+
+// 通过 SCP 协议将数据写入远程服务器上指定路径的文件。
+// 目标文件不存在时以指定的权限新建；目标文件已存在时，服务端将沿用其原有的权限。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//   - data: 文件数据字节数组。
+//   - perm: 新建文件时的权限。零值时默认值 0644。
+//
+// 出参:
+//   - 错误。
+func WriteFileWithSCP(sshCli *ssh.Client, path string, data []byte, perm os.FileMode) error {
+	return writeFileWithSCP(sshCli, path, data, perm)
+}
+
+// 通过 SFTP 协议读取远程服务器上指定路径的文件。
+// 如果文件不存在，将返回 [os.ErrNotExist]。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//
+// 出参:
+//   - data: 文件数据字节数组。
+//   - err: 错误。
+func ReadFile(sshCli *ssh.Client, path string) ([]byte, error) {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	file, err := sftpCli.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, os.ErrNotExist
+		}
+		return nil, fmt.Errorf("failed to open remote file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote file: %w", err)
+	}
+
+	return data, nil
+}
+
+// 通过 SFTP 协议以原子方式写入远程服务器上指定路径的文件：先写入同目录下的临时文件，再重命名覆盖目标文件。
+// 如果目录不存在，将会递归创建目录。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//   - data: 文件数据字节数组。
+//   - perm: 文件权限。零值时沿用原文件的权限，原文件不存在时默认值 0644。
+//
+// 出参:
+//   - 错误。
+func WriteFileAtomic(sshCli *ssh.Client, path string, data []byte, perm os.FileMode) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	return writeFileAtomicWithSFTP(sftpCli, path, data, perm)
+}
+
+// 通过 SFTP 协议复制远程服务器上的文件，保留原文件的权限。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - src: 远程源文件路径。
+//   - dst: 远程目标文件路径。
+//
+// 出参:
+//   - 错误。源文件不存在时返回 [os.ErrNotExist]。
+func CopyFile(sshCli *ssh.Client, src string, dst string) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	info, err := sftpCli.Stat(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return os.ErrNotExist
+		}
+		return fmt.Errorf("failed to stat remote file: %w", err)
+	}
+
+	file, err := sftpCli.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open remote file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return fmt.Errorf("failed to read remote file: %w", err)
+	}
+
+	return writeFileAtomicWithSFTP(sftpCli, dst, data, info.Mode().Perm())
+}
+
+// 通过 SFTP 协议获取远程服务器上指定路径的文件信息。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//
+// 出参:
+//   - 文件信息。
+//   - 错误。文件不存在时返回 [os.ErrNotExist]。
+func Stat(sshCli *ssh.Client, path string) (os.FileInfo, error) {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	info, err := sftpCli.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, os.ErrNotExist
+		}
+		return nil, fmt.Errorf("failed to stat remote file: %w", err)
+	}
+
+	return info, nil
+}
+
+// 通过 SFTP 协议删除远程服务器上的文件。
+// 文件不存在时不返回错误。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//
+// 出参:
+//   - 错误。
+func RemoveFile(sshCli *ssh.Client, path string) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	if err := sftpCli.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove remote file: %w", err)
+	}
+
+	return nil
+}
+
+// 通过 SFTP 协议修改远程服务器上文件的权限和所有者。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - path: 远程文件路径。
+//   - perm: 文件权限。零值时不修改。
+//   - uid: 所有者用户 ID。负值时不修改所有者。
+//   - gid: 所有者用户组 ID。负值时不修改所有者。
+//
+// 出参:
+//   - 错误。
+func ChmodChown(sshCli *ssh.Client, path string, perm os.FileMode, uid int, gid int) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	if perm != 0 {
+		if err := sftpCli.Chmod(path, perm); err != nil {
+			return fmt.Errorf("failed to change remote file mode: %w", err)
+		}
+	}
+
+	if uid >= 0 && gid >= 0 {
+		if err := sftpCli.Chown(path, uid, gid); err != nil {
+			return fmt.Errorf("failed to change remote file owner: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// 通过 SFTP 协议查找远程服务器上匹配指定模式的文件路径。
+// 模式语法同 [path.Match]。
+//
+// 入参:
+//   - sshCli: SSH 客户端。
+//   - pattern: 匹配模式。
+//
+// 出参:
+//   - 匹配的文件路径数组。
+//   - 错误。
+func Glob(sshCli *ssh.Client, pattern string) ([]string, error) {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	matches, err := sftpCli.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to glob remote files: %w", err)
+	}
+
+	return matches, nil
+}
+
+func writeFileWithSCP(sshCli *ssh.Client, path string, data []byte, perm os.FileMode) error {
+	scpCli, err := scp.NewClientFromExistingSSH(sshCli, &scp.ClientOption{})
+	if err != nil {
+		return fmt.Errorf("failed to create scp client: %w", err)
+	}
+
+	reader := bytes.NewReader(data)
+	err = scpCli.CopyToRemote(reader, path, &scp.FileTransferOption{Perm: perm})
+	if err != nil {
+		return fmt.Errorf("failed to write to remote file: %w", err)
+	}
+
+	return nil
+}
+
+func writeFileWithSFTP(sshCli *ssh.Client, path string, data []byte) error {
+	sftpCli, err := sftp.NewClient(sshCli)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp client: %w", err)
+	}
+	defer sftpCli.Close()
+
+	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to create remote directory: %w", err)
+	}
+
+	file, err := sftpCli.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return fmt.Errorf("failed to open remote file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = file.Write(data)
+	if err != nil {
+		return fmt.Errorf("failed to write to remote file: %w", err)
+	}
+
+	return nil
+}
+
+func writeFileAtomicWithSFTP(sftpCli *sftp.Client, path string, data []byte, perm os.FileMode) error {
+	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to create remote directory: %w", err)
+	}
+
+	prevInfo, prevErr := sftpCli.Stat(path)
+	if perm == 0 {
+		if prevErr == nil {
+			perm = prevInfo.Mode().Perm()
+		} else {
+			perm = 0o644
+		}
+	}
+
+	tempPath := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%s.tmp-%d", filepath.Base(path), time.Now().UnixNano()))
+	file, err := sftpCli.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL)
+	if err != nil {
+		return fmt.Errorf("failed to create remote temporary file: %w", err)
+	}
+	defer sftpCli.Remove(tempPath)
+
+	// 临时文件以服务端默认权限创建，须在写入内容前调整权限及所有者，避免私钥等敏感内容短暂可读
+	if err := file.Chmod(perm); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to change remote file mode: %w", err)
+	}
+	if prevErr == nil {
+		if stat, ok := prevInfo.Sys().(*sftp.FileStat); ok {
+			if err := file.Chown(int(stat.UID), int(stat.GID)); err != nil {
+				file.Close()
+				return fmt.Errorf("failed to preserve remote file owner: %w", err)
+			}
+		}
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write to remote temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close remote temporary file: %w", err)
+	}
+
+	// 优先使用 posix-rename 扩展以原子方式覆盖目标文件；服务端不支持时回退为先删除再重命名
+	if err := sftpCli.PosixRename(tempPath, path); err != nil {
+		if err := sftpCli.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to replace remote file: %w", err)
+		}
+		if err := sftpCli.Rename(tempPath, path); err != nil {
+			return fmt.Errorf("failed to rename remote temporary file: %w", err)
+		}
+	}
+
+	return nil
+}
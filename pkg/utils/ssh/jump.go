@@ -0,0 +1,59 @@
+package ssh
+
+import (
+	"context"
+)
+
+// 表示跳板机配置的数据结构，可直接嵌入部署器等的配置中。
+type JumpServerConfig struct {
+	// SSH 主机。
+	// 零值时默认值 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认值 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 认证方式。
+	// 可取值 "none"、"password"、"key"。
+	// 零值时根据有无密码或私钥字段决定。
+	SshAuthMethod string `json:"sshAuthMethod,omitempty"`
+	// SSH 登录用户名。
+	// 零值时默认值 "root"。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+}
+
+func (c JumpServerConfig) serverConfig() ServerConfig {
+	return ServerConfig{
+		Host:          c.SshHost,
+		Port:          c.SshPort,
+		AuthMethod:    c.SshAuthMethod,
+		Username:      c.SshUsername,
+		Password:      c.SshPassword,
+		Key:           c.SshKey,
+		KeyPassphrase: c.SshKeyPassphrase,
+	}
+}
+
+// 与 [Dial] 类似，但跳板机配置为 [JumpServerConfig]。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - target: 目标服务器配置。
+//   - jumpServers: 跳板机配置数组，按连接顺序排列。
+//
+// 出参:
+//   - client: SSH 客户端。
+//   - err: 错误。
+func DialWithJumpServers(ctx context.Context, target ServerConfig, jumpServers []JumpServerConfig) (*Client, error) {
+	servers := make([]ServerConfig, len(jumpServers))
+	for i, jumpServer := range jumpServers {
+		servers[i] = jumpServer.serverConfig()
+	}
+
+	return Dial(ctx, target, servers)
+}